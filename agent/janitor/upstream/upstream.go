@@ -3,6 +3,8 @@ package upstream
 import (
 	"errors"
 	"fmt"
+	"net"
+	"strconv"
 	"strings"
 	"sync"
 )
@@ -113,7 +115,7 @@ func (b *Backend) valid() error {
 }
 
 func (b *Backend) Addr() string {
-	return fmt.Sprintf("%s:%d", b.IP, b.Port)
+	return net.JoinHostPort(b.IP, strconv.FormatUint(b.Port, 10))
 }
 
 // BackendCombined
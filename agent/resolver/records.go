@@ -11,15 +11,16 @@ import (
 )
 
 type Record struct {
-	ID          string  `json:"id"`
-	Parent      string  `json:"parent"`
-	IP          string  `json:"ip"`
-	Port        string  `json:"port"`
-	Weight      float64 `json:"weight"`
-	ProxyRecord bool    `json:"proxy_record"`
-	CleanName   string  `json:"clean_name"`
-
-	ip    net.IP
+	ID          string   `json:"id"`
+	Parent      string   `json:"parent"`
+	IP          string   `json:"ip"`
+	IPs         []string `json:"ips,omitempty"` // all of the addresses, including IP
+	Port        string   `json:"port"`
+	Weight      float64  `json:"weight"`
+	ProxyRecord bool     `json:"proxy_record"`
+	CleanName   string   `json:"clean_name"`
+
+	ips   []net.IP
 	portN int
 }
 
@@ -32,7 +33,18 @@ func (r *Record) rewrite(base string) error {
 	if ip == nil {
 		return errors.New("dns-record: invlaid IP: " + r.IP)
 	}
-	r.ip = ip
+	r.ips = []net.IP{ip}
+
+	for _, addr := range r.IPs {
+		ip := net.ParseIP(addr)
+		if ip == nil {
+			return errors.New("dns-record: invlaid IP: " + addr)
+		}
+
+		if !ip.Equal(r.ips[0]) {
+			r.ips = append(r.ips, ip)
+		}
+	}
 
 	port, err := strconv.Atoi(r.Port)
 	if err != nil {
@@ -48,19 +60,51 @@ func (r *Record) rewrite(base string) error {
 	return nil
 }
 
-func (r *Record) buildA(name string, ttl int) *dns.A {
-	return &dns.A{
-		Hdr: dns.RR_Header{
-			Name:   name,
-			Rrtype: dns.TypeA,
-			Class:  dns.ClassINET,
-			Ttl:    uint32(ttl),
-		},
-		A: r.ip.To4(),
+func (r *Record) buildA(name string, ttl int) []dns.RR {
+	rrs := make([]dns.RR, 0, len(r.ips))
+
+	for _, ip := range r.ips {
+		if ip.To4() == nil {
+			continue
+		}
+
+		rrs = append(rrs, &dns.A{
+			Hdr: dns.RR_Header{
+				Name:   name,
+				Rrtype: dns.TypeA,
+				Class:  dns.ClassINET,
+				Ttl:    uint32(ttl),
+			},
+			A: ip.To4(),
+		})
 	}
+
+	return rrs
+}
+
+func (r *Record) buildAAAA(name string, ttl int) []dns.RR {
+	rrs := make([]dns.RR, 0, len(r.ips))
+
+	for _, ip := range r.ips {
+		if ip.To4() != nil {
+			continue
+		}
+
+		rrs = append(rrs, &dns.AAAA{
+			Hdr: dns.RR_Header{
+				Name:   name,
+				Rrtype: dns.TypeAAAA,
+				Class:  dns.ClassINET,
+				Ttl:    uint32(ttl),
+			},
+			AAAA: ip,
+		})
+	}
+
+	return rrs
 }
 
-func (r *Record) buildSRV(name string, ttl int) (*dns.SRV, *dns.A) {
+func (r *Record) buildSRV(name string, ttl int) (*dns.SRV, []dns.RR) {
 	srv := &dns.SRV{
 		Hdr: dns.RR_Header{
 			Name:   name,
@@ -74,7 +118,8 @@ func (r *Record) buildSRV(name string, ttl int) (*dns.SRV, *dns.A) {
 		Target:   r.CleanName,
 	}
 
-	a := r.buildA(r.CleanName, ttl) // note: use clean name to build A
+	// note: use clean name to build A & AAAA
+	ext := append(r.buildA(r.CleanName, ttl), r.buildAAAA(r.CleanName, ttl)...)
 
-	return srv, a
+	return srv, ext
 }
@@ -111,7 +111,9 @@ func (r *Resolver) Upsert(record *Record) error {
 		}
 	}
 
+	// refresh the addresses
 	if idx >= 0 {
+		records[idx] = record
 		return nil
 	}
 
@@ -213,15 +215,19 @@ func (r *Resolver) handleLocal(w dns.ResponseWriter, req *dns.Msg) {
 
 	case dns.TypeA:
 		for _, record := range r.search(name) {
-			a := record.buildA(name, ttl)
-			msg.Answer = append(msg.Answer, a)
+			msg.Answer = append(msg.Answer, record.buildA(name, ttl)...)
+		}
+
+	case dns.TypeAAAA:
+		for _, record := range r.search(name) {
+			msg.Answer = append(msg.Answer, record.buildAAAA(name, ttl)...)
 		}
 
 	case dns.TypeSRV:
 		for _, record := range r.search(name) {
 			srv, ext := record.buildSRV(name, ttl)
 			msg.Answer = append(msg.Answer, srv)
-			msg.Extra = append(msg.Extra, ext)
+			msg.Extra = append(msg.Extra, ext...)
 		}
 	}
 
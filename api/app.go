@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/Dataman-Cloud/swan/mesos"
+	"github.com/Dataman-Cloud/swan/store"
 	"github.com/Dataman-Cloud/swan/types"
 	"github.com/Dataman-Cloud/swan/utils"
 	"github.com/Dataman-Cloud/swan/utils/fields"
@@ -39,6 +40,21 @@ func (r *Server) createApp(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	instances := int(version.Instances)
+	if version.IsDaemon() {
+		instances = r.driver.DaemonAgents(version.Constraints)
+	}
+
+	quotaReq := types.NewResourceUsage(&version, instances)
+	quotaReq.Apps = 1
+
+	reserved, herr := r.checkQuota(version.RunAs, quotaReq)
+	if herr != nil {
+		http.Error(w, herr.Error(), herr.StatusCode())
+		return
+	}
+	defer reserved.done()
+
 	compose := req.Form.Get("compose")
 
 	if compose == "" {
@@ -62,6 +78,7 @@ func (r *Server) createApp(w http.ResponseWriter, req *http.Request) {
 		Name:      spec.Name,
 		Alias:     alias,
 		RunAs:     spec.RunAs,
+		Priority:  int(spec.Priority),
 		Cluster:   r.driver.ClusterName(),
 		Status:    "creating",
 		CreatedAt: time.Now(),
@@ -77,6 +94,10 @@ func (r *Server) createApp(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	rev := app.Revision // as created, the app is changed by the launching after
+
+	reserved.release(&types.ResourceUsage{Apps: 1})
+
 	version.ID = vid
 
 	if err := r.db.CreateVersion(id, &version); err != nil {
@@ -94,10 +115,16 @@ func (r *Server) createApp(w http.ResponseWriter, req *http.Request) {
 		onfailure = spec.DeployPolicy.OnFailure
 	}
 
+	// released as the tasks written.
+	held := reserved.handOver()
+
 	go func(appId string) {
+		defer held.done()
+
 		defer func() {
-			app.OpStatus = types.OpStatusNoop
-			if err := r.db.UpdateApp(app); err != nil {
+			if err := store.UpdateAppWith(r.db, app, func(app *types.Application) {
+				app.OpStatus = types.OpStatusNoop
+			}); err != nil {
 				log.Errorf("update app op-status got error: %v", err)
 			}
 		}()
@@ -115,7 +142,7 @@ func (r *Server) createApp(w http.ResponseWriter, req *http.Request) {
 
 			cfg := types.NewTaskConfig(spec)
 
-			if cfg.Network != "host" && cfg.Network != "bridge" {
+			if cfg.FixedIP() {
 				cfg.Parameters = append(cfg.Parameters, &types.Parameter{
 					Key:   "ip",
 					Value: spec.IPs[i],
@@ -140,6 +167,8 @@ func (r *Server) createApp(w http.ResponseWriter, req *http.Request) {
 				break
 			}
 
+			held.release(types.NewResourceUsage(spec, 1))
+
 			t := mesos.NewTask(
 				cfg,
 				id,
@@ -162,10 +191,10 @@ func (r *Server) createApp(w http.ResponseWriter, req *http.Request) {
 							return
 						}
 
-						task.Status = "Failed"
-						task.ErrMsg = err.Error()
-
-						if err = r.db.UpdateTask(app.ID, task); err != nil {
+						if err = store.UpdateTaskWith(r.db, app.ID, task, func(task *types.Task) {
+							task.Status = "Failed"
+							task.ErrMsg = err.Error()
+						}); err != nil {
 							log.Errorf("update task %s status got error: %v", id, err)
 						}
 					}
@@ -193,6 +222,7 @@ func (r *Server) createApp(w http.ResponseWriter, req *http.Request) {
 		return
 	}(app.ID)
 
+	setETag(w, rev)
 	writeJSON(w, http.StatusCreated, map[string]string{"Id": app.ID})
 }
 
@@ -268,6 +298,7 @@ func (r *Server) getApp(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	setETag(w, app.Revision)
 	writeJSON(w, http.StatusOK, app)
 }
 
@@ -285,13 +316,21 @@ func (r *Server) deleteApp(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	if err := checkIfMatch(req, app.Revision); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
 	app.OpStatus = types.OpStatusDeleting
 
 	if err := r.db.UpdateApp(app); err != nil {
-		http.Error(w, fmt.Sprintf("updating app opstatus to deleting got error: %v", err), http.StatusInternalServerError)
+		http.Error(w, fmt.Sprintf("updating app opstatus to deleting got error: %v", err), updateErrCode(req, err))
 		return
 	}
 
+	// drop the app's launches those still waiting in the queue
+	r.driver.CancelAppLaunches(app.ID)
+
 	tasks, err := r.db.ListTasks(app.ID)
 	if err != nil {
 		http.Error(w, fmt.Sprintf("list tasks got error for delete app. %v", err), http.StatusInternalServerError)
@@ -331,8 +370,9 @@ func (r *Server) deleteApp(w http.ResponseWriter, req *http.Request) {
 
 					hasError = true
 
-					task.ErrMsg = fmt.Sprintf("kill task error: %v", err)
-					if err = r.db.UpdateTask(appId, task); err != nil {
+					if err = store.UpdateTaskWith(r.db, appId, task, func(task *types.Task) {
+						task.ErrMsg = fmt.Sprintf("kill task error: %v", err)
+					}); err != nil {
 						log.Errorf("update task %s got error: %v", task.Name, err)
 					}
 
@@ -344,8 +384,9 @@ func (r *Server) deleteApp(w http.ResponseWriter, req *http.Request) {
 
 					hasError = true
 
-					task.ErrMsg = fmt.Sprintf("delete task error: %v", err)
-					if err = r.db.UpdateTask(appId, task); err != nil {
+					if err = store.UpdateTaskWith(r.db, appId, task, func(task *types.Task) {
+						task.ErrMsg = fmt.Sprintf("delete task error: %v", err)
+					}); err != nil {
 						log.Errorf("update task %s got error: %v", task.Name, err)
 					}
 
@@ -382,6 +423,11 @@ func (r *Server) scaleApp(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	if err := checkIfMatch(req, app.Revision); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
 	if app.OpStatus != types.OpStatusNoop {
 		http.Error(w, fmt.Sprintf("app status is %s, operation not allowed.", app.OpStatus), http.StatusMethodNotAllowed)
 		return
@@ -415,18 +461,40 @@ func (r *Server) scaleApp(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	spec, err := r.db.GetVersion(app.ID, app.Version[0])
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if spec.IsDaemon() {
+		http.Error(w, "daemon app can't be scaled, it runs one task on every matching agent", http.StatusBadRequest)
+		return
+	}
+
+	var reserved *quotaReservation
+	if goal > current {
+		var herr *httpError
+		if reserved, herr = r.checkQuota(app.RunAs, types.NewResourceUsage(spec, goal-current)); herr != nil {
+			http.Error(w, herr.Error(), herr.StatusCode())
+			return
+		}
+	}
+	defer reserved.done()
+
 	app.OpStatus = types.OpStatusScaling
 
 	if err := r.db.UpdateApp(app); err != nil {
-		http.Error(w, fmt.Sprintf("updating app opstatus to scaling got error: %v", err), http.StatusInternalServerError)
+		http.Error(w, fmt.Sprintf("updating app opstatus to scaling got error: %v", err), updateErrCode(req, err))
 		return
 	}
 
 	if goal < current { // scale dwon
 		go func() {
 			defer func() {
-				app.OpStatus = types.OpStatusNoop
-				if err := r.db.UpdateApp(app); err != nil {
+				if err := store.UpdateAppWith(r.db, app, func(app *types.Application) {
+					app.OpStatus = types.OpStatusNoop
+				}); err != nil {
 					log.Errorf("updating app status from scaling to noop got error: %v", err)
 				}
 			}()
@@ -450,6 +518,8 @@ func (r *Server) scaleApp(w http.ResponseWriter, req *http.Request) {
 					break
 				}
 
+				r.driver.CancelAppLaunches(appId, tid)
+
 				t, err := r.db.GetTask(appId, tid)
 				if err != nil {
 					log.Errorln("get db task error:", err)
@@ -457,10 +527,10 @@ func (r *Server) scaleApp(w http.ResponseWriter, req *http.Request) {
 				}
 
 				if err := r.driver.KillTask(t.ID, t.AgentId, true); err != nil {
-					t.Status = "delete failed"
-					t.ErrMsg = err.Error()
-
-					if err = r.db.UpdateTask(appId, t); err != nil {
+					if err = store.UpdateTaskWith(r.db, appId, t, func(t *types.Task) {
+						t.Status = "delete failed"
+						t.ErrMsg = err.Error()
+					}); err != nil {
 						log.Errorf("update task %s got error: %v", t.Name, err)
 					}
 
@@ -479,14 +549,7 @@ func (r *Server) scaleApp(w http.ResponseWriter, req *http.Request) {
 	}
 
 	// scale up
-	spec, err := r.db.GetVersion(app.ID, app.Version[0])
-	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	net := spec.Container.Docker.Network
-	if net != "host" && net != "bridge" {
+	if types.NewTaskConfig(spec).FixedIP() {
 		if len(ips) < int(goal-current) {
 			http.Error(w, fmt.Sprintf("IP number cannot be less than the instance number"), http.StatusBadRequest)
 			return
@@ -498,10 +561,16 @@ func (r *Server) scaleApp(w http.ResponseWriter, req *http.Request) {
 		onfailure = scale.OnFailure
 	)
 
+	// released as the tasks written.
+	held := reserved.handOver()
+
 	go func() {
+		defer held.done()
+
 		defer func() {
-			app.OpStatus = types.OpStatusNoop
-			if err := r.db.UpdateApp(app); err != nil {
+			if err := store.UpdateAppWith(r.db, app, func(app *types.Application) {
+				app.OpStatus = types.OpStatusNoop
+			}); err != nil {
 				log.Errorf("updating app status from scaling to noop got error: %v", err)
 			}
 		}()
@@ -521,7 +590,7 @@ func (r *Server) scaleApp(w http.ResponseWriter, req *http.Request) {
 
 			cfg := types.NewTaskConfig(spec)
 
-			if cfg.Network != "host" && cfg.Network != "bridge" {
+			if cfg.FixedIP() {
 				cfg.Parameters = append(cfg.Parameters, &types.Parameter{
 					Key:   "ip",
 					Value: ips[i],
@@ -545,6 +614,8 @@ func (r *Server) scaleApp(w http.ResponseWriter, req *http.Request) {
 				break
 			}
 
+			held.release(types.NewResourceUsage(spec, 1))
+
 			t := mesos.NewTask(
 				cfg,
 				id,
@@ -567,10 +638,10 @@ func (r *Server) scaleApp(w http.ResponseWriter, req *http.Request) {
 							return
 						}
 
-						task.Status = "Failed"
-						task.ErrMsg = err.Error()
-
-						if err = r.db.UpdateTask(app.ID, task); err != nil {
+						if err = store.UpdateTaskWith(r.db, app.ID, task, func(task *types.Task) {
+							task.Status = "Failed"
+							task.ErrMsg = err.Error()
+						}); err != nil {
 							log.Errorf("update task %s status got error: %v", task.ID, err)
 						}
 					}
@@ -607,6 +678,11 @@ func (r *Server) updateApp(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	if err := checkIfMatch(req, app.Revision); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
 	if app.OpStatus != types.OpStatusNoop {
 		http.Error(w, fmt.Sprintf("app status is %s, operation not allowed.", app.OpStatus), http.StatusMethodNotAllowed)
 		return
@@ -623,22 +699,44 @@ func (r *Server) updateApp(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	newVer.ID = fmt.Sprintf("%d", time.Now().UTC().UnixNano())
-	if err := r.db.CreateVersion(appId, newVer); err != nil {
-		http.Error(w, fmt.Sprintf("create app version failed: %v", err), http.StatusInternalServerError)
+	tasks, err := r.db.ListTasks(app.ID)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("list tasks got error for update app. %v", err), http.StatusInternalServerError)
 		return
 	}
 
-	tasks, err := r.db.ListTasks(app.ID)
+	// all of the tasks will be replaced by the new version
+	appUsed, err := store.AppUsage(r.db, app.ID)
 	if err != nil {
-		http.Error(w, fmt.Sprintf("list tasks got error for update app. %v", err), http.StatusInternalServerError)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	instances := len(tasks)
+	if newVer.IsDaemon() {
+		instances = r.driver.DaemonAgents(newVer.Constraints)
+	}
+
+	quotaReq := types.NewResourceUsage(newVer, instances)
+	quotaReq.Sub(appUsed)
+
+	reserved, herr := r.checkQuota(app.RunAs, quotaReq)
+	if herr != nil {
+		http.Error(w, herr.Error(), herr.StatusCode())
+		return
+	}
+	defer reserved.done()
+
+	newVer.ID = fmt.Sprintf("%d", time.Now().UTC().UnixNano())
+	if err := r.db.CreateVersion(appId, newVer); err != nil {
+		http.Error(w, fmt.Sprintf("create app version failed: %v", err), http.StatusInternalServerError)
 		return
 	}
 
 	app.OpStatus = types.OpStatusUpdating
 
 	if err := r.db.UpdateApp(app); err != nil {
-		http.Error(w, fmt.Sprintf("updating app opstatus to rolling-update got error: %v", err.Error), http.StatusInternalServerError)
+		http.Error(w, fmt.Sprintf("updating app opstatus to rolling-update got error: %v", err.Error), updateErrCode(req, err))
 		return
 	}
 
@@ -655,11 +753,17 @@ func (r *Server) updateApp(w http.ResponseWriter, req *http.Request) {
 
 	pending := tasks
 
+	// held until all of the tasks replaced.
+	held := reserved.handOver()
+
 	go func() {
+		defer held.done()
+
 		defer func() {
-			app.OpStatus = types.OpStatusNoop
-			app.Progress = 0
-			if err := r.db.UpdateApp(app); err != nil {
+			if err := store.UpdateAppWith(r.db, app, func(app *types.Application) {
+				app.OpStatus = types.OpStatusNoop
+				app.Progress = 0
+			}); err != nil {
 				log.Errorf("updating app status from updating to noop got error: %v", err)
 			}
 		}()
@@ -668,16 +772,17 @@ func (r *Server) updateApp(w http.ResponseWriter, req *http.Request) {
 
 		for _, t := range pending {
 			progress++
-			app.Progress = progress
-			if err := r.db.UpdateApp(app); err != nil {
+			if err := store.UpdateAppWith(r.db, app, func(app *types.Application) {
+				app.Progress = progress
+			}); err != nil {
 				log.Errorf("updating app progress got error: %v", err)
 			}
 
 			if err := r.driver.KillTask(t.ID, t.AgentId, true); err != nil {
-				t.Status = "Failed"
-				t.ErrMsg = fmt.Sprintf("kill task for updating :%v", err)
-
-				if err = r.db.UpdateTask(app.ID, t); err != nil {
+				if err = store.UpdateTaskWith(r.db, app.ID, t, func(t *types.Task) {
+					t.Status = "Failed"
+					t.ErrMsg = fmt.Sprintf("kill task for updating :%v", err)
+				}); err != nil {
 					log.Errorf("update task %s got error: %v", t.ID, err)
 				}
 
@@ -719,10 +824,10 @@ func (r *Server) updateApp(w http.ResponseWriter, req *http.Request) {
 			if err != nil {
 				log.Errorf("launch task %s got error: %v", id, err)
 
-				task.Status = "Failed"
-				task.ErrMsg = err.Error()
-
-				if err = r.db.UpdateTask(appId, task); err != nil {
+				if err = store.UpdateTaskWith(r.db, appId, task, func(task *types.Task) {
+					task.Status = "Failed"
+					task.ErrMsg = err.Error()
+				}); err != nil {
 					log.Errorf("update task %s got error: %v", id, err)
 				}
 
@@ -739,8 +844,9 @@ func (r *Server) updateApp(w http.ResponseWriter, req *http.Request) {
 
 				task, err := r.db.GetTask(appId, taskId)
 				if err == nil {
-					task.OpStatus = types.OpStatusNoop
-					if err = r.db.UpdateTask(appId, task); err != nil {
+					if err = store.UpdateTaskWith(r.db, appId, task, func(task *types.Task) {
+						task.OpStatus = types.OpStatusNoop
+					}); err != nil {
 						log.Errorf("update task %s got error: %v", id, err)
 					}
 				}
@@ -769,6 +875,11 @@ func (r *Server) canaryUpdate(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	if err := checkIfMatch(req, app.Revision); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
 	if app.OpStatus != types.OpStatusNoop {
 		http.Error(w, fmt.Sprintf("app status is %s, operation not allowed.", app.OpStatus), http.StatusMethodNotAllowed)
 		return
@@ -824,13 +935,6 @@ func (r *Server) canaryUpdate(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	app.OpStatus = types.OpStatusUpdating
-
-	if err := r.db.UpdateApp(app); err != nil {
-		http.Error(w, fmt.Sprintf("updating app opstatus to rolling-update got error: %v", err.Error), http.StatusInternalServerError)
-		return
-	}
-
 	types.TaskList(tasks).Sort() // TODO
 
 	new := 0
@@ -856,20 +960,50 @@ func (r *Server) canaryUpdate(w http.ResponseWriter, req *http.Request) {
 
 	pending := tasks[new:goal]
 
+	// the pending tasks will be replaced by the new version.
+	pendingUsed, err := store.TasksUsage(r.db, app.ID, pending)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	quotaReq := types.NewResourceUsage(newVer, len(pending))
+	quotaReq.Sub(pendingUsed)
+
+	reserved, herr := r.checkQuota(app.RunAs, quotaReq)
+	if herr != nil {
+		http.Error(w, herr.Error(), herr.StatusCode())
+		return
+	}
+	defer reserved.done()
+
+	app.OpStatus = types.OpStatusUpdating
+
+	if err := r.db.UpdateApp(app); err != nil {
+		http.Error(w, fmt.Sprintf("updating app opstatus to rolling-update got error: %v", err.Error), updateErrCode(req, err))
+		return
+	}
+
+	// held until all of the pending tasks replaced.
+	held := reserved.handOver()
+
 	go func() {
+		defer held.done()
+
 		defer func() {
-			app.OpStatus = types.OpStatusNoop
-			if err := r.db.UpdateApp(app); err != nil {
+			if err := store.UpdateAppWith(r.db, app, func(app *types.Application) {
+				app.OpStatus = types.OpStatusNoop
+			}); err != nil {
 				log.Errorf("updating app status from updating to noop got error: %v", err)
 			}
 		}()
 
 		for _, t := range pending {
 			if err := r.driver.KillTask(t.ID, t.AgentId, true); err != nil {
-				t.Status = "Failed"
-				t.ErrMsg = fmt.Sprintf("kill task for updating :%v", err)
-
-				if err = r.db.UpdateTask(app.ID, t); err != nil {
+				if err = store.UpdateTaskWith(r.db, app.ID, t, func(t *types.Task) {
+					t.Status = "Failed"
+					t.ErrMsg = fmt.Sprintf("kill task for updating :%v", err)
+				}); err != nil {
 					log.Errorf("update task %s got error: %v", t.ID, err)
 				}
 
@@ -911,10 +1045,10 @@ func (r *Server) canaryUpdate(w http.ResponseWriter, req *http.Request) {
 			if err != nil {
 				log.Errorf("launch task %s got error: %v", id, err)
 
-				task.Status = "Failed"
-				task.ErrMsg = err.Error()
-
-				if err = r.db.UpdateTask(appId, task); err != nil {
+				if err = store.UpdateTaskWith(r.db, appId, task, func(task *types.Task) {
+					task.Status = "Failed"
+					task.ErrMsg = err.Error()
+				}); err != nil {
 					log.Errorf("update task %s got error: %v", id, err)
 				}
 
@@ -931,8 +1065,9 @@ func (r *Server) canaryUpdate(w http.ResponseWriter, req *http.Request) {
 
 				task, err := r.db.GetTask(appId, taskId)
 				if err == nil {
-					task.OpStatus = types.OpStatusNoop
-					if err = r.db.UpdateTask(appId, task); err != nil {
+					if err = store.UpdateTaskWith(r.db, appId, task, func(task *types.Task) {
+						task.OpStatus = types.OpStatusNoop
+					}); err != nil {
 						log.Errorf("update task %s got error: %v", id, err)
 					}
 				}
@@ -966,6 +1101,11 @@ func (r *Server) rollback(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	if err := checkIfMatch(req, app.Revision); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
 	if app.OpStatus != types.OpStatusNoop {
 		http.Error(w, fmt.Sprintf("app status is %s, operation not allowed.", app.OpStatus), http.StatusMethodNotAllowed)
 		return
@@ -983,13 +1123,6 @@ func (r *Server) rollback(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	app.OpStatus = types.OpStatusRollback
-
-	if err := r.db.UpdateApp(app); err != nil {
-		http.Error(w, fmt.Sprintf("updating app opstatus to rolling-back got error: %v", err.Error), http.StatusInternalServerError)
-		return
-	}
-
 	verId := req.Form.Get("version")
 
 	var desired *types.Version
@@ -1021,23 +1154,58 @@ func (r *Server) rollback(w http.ResponseWriter, req *http.Request) {
 		}
 	}
 
+	if desired == nil {
+		http.Error(w, "no version to rollback", http.StatusInternalServerError)
+		return
+	}
+
+	// all of the tasks will be replaced by the desired version.
+	appUsed, err := store.AppUsage(r.db, app.ID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	quotaReq := types.NewResourceUsage(desired, len(tasks))
+	quotaReq.Sub(appUsed)
+
+	reserved, herr := r.checkQuota(app.RunAs, quotaReq)
+	if herr != nil {
+		http.Error(w, herr.Error(), herr.StatusCode())
+		return
+	}
+	defer reserved.done()
+
+	app.OpStatus = types.OpStatusRollback
+
+	if err := r.db.UpdateApp(app); err != nil {
+		http.Error(w, fmt.Sprintf("updating app opstatus to rolling-back got error: %v", err.Error), updateErrCode(req, err))
+		return
+	}
+
 	// TODO
 	types.TaskList(tasks).Reverse()
 
+	// held until all of the tasks replaced.
+	held := reserved.handOver()
+
 	go func() {
+		defer held.done()
+
 		defer func() {
-			app.OpStatus = types.OpStatusNoop
-			if err := r.db.UpdateApp(app); err != nil {
+			if err := store.UpdateAppWith(r.db, app, func(app *types.Application) {
+				app.OpStatus = types.OpStatusNoop
+			}); err != nil {
 				log.Errorf("updating app status from rollback to noop got error: %v", err)
 			}
 		}()
 
 		for _, t := range tasks {
 			if err := r.driver.KillTask(t.ID, t.AgentId, true); err != nil {
-				t.Status = "Failed"
-				t.ErrMsg = fmt.Sprintf("kill task for rollback :%v", err)
-
-				if err = r.db.UpdateTask(appId, t); err != nil {
+				if err = store.UpdateTaskWith(r.db, appId, t, func(t *types.Task) {
+					t.Status = "Failed"
+					t.ErrMsg = fmt.Sprintf("kill task for rollback :%v", err)
+				}); err != nil {
 					log.Errorf("update task %s got error: %v", t.ID, err)
 				}
 
@@ -1079,10 +1247,10 @@ func (r *Server) rollback(w http.ResponseWriter, req *http.Request) {
 			if err != nil {
 				log.Errorf("launch task %s got error: %v", task.ID, err)
 
-				task.Status = "Failed"
-				task.ErrMsg = fmt.Sprintf("launch task failed: %v", err)
-
-				if err = r.db.UpdateTask(appId, task); err != nil {
+				if err = store.UpdateTaskWith(r.db, appId, task, func(task *types.Task) {
+					task.Status = "Failed"
+					task.ErrMsg = fmt.Sprintf("launch task failed: %v", err)
+				}); err != nil {
 					log.Errorf("update task %s got error: %v", task.ID, err)
 				}
 
@@ -1123,6 +1291,20 @@ func (r *Server) updateWeights(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	if err := checkIfMatch(req, app.Revision); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	// the app is written by the revision matched, so the weights are never set on
+	// the app changed since.
+	if hasIfMatch(req) {
+		if err := r.db.UpdateApp(app); err != nil {
+			http.Error(w, err.Error(), updateErrCode(req, err))
+			return
+		}
+	}
+
 	tasks, err := r.db.ListTasks(app.ID)
 	if err != nil {
 		http.Error(w, fmt.Sprintf("list tasks got error for update weights. %v", err), http.StatusInternalServerError)
@@ -1132,9 +1314,9 @@ func (r *Server) updateWeights(w http.ResponseWriter, req *http.Request) {
 	for n, weight := range weights {
 		for _, task := range tasks {
 			if task.Index() == n {
-				task.Weight = weight
-
-				if err := r.db.UpdateTask(appId, task); err != nil {
+				if err := store.UpdateTaskWith(r.db, appId, task, func(task *types.Task) {
+					task.Weight = weight
+				}); err != nil {
 					http.Error(w, err.Error(), http.StatusInternalServerError)
 					return
 				}
@@ -1171,6 +1353,7 @@ func (r *Server) getTask(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	setETag(w, task.Revision)
 	writeJSON(w, http.StatusOK, task)
 }
 
@@ -1193,10 +1376,15 @@ func (r *Server) updateWeight(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	task.Weight = body.Weight
+	if err := checkIfMatch(req, task.Revision); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
 
-	if err := r.db.UpdateTask(appId, task); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+	if err := updateTaskIfMatch(r.db, req, appId, task, func(task *types.Task) {
+		task.Weight = body.Weight
+	}); err != nil {
+		http.Error(w, err.Error(), updateErrCode(req, err))
 		return
 	}
 
@@ -1308,6 +1496,20 @@ func (r *Server) deleteTask(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	if err := checkIfMatch(req, task.Revision); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	// the task is written by the revision matched, so the task changed since is
+	// never killed.
+	if hasIfMatch(req) {
+		if err := r.db.UpdateTask(appId, task); err != nil {
+			http.Error(w, err.Error(), updateErrCode(req, err))
+			return
+		}
+	}
+
 	if err := r.driver.KillTask(task.ID, task.AgentId, false); err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -1349,8 +1551,9 @@ func (r *Server) deleteTasks(w http.ResponseWriter, req *http.Request) {
 			if err := r.driver.KillTask(task.ID, task.AgentId, false); err != nil {
 				log.Errorf("Kill task %s got error: %v", task.ID, err)
 
-				task.OpStatus = fmt.Sprintf("kill task error: %v", err)
-				if err = r.db.UpdateTask(appId, task); err != nil {
+				if err = store.UpdateTaskWith(r.db, appId, task, func(task *types.Task) {
+					task.OpStatus = fmt.Sprintf("kill task error: %v", err)
+				}); err != nil {
 					log.Errorf("update task %s got error: %v", task.Name, err)
 				}
 
@@ -1360,8 +1563,9 @@ func (r *Server) deleteTasks(w http.ResponseWriter, req *http.Request) {
 			if err := r.db.DeleteTask(task.ID); err != nil {
 				log.Errorf("Kill task %s got error: %v", task.ID, err)
 
-				task.OpStatus = fmt.Sprintf("delete task error: %v", err)
-				if err = r.db.UpdateTask(appId, task); err != nil {
+				if err = store.UpdateTaskWith(r.db, appId, task, func(task *types.Task) {
+					task.OpStatus = fmt.Sprintf("delete task error: %v", err)
+				}); err != nil {
 					log.Errorf("update task %s got error: %v", task.Name, err)
 				}
 
@@ -1404,11 +1608,31 @@ func (r *Server) updateTask(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	if err := r.driver.KillTask(t.ID, t.AgentId, true); err != nil {
-		t.Status = "Failed"
-		t.ErrMsg = fmt.Sprintf("kill task for updating :%v", err)
+	if err := checkIfMatch(req, t.Revision); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
 
-		if err = r.db.UpdateTask(appId, t); err != nil {
+	// written by the revision matched as deleteTask.
+	if hasIfMatch(req) {
+		if err := r.db.UpdateTask(appId, t); err != nil {
+			http.Error(w, err.Error(), updateErrCode(req, err))
+			return
+		}
+	}
+
+	reserved, herr := r.checkTaskQuota(appId, t, &version)
+	if herr != nil {
+		http.Error(w, herr.Error(), herr.StatusCode())
+		return
+	}
+	defer reserved.done()
+
+	if err := r.driver.KillTask(t.ID, t.AgentId, true); err != nil {
+		if err = store.UpdateTaskWith(r.db, appId, t, func(t *types.Task) {
+			t.Status = "Failed"
+			t.ErrMsg = fmt.Sprintf("kill task for updating :%v", err)
+		}); err != nil {
 			log.Errorf("update task %s got error: %v", t.ID, err)
 		}
 
@@ -1449,10 +1673,10 @@ func (r *Server) updateTask(w http.ResponseWriter, req *http.Request) {
 	if err != nil {
 		log.Errorf("launch task %s got error: %v", task.ID, err)
 
-		task.Status = "Failed"
-		task.ErrMsg = fmt.Sprintf("launch task failed: %v", err)
-
-		if err = r.db.UpdateTask(appId, task); err != nil {
+		if err = store.UpdateTaskWith(r.db, appId, task, func(task *types.Task) {
+			task.Status = "Failed"
+			task.ErrMsg = fmt.Sprintf("launch task failed: %v", err)
+		}); err != nil {
 			log.Errorf("update task %s got error: %v", t.ID, err)
 		}
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -1502,13 +1726,14 @@ func (r *Server) rollbackTask(w http.ResponseWriter, req *http.Request) {
 	app.OpStatus = types.OpStatusRollback
 
 	if err := r.db.UpdateApp(app); err != nil {
-		http.Error(w, fmt.Sprintf("updating app opstatus to rolling-back got error: %v", err.Error), http.StatusInternalServerError)
+		http.Error(w, fmt.Sprintf("updating app opstatus to rolling-back got error: %v", err.Error), updateErrCode(req, err))
 		return
 	}
 
 	defer func() {
-		app.OpStatus = types.OpStatusNoop
-		if err := r.db.UpdateApp(app); err != nil {
+		if err := store.UpdateAppWith(r.db, app, func(app *types.Application) {
+			app.OpStatus = types.OpStatusNoop
+		}); err != nil {
 			log.Errorf("updating app status from rollback to noop got error: %v", err)
 		}
 	}()
@@ -1551,11 +1776,31 @@ func (r *Server) rollbackTask(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	if err := r.driver.KillTask(t.ID, t.AgentId, true); err != nil {
-		t.Status = "Failed"
-		t.ErrMsg = fmt.Sprintf("kill task for rollback :%v", err)
+	if err := checkIfMatch(req, t.Revision); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	// written by the revision matched as deleteTask.
+	if hasIfMatch(req) {
+		if err := r.db.UpdateTask(appId, t); err != nil {
+			http.Error(w, err.Error(), updateErrCode(req, err))
+			return
+		}
+	}
 
-		if err = r.db.UpdateTask(appId, t); err != nil {
+	reserved, herr := r.checkTaskQuota(appId, t, desired)
+	if herr != nil {
+		http.Error(w, herr.Error(), herr.StatusCode())
+		return
+	}
+	defer reserved.done()
+
+	if err := r.driver.KillTask(t.ID, t.AgentId, true); err != nil {
+		if err = store.UpdateTaskWith(r.db, appId, t, func(t *types.Task) {
+			t.Status = "Failed"
+			t.ErrMsg = fmt.Sprintf("kill task for rollback :%v", err)
+		}); err != nil {
 			log.Errorf("update task %s got error: %v", t.ID, err)
 		}
 
@@ -1597,10 +1842,10 @@ func (r *Server) rollbackTask(w http.ResponseWriter, req *http.Request) {
 	if err != nil {
 		log.Errorf("launch task %s got error: %v", task.ID, err)
 
-		task.Status = "Failed"
-		task.ErrMsg = fmt.Sprintf("launch task failed: %v", err)
-
-		if err = r.db.UpdateTask(appId, task); err != nil {
+		if err = store.UpdateTaskWith(r.db, appId, task, func(task *types.Task) {
+			task.Status = "Failed"
+			task.ErrMsg = fmt.Sprintf("launch task failed: %v", err)
+		}); err != nil {
 			log.Errorf("update task %s got error: %v", t.ID, err)
 		}
 		http.Error(w, err.Error(), http.StatusInternalServerError)
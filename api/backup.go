@@ -0,0 +1,68 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+
+	"github.com/Dataman-Cloud/swan/store"
+)
+
+func (r *Server) backup(w http.ResponseWriter, req *http.Request) {
+	name := fmt.Sprintf("swan-backup-%s.json", time.Now().Format("20060102150405"))
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", name))
+	w.WriteHeader(http.StatusOK)
+
+	// the status is sent already, the archive is left truncated without the end record on
+	// error, which is refused to restore.
+	if err := store.Backup(r.db, w); err != nil {
+		log.Errorf("backup got error: %v", err)
+	}
+}
+
+func (r *Server) restore(w http.ResponseWriter, req *http.Request) {
+	// the body is the archive, never parsed as the form.
+	var opts store.RestoreOptions
+	if v := req.URL.Query().Get("skip_tasks"); v != "" {
+		skip, err := strconv.ParseBool(v)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid skip_tasks: %v", err), http.StatusBadRequest)
+			return
+		}
+		opts.SkipTasks = skip
+	}
+
+	if v := req.URL.Query().Get("resume"); v != "" {
+		resume, err := strconv.ParseBool(v)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid resume: %v", err), http.StatusBadRequest)
+			return
+		}
+		opts.Resume = resume
+	}
+
+	counts, err := store.Restore(r.db, req.Body, &opts)
+	if err != nil {
+		code := http.StatusInternalServerError
+		switch {
+		case store.IsNotEmpty(err):
+			code = http.StatusConflict
+		case strings.Contains(err.Error(), "malformed backup"):
+			code = http.StatusBadRequest
+		}
+
+		log.Errorf("restore got error: %v, restored %v", err, counts)
+		http.Error(w, err.Error(), code)
+		return
+	}
+
+	log.Printf("restored %v, skip tasks: %v, resume: %v", counts, opts.SkipTasks, opts.Resume)
+
+	writeJSON(w, http.StatusOK, counts)
+}
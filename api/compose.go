@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/Dataman-Cloud/swan/mesos"
+	"github.com/Dataman-Cloud/swan/store"
 	"github.com/Dataman-Cloud/swan/types"
 	"github.com/Dataman-Cloud/swan/utils"
 	log "github.com/Sirupsen/logrus"
@@ -57,6 +58,26 @@ func (r *Server) newCompose(w http.ResponseWriter, req *http.Request) {
 		}
 	}
 
+	// quota
+	quotaReq := &types.ResourceUsage{}
+	for name, srv := range cps.ServiceGroup {
+		ver, err := srv.ToVersion(cps.Name, r.driver.ClusterName())
+		if err != nil {
+			http.Error(w, fmt.Sprintf("convert service %s to version: %v", name, err), http.StatusBadRequest)
+			return
+		}
+
+		quotaReq.Add(types.NewResourceUsage(ver, int(ver.Instances)))
+		quotaReq.Apps++
+	}
+
+	reserved, herr := r.checkQuota(runAs, quotaReq)
+	if herr != nil {
+		http.Error(w, herr.Error(), herr.StatusCode())
+		return
+	}
+	defer reserved.done()
+
 	// db save
 	cps.ID = uuid.NewV4().String()
 	cps.DisplayName = fmt.Sprintf("%s.%s.%s", cps.Name, runAs, r.driver.ClusterName())
@@ -69,13 +90,20 @@ func (r *Server) newCompose(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	rev := cps.Revision // as created, the compose is changed by the launching after
+
 	srvOrders, err := cps.ServiceGroup.PrioritySort()
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
 
+	// held until all of the apps and tasks created.
+	held := reserved.handOver()
+
 	go func() {
+		defer held.done()
+
 		for _, srv := range srvOrders {
 			ver, err := cps.ServiceGroup[srv].ToVersion(cps.Name, r.driver.ClusterName())
 			if err != nil {
@@ -93,6 +121,7 @@ func (r *Server) newCompose(w http.ResponseWriter, req *http.Request) {
 				Name:      ver.Name,
 				Alias:     ver.Proxy.Alias,
 				RunAs:     ver.RunAs,
+				Priority:  int(ver.Priority),
 				Cluster:   r.driver.ClusterName(),
 				Status:    "creating",
 				CreatedAt: time.Now(),
@@ -126,7 +155,7 @@ func (r *Server) newCompose(w http.ResponseWriter, req *http.Request) {
 
 				cfg := types.NewTaskConfig(ver)
 
-				if cfg.Network != "host" && cfg.Network != "bridge" {
+				if cfg.FixedIP() {
 					cfg.Parameters = append(cfg.Parameters, &types.Parameter{
 						Key:   "ip",
 						Value: ver.IPs[i],
@@ -141,6 +170,7 @@ func (r *Server) newCompose(w http.ResponseWriter, req *http.Request) {
 					Weight:  100,
 					Status:  "creating",
 					Healthy: types.TaskHealthyUnset,
+					Version: vid,
 					Created: time.Now(),
 					Updated: time.Now(),
 				}
@@ -162,10 +192,10 @@ func (r *Server) newCompose(w http.ResponseWriter, req *http.Request) {
 				if err != nil {
 					log.Errorf("launch task %s got error: %v", id, err)
 
-					task.Status = "Failed"
-					task.ErrMsg = err.Error()
-
-					if err = r.db.UpdateTask(app.ID, task); err != nil {
+					if err = store.UpdateTaskWith(r.db, app.ID, task, func(task *types.Task) {
+						task.Status = "Failed"
+						task.ErrMsg = err.Error()
+					}); err != nil {
 						log.Errorf("update task %s got error: %v", id, err)
 					}
 
@@ -184,6 +214,7 @@ func (r *Server) newCompose(w http.ResponseWriter, req *http.Request) {
 		}
 	}()
 
+	setETag(w, rev)
 	writeJSON(w, http.StatusAccepted, "accepted")
 }
 
@@ -244,6 +275,7 @@ func (r *Server) getCompose(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	setETag(w, cps.Revision)
 	writeJSON(w, http.StatusOK, cps)
 }
 
@@ -0,0 +1,148 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Dataman-Cloud/swan/types"
+	"github.com/gorilla/mux"
+)
+
+func (r *Server) createCronJob(w http.ResponseWriter, req *http.Request) {
+	if err := checkForJSON(req); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var cronJob types.CronJob
+	if err := decode(req.Body, &cronJob); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	cronJob.Cluster = r.driver.ClusterName()
+
+	if err := cronJob.Validate(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	cronJob.ID = fmt.Sprintf("%s.%s.%s", cronJob.Name, cronJob.RunAs, cronJob.Cluster)
+	cronJob.LastScheduleTime = time.Time{}
+	cronJob.CreatedAt = time.Now()
+	cronJob.UpdatedAt = time.Now()
+
+	if err := r.db.CreateCronJob(&cronJob); err != nil {
+		if strings.Contains(err.Error(), "already exists") {
+			http.Error(w, fmt.Sprintf("cron job %s has already exists", cronJob.ID), http.StatusConflict)
+			return
+		}
+
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, map[string]string{"id": cronJob.ID})
+}
+
+func (r *Server) listCronJobs(w http.ResponseWriter, req *http.Request) {
+	cronJobs, err := r.db.ListCronJobs()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, cronJobs)
+}
+
+func (r *Server) getCronJob(w http.ResponseWriter, req *http.Request) {
+	id := mux.Vars(req)["cronjob_id"]
+
+	cronJob, err := r.db.GetCronJob(id)
+	if err != nil {
+		if strings.Contains(err.Error(), "not exists") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, cronJob)
+}
+
+func (r *Server) updateCronJob(w http.ResponseWriter, req *http.Request) {
+	id := mux.Vars(req)["cronjob_id"]
+
+	prev, err := r.db.GetCronJob(id)
+	if err != nil {
+		if strings.Contains(err.Error(), "not exists") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var cronJob types.CronJob
+	if err := decode(req.Body, &cronJob); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// the identity can't be changed.
+	cronJob.ID = prev.ID
+	cronJob.Name = prev.Name
+	cronJob.RunAs = prev.RunAs
+	cronJob.Cluster = prev.Cluster
+
+	if err := cronJob.Validate(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	cronJob.LastScheduleTime = prev.LastScheduleTime
+	cronJob.CreatedAt = prev.CreatedAt
+	cronJob.UpdatedAt = time.Now()
+
+	if err := r.db.UpdateCronJob(&cronJob); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusAccepted, "accepted")
+}
+
+// deleteCronJob deletes the cron job and its finished jobs, the running
+// jobs are left to finish.
+func (r *Server) deleteCronJob(w http.ResponseWriter, req *http.Request) {
+	id := mux.Vars(req)["cronjob_id"]
+
+	if err := r.db.DeleteCronJob(id); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	jobs, err := r.db.ListJobs()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	for _, job := range jobs {
+		if job.CronJob != id || !job.Finished() {
+			continue
+		}
+
+		if err := r.db.DeleteJob(job.ID); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	writeJSON(w, http.StatusNoContent, "")
+}
@@ -12,6 +12,18 @@ type Driver interface {
 	KillTask(string, string, bool) error
 	LaunchTasks([]*mesos.Task) (map[string]error, error)
 
+	QueuedLaunches() []*types.QueuedLaunch
+	CancelLaunch(id string) error
+	UpdateLaunchPriority(id string, priority int32) error
+	CancelAppLaunches(appId string, taskIds ...string) int
+
+	RunJob(job *types.Job) error
+	StopJob(id string) error
+
+	ReleaseReservation(id string, force bool) error
+
+	DaemonAgents(constraints []*types.Constraint) int
+
 	ClusterName() string
 
 	SubscribeEvent(http.ResponseWriter, string) error
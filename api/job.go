@@ -0,0 +1,157 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Dataman-Cloud/swan/types"
+	"github.com/gorilla/mux"
+)
+
+func (r *Server) createJob(w http.ResponseWriter, req *http.Request) {
+	if err := checkForJSON(req); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var job types.Job
+	if err := decode(req.Body, &job); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := job.Validate(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	job.Cluster = r.driver.ClusterName()
+	job.ID = fmt.Sprintf("%s.%s.%s", job.Name, job.RunAs, job.Cluster)
+	job.CronJob = ""
+	job.Status = types.JobPending
+	job.ErrMsg = ""
+	job.Attempts = make([]*types.JobAttempt, 0)
+	job.CreatedAt = time.Now()
+	job.StartedAt = time.Time{}
+	job.FinishedAt = time.Time{}
+
+	// the job runs one task at a time, counted until finished.
+	reserved, herr := r.checkQuota(job.RunAs, types.NewResourceUsage(job.Template, 1))
+	if herr != nil {
+		http.Error(w, herr.Error(), herr.StatusCode())
+		return
+	}
+	defer reserved.done()
+
+	if err := r.db.CreateJob(&job); err != nil {
+		if strings.Contains(err.Error(), "already exists") {
+			http.Error(w, fmt.Sprintf("job %s has already exists", job.ID), http.StatusConflict)
+			return
+		}
+
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := r.driver.RunJob(&job); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, map[string]string{"id": job.ID})
+}
+
+func (r *Server) listJobs(w http.ResponseWriter, req *http.Request) {
+	if err := req.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	jobs, err := r.db.ListJobs()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var (
+		cronJob = req.Form.Get("cronJob")
+		status  = req.Form.Get("status")
+		ret     = make([]*types.Job, 0, len(jobs))
+	)
+
+	for _, job := range jobs {
+		if cronJob != "" && job.CronJob != cronJob {
+			continue
+		}
+
+		if status != "" && job.Status != status {
+			continue
+		}
+
+		ret = append(ret, job)
+	}
+
+	writeJSON(w, http.StatusOK, ret)
+}
+
+func (r *Server) getJob(w http.ResponseWriter, req *http.Request) {
+	id := mux.Vars(req)["job_id"]
+
+	job, err := r.db.GetJob(id)
+	if err != nil {
+		if strings.Contains(err.Error(), "not exists") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, job)
+}
+
+func (r *Server) stopJob(w http.ResponseWriter, req *http.Request) {
+	id := mux.Vars(req)["job_id"]
+
+	if err := r.driver.StopJob(id); err != nil {
+		if strings.Contains(err.Error(), "not running") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusAccepted, "accepted")
+}
+
+func (r *Server) deleteJob(w http.ResponseWriter, req *http.Request) {
+	id := mux.Vars(req)["job_id"]
+
+	job, err := r.db.GetJob(id)
+	if err != nil {
+		if strings.Contains(err.Error(), "not exists") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if !job.Finished() {
+		http.Error(w, fmt.Sprintf("job %s is %s, stop it first", id, job.Status), http.StatusConflict)
+		return
+	}
+
+	if err := r.db.DeleteJob(id); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusNoContent, "")
+}
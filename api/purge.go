@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"sync"
 
+	"github.com/Dataman-Cloud/swan/store"
 	"github.com/Dataman-Cloud/swan/types"
 	log "github.com/Sirupsen/logrus"
 )
@@ -27,6 +28,8 @@ func (r *Server) purge(w http.ResponseWriter, req *http.Request) {
 				wg       sync.WaitGroup
 			)
 
+			r.driver.CancelAppLaunches(app.ID)
+
 			tasks, err := r.db.ListTasks(app.ID)
 			if err != nil {
 				log.Errorf("list app tasks got error for purge: %v", err)
@@ -51,8 +54,9 @@ func (r *Server) purge(w http.ResponseWriter, req *http.Request) {
 
 						hasError = true
 
-						task.OpStatus = fmt.Sprintf("kill task error: %v", err)
-						if err = r.db.UpdateTask(appId, task); err != nil {
+						if err = store.UpdateTaskWith(r.db, appId, task, func(task *types.Task) {
+							task.OpStatus = fmt.Sprintf("kill task error: %v", err)
+						}); err != nil {
 							log.Errorf("update task %s got error: %v", task.Name, err)
 						}
 
@@ -64,8 +68,9 @@ func (r *Server) purge(w http.ResponseWriter, req *http.Request) {
 
 						hasError = true
 
-						task.OpStatus = fmt.Sprintf("delete task error: %v", err)
-						if err = r.db.UpdateTask(appId, task); err != nil {
+						if err = store.UpdateTaskWith(r.db, appId, task, func(task *types.Task) {
+							task.OpStatus = fmt.Sprintf("delete task error: %v", err)
+						}); err != nil {
 							log.Errorf("update task %s got error: %v", task.Name, err)
 						}
 
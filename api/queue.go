@@ -0,0 +1,57 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Dataman-Cloud/swan/types"
+	"github.com/gorilla/mux"
+)
+
+func (r *Server) listQueue(w http.ResponseWriter, req *http.Request) {
+	writeJSON(w, http.StatusOK, r.driver.QueuedLaunches())
+}
+
+func (r *Server) cancelLaunch(w http.ResponseWriter, req *http.Request) {
+	id := mux.Vars(req)["launch_id"]
+
+	if err := r.driver.CancelLaunch(id); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusNoContent, "")
+}
+
+func (r *Server) updateLaunchPriority(w http.ResponseWriter, req *http.Request) {
+	id := mux.Vars(req)["launch_id"]
+
+	var body types.UpdateLaunchPriorityBody
+	if err := decode(req.Body, &body); err != nil {
+		http.Error(w, fmt.Sprintf("decode priority param error: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	if err := r.driver.UpdateLaunchPriority(id, body.Priority); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+
+		if strings.Contains(err.Error(), "is dispatching") {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusAccepted, "accepted")
+}
@@ -0,0 +1,294 @@
+package api
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Dataman-Cloud/swan/store"
+	"github.com/Dataman-Cloud/swan/types"
+	"github.com/gorilla/mux"
+)
+
+func (r *Server) createQuota(w http.ResponseWriter, req *http.Request) {
+	if err := checkForJSON(req); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var quota types.Quota
+	if err := decode(req.Body, &quota); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := quota.Valid(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	quota.CreatedAt = time.Now()
+	quota.UpdatedAt = time.Now()
+
+	if err := r.db.CreateQuota(&quota); err != nil {
+		if strings.Contains(err.Error(), "already exists") {
+			http.Error(w, fmt.Sprintf("quota %s has already exists", quota.RunAs), http.StatusConflict)
+			return
+		}
+
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, map[string]string{"runAs": quota.RunAs})
+}
+
+func (r *Server) listQuotas(w http.ResponseWriter, req *http.Request) {
+	quotas, err := r.db.ListQuotas()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	ret := make([]*types.QuotaStatus, 0, len(quotas))
+	for _, quota := range quotas {
+		used, err := store.QuotaUsage(r.db, quota.RunAs)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		ret = append(ret, &types.QuotaStatus{Quota: quota, Used: used})
+	}
+
+	writeJSON(w, http.StatusOK, ret)
+}
+
+func (r *Server) getQuota(w http.ResponseWriter, req *http.Request) {
+	runAs := mux.Vars(req)["run_as"]
+
+	quota, err := r.db.GetQuota(runAs)
+	if err != nil {
+		if strings.Contains(err.Error(), "not exists") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	used, err := store.QuotaUsage(r.db, runAs)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, &types.QuotaStatus{Quota: quota, Used: used})
+}
+
+func (r *Server) updateQuota(w http.ResponseWriter, req *http.Request) {
+	runAs := mux.Vars(req)["run_as"]
+
+	prev, err := r.db.GetQuota(runAs)
+	if err != nil {
+		if strings.Contains(err.Error(), "not exists") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var quota types.Quota
+	if err := decode(req.Body, &quota); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	quota.RunAs = runAs
+
+	if err := quota.Valid(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	quota.CreatedAt = prev.CreatedAt
+	quota.UpdatedAt = time.Now()
+
+	if err := r.db.UpdateQuota(&quota); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusAccepted, "accepted")
+}
+
+func (r *Server) deleteQuota(w http.ResponseWriter, req *http.Request) {
+	runAs := mux.Vars(req)["run_as"]
+
+	if err := r.db.DeleteQuota(runAs); err != nil {
+		if strings.Contains(err.Error(), "not exists") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusNoContent, "")
+}
+
+// quotaHold is the resources of the runAs's quota reserved by the operations, but not
+// written to the store yet. The quota of one runAs is checked and reserved with it
+// locked, so the concurrent operations can't both pass.
+type quotaHold struct {
+	sync.Mutex
+	reserved types.ResourceUsage
+}
+
+// quotaReservation is the resources reserved by one operation, they're released as
+// the apps and tasks written and counted by store.QuotaUsage, the rest released by done.
+// The nil reservation of the runAs without any quota does nothing.
+type quotaReservation struct {
+	hold *quotaHold
+	left types.ResourceUsage
+}
+
+func (r *Server) quotaHold(runAs string) *quotaHold {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.quotaHolds == nil {
+		r.quotaHolds = make(map[string]*quotaHold)
+	}
+
+	h, ok := r.quotaHolds[runAs]
+	if !ok {
+		h = &quotaHold{}
+		r.quotaHolds[runAs] = h
+	}
+
+	return h
+}
+
+// checkQuota verifies the requested resources against the runAs's quota and reserves
+// them, the runAs without any quota is unlimited. The caller releases the reservation
+// once the resources are written.
+func (r *Server) checkQuota(runAs string, req *types.ResourceUsage) (*quotaReservation, *httpError) {
+	h := r.quotaHold(runAs)
+
+	h.Lock()
+	defer h.Unlock()
+
+	quota, err := r.db.GetQuota(runAs)
+	if err != nil {
+		if strings.Contains(err.Error(), "not exists") {
+			return nil, nil
+		}
+
+		return nil, &httpError{err.Error(), http.StatusInternalServerError}
+	}
+
+	used, err := store.QuotaUsage(r.db, runAs)
+	if err != nil {
+		return nil, &httpError{err.Error(), http.StatusInternalServerError}
+	}
+
+	used.Add(&h.reserved)
+
+	if err := quota.Check(used, req); err != nil {
+		return nil, &httpError{err.Error(), http.StatusForbidden}
+	}
+
+	// the resources freed, eg: by the update to a smaller version, are not freed
+	// until written.
+	res := &quotaReservation{hold: h, left: *maxUsage(req, &types.ResourceUsage{})}
+
+	h.reserved.Add(&res.left)
+
+	return res, nil
+}
+
+// checkTaskQuota checks and reserves the quota for the task replaced by the version.
+func (r *Server) checkTaskQuota(appId string, task *types.Task, ver *types.Version) (*quotaReservation, *httpError) {
+	app, err := r.db.GetApp(appId)
+	if err != nil {
+		return nil, &httpError{err.Error(), http.StatusInternalServerError}
+	}
+
+	used, err := store.TasksUsage(r.db, appId, []*types.Task{task})
+	if err != nil {
+		return nil, &httpError{err.Error(), http.StatusInternalServerError}
+	}
+
+	req := types.NewResourceUsage(ver, 1)
+	req.Sub(used)
+
+	return r.checkQuota(app.RunAs, req)
+}
+
+// release releases the part of the reservation written, no more than left.
+func (q *quotaReservation) release(written *types.ResourceUsage) {
+	if q == nil {
+		return
+	}
+
+	q.hold.Lock()
+	defer q.hold.Unlock()
+
+	part := minUsage(written, &q.left)
+
+	q.left.Sub(part)
+	q.hold.reserved.Sub(part)
+}
+
+// done releases the rest of the reservation, eg: the operation failed or finished.
+func (q *quotaReservation) done() {
+	if q == nil {
+		return
+	}
+
+	q.release(&q.left)
+}
+
+// handOver moves the rest of the reservation to a new one, eg: released by the
+// goroutine writing the tasks, then done of the origin one does nothing.
+func (q *quotaReservation) handOver() *quotaReservation {
+	if q == nil {
+		return nil
+	}
+
+	q.hold.Lock()
+	defer q.hold.Unlock()
+
+	n := &quotaReservation{hold: q.hold, left: q.left}
+	q.left = types.ResourceUsage{}
+
+	return n
+}
+
+func minUsage(a, b *types.ResourceUsage) *types.ResourceUsage {
+	return &types.ResourceUsage{
+		CPUs:      math.Min(a.CPUs, b.CPUs),
+		Mem:       math.Min(a.Mem, b.Mem),
+		Disk:      math.Min(a.Disk, b.Disk),
+		Instances: int(math.Min(float64(a.Instances), float64(b.Instances))),
+		Apps:      int(math.Min(float64(a.Apps), float64(b.Apps))),
+	}
+}
+
+func maxUsage(a, b *types.ResourceUsage) *types.ResourceUsage {
+	return &types.ResourceUsage{
+		CPUs:      math.Max(a.CPUs, b.CPUs),
+		Mem:       math.Max(a.Mem, b.Mem),
+		Disk:      math.Max(a.Disk, b.Disk),
+		Instances: int(math.Max(float64(a.Instances), float64(b.Instances))),
+		Apps:      int(math.Max(float64(a.Apps), float64(b.Apps))),
+	}
+}
@@ -0,0 +1,45 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/Dataman-Cloud/swan/store/memory"
+	"github.com/Dataman-Cloud/swan/types"
+)
+
+// TestQuotaReserved checks the concurrent requests of one runAs against the resources
+// reserved but not written yet.
+func TestQuotaReserved(t *testing.T) {
+	db, err := memory.NewMemoryStore("")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := db.CreateQuota(&types.Quota{RunAs: "bbk", CPUs: 4}); err != nil {
+		t.Fatal(err)
+	}
+
+	r := &Server{db: db}
+
+	first, herr := r.checkQuota("bbk", &types.ResourceUsage{CPUs: 3})
+	if herr != nil {
+		t.Fatalf("expected the first request passed, got %v", herr)
+	}
+
+	if _, herr := r.checkQuota("bbk", &types.ResourceUsage{CPUs: 2}); herr == nil {
+		t.Error("expected the second request refused by the resources reserved")
+	}
+
+	// written as a task of 1 cpu, the rest is released once done.
+	first.release(&types.ResourceUsage{CPUs: 1})
+	first.done()
+
+	if _, herr := r.checkQuota("bbk", &types.ResourceUsage{CPUs: 2}); herr != nil {
+		t.Errorf("expected the request passed after released, got %v", herr)
+	}
+
+	// no quota, unlimited.
+	if res, herr := r.checkQuota("xcm", &types.ResourceUsage{CPUs: 100}); res != nil || herr != nil {
+		t.Errorf("expected the runAs without quota unlimited, got %v, %v", res, herr)
+	}
+}
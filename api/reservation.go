@@ -0,0 +1,83 @@
+package api
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/Dataman-Cloud/swan/types"
+	"github.com/gorilla/mux"
+)
+
+func (r *Server) listReservations(w http.ResponseWriter, req *http.Request) {
+	if err := req.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	rs, err := r.db.ListReservations()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var (
+		appId = req.Form.Get("appId")
+		ret   = make([]*types.Reservation, 0, len(rs))
+	)
+
+	for _, rv := range rs {
+		if appId != "" && rv.AppID != appId {
+			continue
+		}
+
+		ret = append(ret, rv)
+	}
+
+	writeJSON(w, http.StatusOK, ret)
+}
+
+func (r *Server) getReservation(w http.ResponseWriter, req *http.Request) {
+	id := mux.Vars(req)["reservation_id"]
+
+	rv, err := r.db.GetReservation(id)
+	if err != nil {
+		if strings.Contains(err.Error(), "not exists") {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, rv)
+}
+
+// deleteReservation destroys the persistent volumes and unreserves the resources.
+// The record is removed without releasing anything with `force=true`, eg: the agent
+// has been gone forever.
+func (r *Server) deleteReservation(w http.ResponseWriter, req *http.Request) {
+	if err := req.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var (
+		id    = mux.Vars(req)["reservation_id"]
+		force = strings.ToLower(req.Form.Get("force")) == "true"
+	)
+
+	if err := r.driver.ReleaseReservation(id, force); err != nil {
+		switch {
+		case strings.Contains(err.Error(), "not exists"):
+			http.Error(w, err.Error(), http.StatusNotFound)
+		case strings.Contains(err.Error(), "in use"):
+			http.Error(w, err.Error(), http.StatusConflict)
+		default:
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	writeJSON(w, http.StatusNoContent, "")
+}
@@ -28,6 +28,34 @@ func (s *Server) setupRoutes(mux *mux.Router) {
 		NewRoute("GET", "/v1/apps/{app_id}/versions/{version_id}", s.getVersion),
 		NewRoute("POST", "/v1/apps/{app_id}/versions", s.createVersion),
 
+		NewRoute("GET", "/v1/quotas", s.listQuotas),
+		NewRoute("POST", "/v1/quotas", s.createQuota),
+		NewRoute("GET", "/v1/quotas/{run_as}", s.getQuota),
+		NewRoute("PUT", "/v1/quotas/{run_as}", s.updateQuota),
+		NewRoute("DELETE", "/v1/quotas/{run_as}", s.deleteQuota),
+
+		NewRoute("GET", "/v1/usage", s.getUsage),
+
+		NewRoute("GET", "/v1/jobs", s.listJobs),
+		NewRoute("POST", "/v1/jobs", s.createJob),
+		NewRoute("GET", "/v1/jobs/{job_id}", s.getJob),
+		NewRoute("DELETE", "/v1/jobs/{job_id}", s.deleteJob),
+		NewRoute("POST", "/v1/jobs/{job_id}/stop", s.stopJob),
+
+		NewRoute("GET", "/v1/cronjobs", s.listCronJobs),
+		NewRoute("POST", "/v1/cronjobs", s.createCronJob),
+		NewRoute("GET", "/v1/cronjobs/{cronjob_id}", s.getCronJob),
+		NewRoute("PUT", "/v1/cronjobs/{cronjob_id}", s.updateCronJob),
+		NewRoute("DELETE", "/v1/cronjobs/{cronjob_id}", s.deleteCronJob),
+
+		NewRoute("GET", "/v1/reservations", s.listReservations),
+		NewRoute("GET", "/v1/reservations/{reservation_id}", s.getReservation),
+		NewRoute("DELETE", "/v1/reservations/{reservation_id}", s.deleteReservation),
+
+		NewRoute("GET", "/v1/queue", s.listQueue),
+		NewRoute("DELETE", "/v1/queue/{launch_id}", s.cancelLaunch),
+		NewRoute("PATCH", "/v1/queue/{launch_id}", s.updateLaunchPriority),
+
 		NewRoute("POST", "/v1/compose", s.newCompose),
 		NewRoute("POST", "/v1/compose/parse", s.parseYAML),
 		NewRoute("GET", "/v1/compose", s.listComposes),
@@ -40,6 +68,8 @@ func (s *Server) setupRoutes(mux *mux.Router) {
 		NewRoute("GET", "/version", s.version),
 		NewRoute("GET", "/v1/leader", s.getLeader),
 		NewRoute("POST", "/v1/purge", s.purge),
+		NewRoute("GET", "/v1/backup", s.backup),
+		NewRoute("POST", "/v1/restore", s.restore),
 
 		NewRoute("GET", "/v1/debug/dump", s.dump),
 		NewRoute("GET", "/v1/debug/load", s.load),
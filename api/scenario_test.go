@@ -0,0 +1,346 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Dataman-Cloud/swan/mesos"
+	"github.com/Dataman-Cloud/swan/mesos/filter"
+	"github.com/Dataman-Cloud/swan/mesos/mesostest"
+	"github.com/Dataman-Cloud/swan/mesos/strategy"
+	"github.com/Dataman-Cloud/swan/mesosproto"
+	"github.com/Dataman-Cloud/swan/mole"
+	"github.com/Dataman-Cloud/swan/store"
+	"github.com/Dataman-Cloud/swan/store/memory"
+	"github.com/Dataman-Cloud/swan/types"
+)
+
+const waitTimeout = 20 * time.Second
+
+// testCluster runs the api and the scheduler subscribed to the fake master as a
+// static master, with two agents.
+type testCluster struct {
+	t      *testing.T
+	master *mesostest.Master
+	sched  *mesos.Scheduler
+	db     store.Store
+	api    *httptest.Server
+	agents []*mesostest.Agent
+}
+
+func newTestCluster(t *testing.T, roles ...string) *testCluster {
+	m := mesostest.NewMaster()
+	t.Cleanup(m.Close)
+
+	agents := make([]*mesostest.Agent, 0, 2)
+	for i := 0; i < 2; i++ {
+		agents = append(agents, m.AddAgent(fmt.Sprintf("agent%d", i), mesostest.Resources{
+			CPUs:  4,
+			Mem:   4096,
+			Disk:  10240,
+			Ports: [2]uint64{31000, 31099},
+		}, nil))
+	}
+
+	db, err := memory.NewMemoryStore("")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	st, err := strategy.New(&types.Strategy{Name: types.StrategySpread})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sched, err := mesos.NewScheduler(&mesos.SchedulerConfig{
+		Masters:                []string{m.Addr()},
+		ReconciliationInterval: 3600,
+		HeartbeatTimeout:       60,
+		User:                   "root",
+		Name:                   "swan",
+		Roles:                  roles,
+	}, db, st, mole.NewMaster(ln))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sched.InitFilters([]mesos.Filter{
+		filter.NewAgentFilter(),
+		filter.NewConstraintsFilter(),
+		filter.NewTopologySpreadFilter(),
+		filter.NewAffinityFilter(),
+		filter.NewResourceFilter(),
+	})
+	sched.InitStrategyBuilder(strategy.New)
+
+	if err := sched.Subscribe(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { sched.Unsubscribe() })
+
+	srv := NewServer(&Config{Listen: "127.0.0.1:0"}, nil, sched, db)
+	srv.UpdateLeader("127.0.0.1:0")
+
+	c := &testCluster{
+		t:      t,
+		master: m,
+		sched:  sched,
+		db:     db,
+		api:    httptest.NewServer(srv.server.Handler),
+		agents: agents,
+	}
+	t.Cleanup(c.api.Close)
+
+	// the offers are held once the agents are known to the scheduler.
+	c.waitFor("the offers of the agents", func() bool {
+		return sched.DaemonAgents(nil) == len(agents)
+	})
+
+	return c
+}
+
+func (c *testCluster) do(method, path string, body interface{}, code int) {
+	var buf bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&buf).Encode(body); err != nil {
+			c.t.Fatal(err)
+		}
+	}
+
+	req, err := http.NewRequest(method, c.api.URL+path, &buf)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != code {
+		var msg bytes.Buffer
+		msg.ReadFrom(resp.Body)
+		c.t.Fatalf("%s %s: expected status %d, got %d: %s", method, path, code, resp.StatusCode, msg.String())
+	}
+}
+
+func (c *testCluster) waitFor(what string, cond func() bool) {
+	if !mesostest.WaitFor(waitTimeout, cond) {
+		c.t.Fatalf("timed out waiting for %s", what)
+	}
+}
+
+// waitRunning waits for the app settled with n tasks running of the version, both
+// in the store and on the master.
+func (c *testCluster) waitRunning(appId string, n int, versionId string) {
+	c.waitFor(fmt.Sprintf("%d tasks of app %s running", n, appId), func() bool {
+		app, err := c.db.GetApp(appId)
+		if err != nil || app.OpStatus != types.OpStatusNoop {
+			return false
+		}
+
+		tasks, err := c.db.ListTasks(appId)
+		if err != nil || len(tasks) != n {
+			return false
+		}
+
+		for _, task := range tasks {
+			if task.Status != "TASK_RUNNING" || (versionId != "" && task.Version != versionId) {
+				return false
+			}
+		}
+
+		return len(c.master.Tasks()) == n
+	})
+}
+
+func (c *testCluster) latestVersion(appId string) *types.Version {
+	versions, err := c.db.ListVersions(appId)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+
+	var latest *types.Version
+	for _, v := range versions {
+		if latest == nil || v.ID > latest.ID {
+			latest = v
+		}
+	}
+
+	return latest
+}
+
+func testVersion(name, image string, instances int32) *types.Version {
+	return &types.Version{
+		Name:      name,
+		RunAs:     "bbk",
+		Instances: instances,
+		CPUs:      0.1,
+		Mem:       32,
+		Container: &types.Container{
+			Type: "docker",
+			Docker: &types.Docker{
+				Image:   image,
+				Network: "bridge",
+			},
+		},
+		UpdatePolicy: &types.UpdatePolicy{Delay: 0, OnFailure: types.UpdateStop},
+	}
+}
+
+func TestAppLifecycle(t *testing.T) {
+	c := newTestCluster(t)
+
+	c.do("POST", "/v1/apps", testVersion("nginx", "nginx:1.12", 2), http.StatusCreated)
+
+	appId := fmt.Sprintf("nginx.default.bbk.%s", c.sched.ClusterName())
+	c.waitRunning(appId, 2, "")
+
+	c.do("POST", "/v1/apps/"+appId+"/scale", &types.ScalePolicy{Instances: 4}, http.StatusAccepted)
+	c.waitRunning(appId, 4, "")
+
+	// spread over the agents.
+	agents := make(map[string]int)
+	for _, task := range c.master.Tasks() {
+		agents[task.AgentID]++
+	}
+	if len(agents) != 2 {
+		t.Errorf("expected the tasks spread over 2 agents, got %v", agents)
+	}
+
+	c.do("POST", "/v1/apps/"+appId+"/scale", &types.ScalePolicy{Instances: 1}, http.StatusAccepted)
+	c.waitRunning(appId, 1, "")
+
+	c.do("PUT", "/v1/apps/"+appId, testVersion("nginx", "nginx:1.13", 1), http.StatusAccepted)
+
+	latest := c.latestVersion(appId)
+	if latest.Container.Docker.Image != "nginx:1.13" {
+		t.Fatalf("expected the latest version of image nginx:1.13, got %s", latest.Container.Docker.Image)
+	}
+
+	c.waitRunning(appId, 1, latest.ID)
+
+	if image := c.master.Tasks()[0].Info.GetContainer().GetDocker().GetImage(); image != "nginx:1.13" {
+		t.Errorf("expected the task updated to nginx:1.13, got %s", image)
+	}
+
+	c.do("DELETE", "/v1/apps/"+appId, nil, http.StatusNoContent)
+	c.waitFor("the app deleted", func() bool {
+		_, err := c.db.GetApp(appId)
+		return err != nil && len(c.master.Tasks()) == 0
+	})
+}
+
+func TestMasterFailover(t *testing.T) {
+	c := newTestCluster(t)
+
+	c.do("POST", "/v1/apps", testVersion("nginx", "nginx", 2), http.StatusCreated)
+
+	appId := fmt.Sprintf("nginx.default.bbk.%s", c.sched.ClusterName())
+	c.waitRunning(appId, 2, "")
+
+	frameworkId := c.master.FrameworkID()
+	subscribe := mesosproto.Call_SUBSCRIBE
+
+	c.master.Failover()
+
+	c.waitFor("the resubscription", func() bool {
+		return len(c.master.Calls(&subscribe)) == 2 && c.master.Subscribed()
+	})
+
+	calls := c.master.Calls(&subscribe)
+	if id := calls[1].GetSubscribe().GetFrameworkInfo().GetId().GetValue(); id != frameworkId {
+		t.Fatalf("expected resubscribed with framework id %s, got %q", frameworkId, id)
+	}
+
+	// the tasks keep running, and the app works with the offers after the failover.
+	c.do("POST", "/v1/apps/"+appId+"/scale", &types.ScalePolicy{Instances: 3}, http.StatusAccepted)
+	c.waitRunning(appId, 3, "")
+}
+
+func TestStatefulApp(t *testing.T) {
+	c := newTestCluster(t, "swan")
+
+	ver := testVersion("mysql", "mysql:5.6", 1)
+	ver.Container.Volumes = []*types.Volume{
+		{ContainerPath: "data", Mode: "RW", Persistent: &types.PersistentVolume{Size: 128}},
+	}
+
+	c.do("POST", "/v1/apps", ver, http.StatusCreated)
+
+	appId := fmt.Sprintf("mysql.default.bbk.%s", c.sched.ClusterName())
+	c.waitRunning(appId, 1, "")
+
+	name := "0." + appId
+
+	r, err := c.db.GetReservation(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(r.Volumes) != 1 || r.AgentID == "" || r.Role != "swan" {
+		t.Fatalf("unexpected reservation: %+v", r)
+	}
+
+	if ids := c.master.Volumes(r.AgentID); len(ids) != 1 || ids[0] != r.Volumes[0].ID {
+		t.Fatalf("expected volume %s created on agent %s, got %v", r.Volumes[0].ID, r.AgentID, ids)
+	}
+
+	// the updated task is relaunched on its reservation, with the same volume.
+	update := testVersion("mysql", "mysql:5.7", 1)
+	update.Container.Volumes = ver.Container.Volumes
+
+	c.do("PUT", "/v1/apps/"+appId, update, http.StatusAccepted)
+	c.waitRunning(appId, 1, c.latestVersion(appId).ID)
+
+	task := c.master.Tasks()[0]
+	if task.AgentID != r.AgentID {
+		t.Fatalf("expected the task relaunched on agent %s, got %s", r.AgentID, task.AgentID)
+	}
+
+	var persistence string
+	for _, res := range task.Info.GetResources() {
+		if id := res.GetDisk().GetPersistence().GetId(); id != "" {
+			persistence = id
+		}
+	}
+	if persistence != r.Volumes[0].ID {
+		t.Errorf("expected the task mounted volume %s, got %q", r.Volumes[0].ID, persistence)
+	}
+
+	if ids := c.master.Volumes(r.AgentID); len(ids) != 1 {
+		t.Errorf("expected the volume kept, got %v", ids)
+	}
+
+	c.do("DELETE", "/v1/reservations/"+name, nil, http.StatusConflict)
+
+	c.do("DELETE", "/v1/apps/"+appId, nil, http.StatusNoContent)
+	c.waitFor("the app deleted", func() bool {
+		_, err := c.db.GetApp(appId)
+		return err != nil && len(c.master.Tasks()) == 0
+	})
+
+	c.do("DELETE", "/v1/reservations/"+name, nil, http.StatusNoContent)
+
+	if ids := c.master.Volumes(r.AgentID); len(ids) != 0 {
+		t.Errorf("expected the volume destroyed, got %v", ids)
+	}
+
+	if _, err := c.db.GetReservation(name); err == nil {
+		t.Errorf("expected reservation %s removed", name)
+	}
+}
@@ -30,6 +30,8 @@ type Server struct {
 	driver   Driver
 	db       store.Store
 
+	quotaHolds map[string]*quotaHold // by runAs
+
 	sync.Mutex
 }
 
@@ -0,0 +1,142 @@
+package api
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Dataman-Cloud/swan/types"
+)
+
+const (
+	defaultUsageRange = time.Duration(30 * 24 * time.Hour)
+)
+
+func (r *Server) getUsage(w http.ResponseWriter, req *http.Request) {
+	if err := req.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var (
+		to   = time.Now()
+		from = to.Add(-defaultUsageRange)
+		err  error
+	)
+
+	if v := req.Form.Get("to"); v != "" {
+		if to, err = parseUsageTime(v); err != nil {
+			http.Error(w, fmt.Sprintf("invalid to: %v", err), http.StatusBadRequest)
+			return
+		}
+		from = to.Add(-defaultUsageRange)
+	}
+
+	if v := req.Form.Get("from"); v != "" {
+		if from, err = parseUsageTime(v); err != nil {
+			http.Error(w, fmt.Sprintf("invalid from: %v", err), http.StatusBadRequest)
+			return
+		}
+	}
+
+	if !from.Before(to) {
+		http.Error(w, "from must be earlier than to", http.StatusBadRequest)
+		return
+	}
+
+	groupBy := req.Form.Get("groupBy")
+	if groupBy == "" {
+		groupBy = "runAs"
+	}
+
+	if groupBy != "runAs" && groupBy != "app" {
+		http.Error(w, "groupBy must be runAs or app", http.StatusBadRequest)
+		return
+	}
+
+	records, err := r.db.ListUsage(from, to)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	reports := usageReports(records, groupBy)
+
+	if req.Form.Get("format") == "csv" || strings.Contains(req.Header.Get("Accept"), "text/csv") {
+		writeUsageCSV(w, reports)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, reports)
+}
+
+// parseUsageTime accepts RFC3339 time or unix timestamp in seconds.
+func parseUsageTime(v string) (time.Time, error) {
+	if secs, err := strconv.ParseInt(v, 10, 64); err == nil {
+		return time.Unix(secs, 0), nil
+	}
+
+	return time.Parse(time.RFC3339, v)
+}
+
+func usageReports(records []*types.UsageRecord, groupBy string) []*types.UsageReport {
+	m := make(map[string]*types.UsageReport)
+
+	for _, rec := range records {
+		key := rec.RunAs
+		if groupBy == "app" {
+			key = rec.AppID
+		}
+
+		rpt, ok := m[key]
+		if !ok {
+			rpt = &types.UsageReport{RunAs: rec.RunAs}
+			if groupBy == "app" {
+				rpt.AppID = rec.AppID
+			}
+			m[key] = rpt
+		}
+
+		rpt.CPUSeconds += rec.CPUSeconds
+		rpt.MemMBSeconds += rec.MemMBSeconds
+		rpt.DiskMBSeconds += rec.DiskMBSeconds
+		rpt.InstanceHours += rec.InstanceSeconds / 3600
+	}
+
+	ret := make([]*types.UsageReport, 0, len(m))
+	for _, rpt := range m {
+		ret = append(ret, rpt)
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].RunAs != ret[j].RunAs {
+			return ret[i].RunAs < ret[j].RunAs
+		}
+		return ret[i].AppID < ret[j].AppID
+	})
+
+	return ret
+}
+
+func writeUsageCSV(w http.ResponseWriter, reports []*types.UsageReport) {
+	w.Header().Set("Content-Type", "text/csv")
+	w.Header().Set("Content-Disposition", "attachment; filename=usage.csv")
+	w.WriteHeader(http.StatusOK)
+
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"runAs", "appId", "cpuSeconds", "memMBSeconds", "diskMBSeconds", "instanceHours"})
+
+	f := func(v float64) string {
+		return strconv.FormatFloat(v, 'f', 2, 64)
+	}
+
+	for _, rpt := range reports {
+		cw.Write([]string{rpt.RunAs, rpt.AppID, f(rpt.CPUSeconds), f(rpt.MemMBSeconds), f(rpt.DiskMBSeconds), f(rpt.InstanceHours)})
+	}
+
+	cw.Flush()
+}
@@ -5,6 +5,11 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/Dataman-Cloud/swan/store"
+	"github.com/Dataman-Cloud/swan/types"
 )
 
 // WriteJSON write response as json format.
@@ -30,3 +35,59 @@ func decode(b io.ReadCloser, v interface{}) error {
 
 	return dec.Decode(&v)
 }
+
+// setETag sets the revision of the object in the store as the ETag.
+func setETag(w http.ResponseWriter, rev int64) {
+	if rev != 0 {
+		w.Header().Set("ETag", strconv.Quote(strconv.FormatInt(rev, 10)))
+	}
+}
+
+// checkIfMatch makes sure the object is not changed since the client got it, if
+// the request has the If-Match header.
+func checkIfMatch(req *http.Request, rev int64) error {
+	if !hasIfMatch(req) {
+		return nil
+	}
+
+	match := req.Header.Get("If-Match")
+	etag := strconv.Quote(strconv.FormatInt(rev, 10))
+	for _, tag := range strings.Split(match, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(tag), "W/") == etag {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("revision %s not matched, the current one is %s", match, etag)
+}
+
+// hasIfMatch reports whether the request is conditional on the revision.
+func hasIfMatch(req *http.Request) bool {
+	match := req.Header.Get("If-Match")
+	return match != "" && match != "*"
+}
+
+// updateErrCode is the status code of the update error, 409 if it's conflicted,
+// or 412 if the request has the If-Match header, as the revision matched is changed.
+func updateErrCode(req *http.Request, err error) int {
+	if store.IsConflict(err) {
+		if hasIfMatch(req) {
+			return http.StatusPreconditionFailed
+		}
+		return http.StatusConflict
+	}
+
+	return http.StatusInternalServerError
+}
+
+// updateTaskIfMatch updates the task by fn. With the If-Match header it's updated only
+// once by the revision matched, so the change since is never overridden, and retried
+// as store.UpdateTaskWith otherwise.
+func updateTaskIfMatch(db store.Store, req *http.Request, appId string, task *types.Task, fn func(*types.Task)) error {
+	if hasIfMatch(req) {
+		fn(task)
+		return db.UpdateTask(appId, task)
+	}
+
+	return store.UpdateTaskWith(db, appId, task, fn)
+}
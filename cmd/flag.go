@@ -16,12 +16,45 @@ func FlagListenAddr() cli.Flag {
 func FlagStoreType() cli.Flag {
 	return cli.StringFlag{
 		Name:   "store-type",
-		Usage:  "db store type [etcd|zk]",
+		Usage:  "db store type [etcd|zk|memory|raft]",
 		EnvVar: "SWAN_STORE_TYPE",
 		Value:  "zk",
 	}
 }
 
+func FlagStoreFile() cli.Flag {
+	return cli.StringFlag{
+		Name:   "store-file",
+		Usage:  "snapshot file of the memory store, the data is lost on exit if not set",
+		EnvVar: "SWAN_STORE_FILE",
+	}
+}
+
+func FlagRaftID() cli.Flag {
+	return cli.IntFlag{
+		Name:   "raft-id",
+		Usage:  "index of this manager in the raft peers, starts from 1",
+		EnvVar: "SWAN_RAFT_ID",
+	}
+}
+
+func FlagRaftPeers() cli.Flag {
+	return cli.StringFlag{
+		Name:   "raft-peers",
+		Usage:  "raft peers of all of the managers. eg. http://10.0.0.1:2111,http://10.0.0.2:2111,http://10.0.0.3:2111",
+		EnvVar: "SWAN_RAFT_PEERS",
+	}
+}
+
+func FlagRaftDataDir() cli.Flag {
+	return cli.StringFlag{
+		Name:   "raft-data-dir",
+		Usage:  "where the raft log and snapshot saved",
+		EnvVar: "SWAN_RAFT_DATA_DIR",
+		Value:  "/var/lib/swan/raft",
+	}
+}
+
 func FlagEtcdAddrs() cli.Flag {
 	return cli.StringFlag{
 		Name:   "etcd-addrs",
@@ -39,6 +72,14 @@ func FlagStrategy() cli.Flag {
 	}
 }
 
+func FlagScorers() cli.Flag {
+	return cli.StringFlag{
+		Name:   "scorers",
+		Usage:  "scorers of the score strategy, eg: least-allocated=1,spread-by-attribute:zone=2",
+		EnvVar: "SWAN_SCHEDULER_SCORERS",
+	}
+}
+
 func FlagEnableCORS() cli.Flag {
 	return cli.BoolTFlag{
 		Name:   "enable-cors",
@@ -83,6 +124,74 @@ func FlagHeartbeatTimeout() cli.Flag {
 	}
 }
 
+func FlagEnablePreemption() cli.Flag {
+	return cli.BoolFlag{
+		Name:   "enable-preemption",
+		Usage:  "kill tasks of lower priority apps to place higher priority ones",
+		EnvVar: "SWAN_ENABLE_PREEMPTION",
+	}
+}
+
+func FlagUsageRetention() cli.Flag {
+	return cli.IntFlag{
+		Name:   "usage-retention",
+		Usage:  "days the resource usage records are kept, 0 for ever",
+		EnvVar: "SWAN_USAGE_RETENTION",
+		Value:  400,
+	}
+}
+
+func FlagFrameworkUser() cli.Flag {
+	return cli.StringFlag{
+		Name:   "framework-user",
+		Usage:  "the user tasks run as on agents",
+		EnvVar: "SWAN_FRAMEWORK_USER",
+		Value:  "root",
+	}
+}
+
+func FlagFrameworkName() cli.Flag {
+	return cli.StringFlag{
+		Name:   "framework-name",
+		Usage:  "the framework name registered with mesos",
+		EnvVar: "SWAN_FRAMEWORK_NAME",
+		Value:  "swan",
+	}
+}
+
+func FlagFrameworkPrincipal() cli.Flag {
+	return cli.StringFlag{
+		Name:   "framework-principal",
+		Usage:  "the principal of the framework, for authentication and reservations",
+		EnvVar: "SWAN_FRAMEWORK_PRINCIPAL",
+		Value:  "swan",
+	}
+}
+
+func FlagFrameworkSecretFile() cli.Flag {
+	return cli.StringFlag{
+		Name:   "framework-secret-file",
+		Usage:  "the file holding the secret of the principal, to authenticate with mesos master",
+		EnvVar: "SWAN_FRAMEWORK_SECRET_FILE",
+	}
+}
+
+func FlagFrameworkRoles() cli.Flag {
+	return cli.StringFlag{
+		Name:   "framework-roles",
+		Usage:  "the roles swan registered with, splited by ','. required by stateful apps to reserve resources",
+		EnvVar: "SWAN_FRAMEWORK_ROLES",
+	}
+}
+
+func FlagFrameworkCheckpoint() cli.Flag {
+	return cli.BoolFlag{
+		Name:   "framework-checkpoint",
+		Usage:  "enable checkpointing of the framework's tasks on agents",
+		EnvVar: "SWAN_FRAMEWORK_CHECKPOINT",
+	}
+}
+
 func FlagJoinAddrs() cli.Flag {
 	return cli.StringFlag{
 		Name:   "join-addrs",
@@ -167,7 +276,7 @@ func FlagDNSResolvers() cli.Flag {
 func FlagMesosURL() cli.Flag {
 	return cli.StringFlag{
 		Name:   "mesos",
-		Usage:  "zookeeper mesos paths. eg. zk://host1:port1,host2:port2,.../path",
+		Usage:  "zookeeper mesos paths. eg. zk://host1:port1,host2:port2,.../path, or the static masters. eg. http://master1:5050,master2:5050",
 		EnvVar: "SWAN_MESOS_URL",
 	}
 }
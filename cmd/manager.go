@@ -20,15 +20,28 @@ func ManagerCmd() cli.Command {
 	managerCmd.Flags = append(managerCmd.Flags, FlagListenAddr())
 	managerCmd.Flags = append(managerCmd.Flags, FlagMesosURL())
 	managerCmd.Flags = append(managerCmd.Flags, FlagStoreType())
+	managerCmd.Flags = append(managerCmd.Flags, FlagStoreFile())
+	managerCmd.Flags = append(managerCmd.Flags, FlagRaftID())
+	managerCmd.Flags = append(managerCmd.Flags, FlagRaftPeers())
+	managerCmd.Flags = append(managerCmd.Flags, FlagRaftDataDir())
 	managerCmd.Flags = append(managerCmd.Flags, FlagZKURL())
 	managerCmd.Flags = append(managerCmd.Flags, FlagEtcdAddrs())
 	managerCmd.Flags = append(managerCmd.Flags, FlagLogLevel())
 	managerCmd.Flags = append(managerCmd.Flags, FlagStrategy())
+	managerCmd.Flags = append(managerCmd.Flags, FlagScorers())
 	managerCmd.Flags = append(managerCmd.Flags, FlagEnableCORS())
 	managerCmd.Flags = append(managerCmd.Flags, FlagReconciliationInterval())
 	managerCmd.Flags = append(managerCmd.Flags, FlagReconciliationStep())
 	managerCmd.Flags = append(managerCmd.Flags, FlagReconciliationStepDelay())
 	managerCmd.Flags = append(managerCmd.Flags, FlagHeartbeatTimeout())
+	managerCmd.Flags = append(managerCmd.Flags, FlagEnablePreemption())
+	managerCmd.Flags = append(managerCmd.Flags, FlagUsageRetention())
+	managerCmd.Flags = append(managerCmd.Flags, FlagFrameworkUser())
+	managerCmd.Flags = append(managerCmd.Flags, FlagFrameworkName())
+	managerCmd.Flags = append(managerCmd.Flags, FlagFrameworkPrincipal())
+	managerCmd.Flags = append(managerCmd.Flags, FlagFrameworkSecretFile())
+	managerCmd.Flags = append(managerCmd.Flags, FlagFrameworkRoles())
+	managerCmd.Flags = append(managerCmd.Flags, FlagFrameworkCheckpoint())
 
 	return managerCmd
 }
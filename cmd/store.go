@@ -0,0 +1,239 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/Dataman-Cloud/swan/store"
+
+	"github.com/urfave/cli"
+)
+
+func StoreCmd() cli.Command {
+	return cli.Command{
+		Name:        "store",
+		Usage:       "manage the db store",
+		Description: "manage the db store of the managers",
+		Subcommands: []cli.Command{
+			{
+				Name:        "migrate",
+				Usage:       "copy the data from one store to another",
+				Description: "copy the apps, versions, tasks, composes, agents, quotas, jobs, cron jobs, reservations, usage and the framework id from one store to another, eg: swan store migrate --from zk://127.0.0.1:2181/swan --to etcd://127.0.0.1:2379",
+				Action:      MigrateStore,
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "from",
+						Usage: "url of the store copied from, eg: zk://zk1:2181,zk2:2181/swan",
+					},
+					cli.StringFlag{
+						Name:  "to",
+						Usage: "url of the store copied to, eg: etcd://etcd1:2379,etcd2:2379 or memory:///var/lib/swan/store.json",
+					},
+					cli.BoolFlag{
+						Name:  "dry-run",
+						Usage: "only show what would be copied",
+					},
+					FlagLogLevel(),
+				},
+			},
+			{
+				Name:        "backup",
+				Usage:       "back up the data of the store by the manager",
+				Description: "write the backup archive of the apps, versions, tasks, composes, agents, quotas, jobs, cron jobs, reservations, usage and the framework id got from GET /v1/backup of the manager, it fails if the archive is truncated",
+				Action:      BackupStore,
+				Flags: []cli.Flag{
+					flagManagerAddr(),
+					cli.StringFlag{
+						Name:  "file",
+						Usage: "file the backup archive written to, the stdout if not set",
+					},
+				},
+			},
+			{
+				Name:        "restore",
+				Usage:       "restore the data of the empty store by the manager",
+				Description: "restore the backup archive to the empty store by POST /v1/restore of the manager",
+				Action:      RestoreStore,
+				Flags: []cli.Flag{
+					flagManagerAddr(),
+					cli.StringFlag{
+						Name:  "file",
+						Usage: "file of the backup archive",
+					},
+					cli.BoolFlag{
+						Name:  "skip-tasks",
+						Usage: "restore the apps without tasks, the running tasks are adopted by the reconciliation",
+					},
+					cli.BoolFlag{
+						Name:  "resume",
+						Usage: "resume the restore failed half way, the objects restored already are updated",
+					},
+				},
+			},
+		},
+	}
+}
+
+func flagManagerAddr() cli.Flag {
+	return cli.StringFlag{
+		Name:   "manager",
+		Usage:  "address of the manager, eg: 127.0.0.1:9999",
+		EnvVar: "SWAN_MANAGER_ADDR",
+		Value:  "127.0.0.1:9999",
+	}
+}
+
+func managerURL(c *cli.Context, path string) string {
+	addr := c.String("manager")
+	if !strings.HasPrefix(addr, "http://") && !strings.HasPrefix(addr, "https://") {
+		addr = "http://" + addr
+	}
+
+	return strings.TrimSuffix(addr, "/") + path
+}
+
+func BackupStore(c *cli.Context) error {
+	resp, err := http.Get(managerURL(c, "/v1/backup"))
+	if err != nil {
+		return fmt.Errorf("backup error: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bs, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("backup got %d: %s", resp.StatusCode, strings.TrimSpace(string(bs)))
+	}
+
+	var w io.Writer = os.Stdout
+	file := c.String("file")
+	if file != "" {
+		f, err := os.Create(file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		w = f
+	}
+
+	// the archive is checked while written, the manager fails half way after the status
+	// sent leaves it truncated, without the end record.
+	if _, err := store.CheckBackup(io.TeeReader(resp.Body, w)); err != nil {
+		if file != "" {
+			os.Remove(file)
+		}
+		return fmt.Errorf("backup error: %v", err)
+	}
+
+	return nil
+}
+
+func RestoreStore(c *cli.Context) error {
+	if c.String("file") == "" {
+		return fmt.Errorf("--file is required")
+	}
+
+	f, err := os.Open(c.String("file"))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var query []string
+	if c.Bool("skip-tasks") {
+		query = append(query, "skip_tasks=true")
+	}
+	if c.Bool("resume") {
+		query = append(query, "resume=true")
+	}
+
+	url := managerURL(c, "/v1/restore")
+	if len(query) > 0 {
+		url += "?" + strings.Join(query, "&")
+	}
+
+	resp, err := http.Post(url, "application/x-ndjson", f)
+	if err != nil {
+		return fmt.Errorf("restore error: %v", err)
+	}
+	defer resp.Body.Close()
+
+	bs, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("restore got %d: %s", resp.StatusCode, strings.TrimSpace(string(bs)))
+	}
+
+	fmt.Printf("restored %s", bs)
+
+	return nil
+}
+
+func MigrateStore(c *cli.Context) error {
+	if c.String("from") == "" || c.String("to") == "" {
+		return fmt.Errorf("both --from and --to are required")
+	}
+
+	setupLogger(c.String("log-level"))
+
+	from, err := store.Open(c.String("from"))
+	if err != nil {
+		return fmt.Errorf("open store %s error: %v", c.String("from"), err)
+	}
+
+	to, err := store.Open(c.String("to"))
+	if err != nil {
+		return fmt.Errorf("open store %s error: %v", c.String("to"), err)
+	}
+
+	dryRun := c.Bool("dry-run")
+
+	counts, err := store.Migrate(from, to, dryRun)
+	if counts != nil {
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "KIND\tCREATED\tUPDATED")
+		for _, kind := range store.MigrateKinds {
+			fmt.Fprintf(w, "%s\t%d\t%d\n", kind, counts[kind].Created, counts[kind].Updated)
+		}
+		w.Flush()
+	}
+
+	if err != nil {
+		return fmt.Errorf("migrate store error: %v", err)
+	}
+
+	if dryRun {
+		fmt.Println("dry run, nothing copied")
+		return nil
+	}
+
+	// the consistency check by the number of the objects of each kind.
+	src, err := store.Count(from)
+	if err != nil {
+		return fmt.Errorf("count store %s error: %v", c.String("from"), err)
+	}
+
+	dst, err := store.Count(to)
+	if err != nil {
+		return fmt.Errorf("count store %s error: %v", c.String("to"), err)
+	}
+
+	var mismatched []string
+	for _, kind := range store.MigrateKinds {
+		if src[kind] != dst[kind] {
+			mismatched = append(mismatched, fmt.Sprintf("%s %d != %d", kind, src[kind], dst[kind]))
+		}
+	}
+
+	if len(mismatched) > 0 {
+		return fmt.Errorf("the stores are not consistent: %v", mismatched)
+	}
+
+	fmt.Println("migrated, the stores are consistent")
+
+	return nil
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/url"
 	"strings"
 
@@ -13,18 +14,35 @@ type ManagerConfig struct {
 	Listen     string `json:"listenAddr"`
 	EnableCORS bool
 
-	MesosURL *url.URL `json:"mesosURL"` // mesos zk url
+	MesosURL *url.URL `json:"mesosURL"` // mesos zk url, or the static masters
 
 	StoreType string   `json:"store_type"` // db store type
-	ZKURL     *url.URL `json:"zkURL"`      // zk store url (currently we always require this for HA)
+	ZKURL     *url.URL `json:"zkURL"`      // zk store url, also elects the leader of the zk store
 	EtcdAddrs []string `json:"etcd_addrs"` // etcd store addrs
+	StoreFile string   `json:"store_file"` // snapshot file of the memory store
+
+	RaftID      uint64   `json:"raftId"`      // index of this manager in the raft peers
+	RaftPeers   []string `json:"raftPeers"`   // raft peer urls of all of the managers
+	RaftDataDir string   `json:"raftDataDir"` // raft log and snapshot
 
 	Strategy string `json:"strategy"`
+	Scorers  string `json:"scorers"` // scorers of the score strategy
 
 	ReconciliationInterval  float64 `json:"reconciliationInterval"`
 	ReconciliationStep      int64   `json:"reconciliationStep"`
 	ReconciliationStepDelay float64 `json:"reconciliationStepDelay"`
 	HeartbeatTimeout        float64 `json:"heartbeatTimeout"`
+
+	EnablePreemption bool `json:"enablePreemption"`
+
+	UsageRetention int `json:"usageRetention"` // days the usage records are kept, 0 for ever
+
+	FrameworkUser       string   `json:"frameworkUser"`
+	FrameworkName       string   `json:"frameworkName"`
+	FrameworkPrincipal  string   `json:"frameworkPrincipal"`
+	FrameworkSecret     string   `json:"-"` // read from the secret file
+	FrameworkRoles      []string `json:"frameworkRoles"`
+	FrameworkCheckpoint bool     `json:"frameworkCheckpoint"`
 }
 
 func NewManagerConfig(c *cli.Context) (*ManagerConfig, error) {
@@ -49,6 +67,20 @@ func NewManagerConfig(c *cli.Context) (*ManagerConfig, error) {
 		return cfg, err
 	}
 
+	if c.String("store-file") != "" {
+		cfg.StoreFile = c.String("store-file")
+	}
+
+	cfg.RaftID = uint64(c.Int("raft-id"))
+
+	if c.String("raft-peers") != "" {
+		cfg.RaftPeers = strings.Split(c.String("raft-peers"), ",")
+	}
+
+	if c.String("raft-data-dir") != "" {
+		cfg.RaftDataDir = c.String("raft-data-dir")
+	}
+
 	if c.String("etcd-addrs") != "" {
 		cfg.EtcdAddrs = strings.Split(c.String("etcd-addrs"), ",")
 	}
@@ -65,6 +97,10 @@ func NewManagerConfig(c *cli.Context) (*ManagerConfig, error) {
 		cfg.Strategy = c.String("strategy")
 	}
 
+	if c.String("scorers") != "" {
+		cfg.Scorers = c.String("scorers")
+	}
+
 	if c.Float64("reconciliation-interval") != 0 {
 		cfg.ReconciliationInterval = c.Float64("reconciliation-interval")
 	}
@@ -81,31 +117,103 @@ func NewManagerConfig(c *cli.Context) (*ManagerConfig, error) {
 		cfg.HeartbeatTimeout = c.Float64("heartbeat-timeout")
 	}
 
-	if err := cfg.validate(); err != nil {
-		return nil, err
-	}
+	cfg.EnablePreemption = c.Bool("enable-preemption")
 
-	return cfg, nil
-}
+	cfg.UsageRetention = c.Int("usage-retention")
 
-func (c *ManagerConfig) validate() error {
+	if c.String("framework-user") != "" {
+		cfg.FrameworkUser = c.String("framework-user")
+	}
+
+	if c.String("framework-name") != "" {
+		cfg.FrameworkName = c.String("framework-name")
+	}
 
-	if typ := c.StoreType; typ == "etcd" && len(c.EtcdAddrs) == 0 {
-		return fmt.Errorf("at least one of etcd cluster address required")
+	if c.String("framework-principal") != "" {
+		cfg.FrameworkPrincipal = c.String("framework-principal")
 	}
 
-	if c.ZKURL.Host == "" {
-		return fmt.Errorf("zk host can not be empty")
+	if file := c.String("framework-secret-file"); file != "" {
+		bs, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("read framework secret file got error: %v", err)
+		}
+
+		cfg.FrameworkSecret = strings.TrimSpace(string(bs))
 	}
-	if c.ZKURL.Scheme != "zk" {
-		return fmt.Errorf("malformed scheme for zk url.")
+
+	if c.String("framework-roles") != "" {
+		for _, role := range strings.Split(c.String("framework-roles"), ",") {
+			if role = strings.TrimSpace(role); role != "" {
+				cfg.FrameworkRoles = append(cfg.FrameworkRoles, role)
+			}
+		}
 	}
-	if c.ZKURL.Path == "" {
-		return fmt.Errorf("zk url not corrected. path must be provied")
+
+	cfg.FrameworkCheckpoint = c.Bool("framework-checkpoint")
+
+	if err := cfg.validate(); err != nil {
+		return nil, err
 	}
 
-	if c.Strategy != "random" && c.Strategy != "spread" && c.Strategy != "binpack" {
-		return fmt.Errorf("strategy not supported. must be one of the 'random, spread, binpack'")
+	return cfg, nil
+}
+
+func (c *ManagerConfig) validate() error {
+
+	switch c.StoreType {
+	case "zk", "memory":
+	case "etcd":
+		if len(c.EtcdAddrs) == 0 {
+			return fmt.Errorf("at least one of etcd cluster address required")
+		}
+	case "raft":
+		if len(c.RaftPeers) == 0 {
+			return fmt.Errorf("at least one of raft peer required")
+		}
+		if c.RaftID == 0 || c.RaftID > uint64(len(c.RaftPeers)) {
+			return fmt.Errorf("raft id must be in [1, %d]", len(c.RaftPeers))
+		}
+		if c.RaftDataDir == "" {
+			return fmt.Errorf("raft data dir can not be empty")
+		}
+	default:
+		return fmt.Errorf("store type not supported. must be one of the 'zk, etcd, memory, raft'")
+	}
+
+	switch c.MesosURL.Scheme {
+	case "zk":
+		if c.MesosURL.Host == "" || c.MesosURL.Path == "" {
+			return fmt.Errorf("mesos zk url not corrected. hosts and path must be provied")
+		}
+	case "http":
+		if c.MesosURL.Host == "" {
+			return fmt.Errorf("at least one of mesos master address required")
+		}
+	default:
+		return fmt.Errorf("malformed scheme for mesos url. must be one of the 'zk, http'")
+	}
+
+	// the managers of the etcd and raft store elect the leader by the store, and the
+	// single manager of the memory store is always the leader.
+	if c.StoreType == "zk" {
+		if c.ZKURL.Host == "" {
+			return fmt.Errorf("zk host can not be empty")
+		}
+		if c.ZKURL.Scheme != "zk" {
+			return fmt.Errorf("malformed scheme for zk url.")
+		}
+		if c.ZKURL.Path == "" {
+			return fmt.Errorf("zk url not corrected. path must be provied")
+		}
+	}
+
+	if c.Strategy != "random" && c.Strategy != "spread" && c.Strategy != "binpack" && c.Strategy != "score" {
+		return fmt.Errorf("strategy not supported. must be one of the 'random, spread, binpack, score'")
+	}
+
+	if c.FrameworkSecret != "" && c.FrameworkPrincipal == "" {
+		return fmt.Errorf("framework principal required to authenticate with the secret")
 	}
 
 	if c.ReconciliationInterval <= 0 {
@@ -0,0 +1,46 @@
+package config
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func newTestConfig(storeType, zk string) *ManagerConfig {
+	mesosURL, _ := url.Parse("http://127.0.0.1:5050")
+	zkURL, _ := url.Parse(zk)
+
+	return &ManagerConfig{
+		Listen:                  "0.0.0.0:9999",
+		MesosURL:                mesosURL,
+		StoreType:               storeType,
+		ZKURL:                   zkURL,
+		Strategy:                "spread",
+		ReconciliationInterval:  600,
+		ReconciliationStep:      100,
+		ReconciliationStepDelay: 15,
+	}
+}
+
+func TestValidateZKURL(t *testing.T) {
+	for _, c := range []struct {
+		storeType string
+		zk        string
+		err       string
+	}{
+		{"memory", "", ""},
+		{"memory", "zk://127.0.0.1:2181/swan", ""},
+		{"zk", "zk://127.0.0.1:2181/swan", ""},
+		{"zk", "", "zk host can not be empty"},
+		{"zk", "zk://127.0.0.1:2181", "path must be provied"},
+	} {
+		err := newTestConfig(c.storeType, c.zk).validate()
+
+		switch {
+		case c.err == "" && err != nil:
+			t.Errorf("%s store with zk %q: expected valid, got %v", c.storeType, c.zk, err)
+		case c.err != "" && (err == nil || !strings.Contains(err.Error(), c.err)):
+			t.Errorf("%s store with zk %q: expected error %q, got %v", c.storeType, c.zk, c.err, err)
+		}
+	}
+}
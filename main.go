@@ -17,6 +17,7 @@ func main() {
 	app.Commands = []cli.Command{
 		cmd.ManagerCmd(),
 		cmd.AgentCmd(),
+		cmd.StoreCmd(),
 		cmd.VersionCmd(),
 	}
 
@@ -0,0 +1,83 @@
+package manager
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/Dataman-Cloud/swan/config"
+	"github.com/Dataman-Cloud/swan/store"
+	"github.com/Dataman-Cloud/swan/store/etcd"
+	"github.com/Dataman-Cloud/swan/store/raft"
+
+	log "github.com/Sirupsen/logrus"
+)
+
+// LeaderElector elects the leader manager among the managers.
+type LeaderElector interface {
+	// Elect campaigns for the leader manager, and calls fn with the address of the
+	// leader manager each time it changes, self tells whether it's this manager.
+	// It blocks until the election fails.
+	Elect(fn func(leader string, self bool)) error
+}
+
+// newElector picks the elector by the store type, the managers of the etcd and
+// raft store elect by the store itself, the single manager of the memory store is
+// always the leader, the others elect by zookeeper.
+func newElector(cfg *config.ManagerConfig, db store.Store) (LeaderElector, error) {
+	switch cfg.StoreType {
+	case "etcd":
+		return db.(*etcd.EtcdStore).NewElector(cfg.Listen), nil
+	case "raft":
+		return db.(*raft.RaftStore), nil
+	case "memory":
+		return &singleElector{addr: cfg.Listen}, nil
+	}
+
+	conn, err := connect(strings.Split(cfg.ZKURL.Host, ","))
+	if err != nil {
+		return nil, err
+	}
+
+	if conn == nil {
+		return nil, fmt.Errorf("zk connection to [%s] failed", cfg.ZKURL.Host)
+	}
+
+	return newZKElector(conn, filepath.Join(cfg.ZKURL.Path, LeaderElectionPath), cfg.Listen)
+}
+
+// elect runs the election, and notifies the leadership changes.
+func (m *Manager) elect() error {
+	var (
+		leading bool
+		last    string
+	)
+
+	return m.elector.Elect(func(leader string, self bool) {
+		m.leader = leader
+
+		switch {
+		case self && !leading:
+			log.Info("Electing leader success.")
+			m.leadershipChangeCh <- LeadershipLeader
+		case !self && (leading || leader != last):
+			log.Infof("Detect new leader at %s", leader)
+			m.leadershipChangeCh <- LeadershipFollower
+		}
+
+		leading, last = self, leader
+	})
+}
+
+// singleElector elects the only manager as the leader, as the memory store is not
+// shared with any other manager.
+type singleElector struct {
+	addr string
+}
+
+// Elect reports this manager as the leader at once, and never fails.
+func (e *singleElector) Elect(fn func(leader string, self bool)) error {
+	fn(e.addr, true)
+
+	select {}
+}
@@ -0,0 +1,34 @@
+package manager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Dataman-Cloud/swan/config"
+)
+
+func TestMemoryStoreElector(t *testing.T) {
+	e, err := newElector(&config.ManagerConfig{StoreType: "memory", Listen: "127.0.0.1:9999"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type election struct {
+		leader string
+		self   bool
+	}
+
+	ch := make(chan election, 1)
+	go e.Elect(func(leader string, self bool) {
+		ch <- election{leader, self}
+	})
+
+	select {
+	case got := <-ch:
+		if got.leader != "127.0.0.1:9999" || !got.self {
+			t.Errorf("expected this manager elected, got %+v", got)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for the election")
+	}
+}
@@ -1,7 +1,6 @@
 package manager
 
 import (
-	"path/filepath"
 	"strings"
 
 	"github.com/Dataman-Cloud/swan/api"
@@ -11,9 +10,10 @@ import (
 	"github.com/Dataman-Cloud/swan/mesos/strategy"
 	"github.com/Dataman-Cloud/swan/mole"
 	"github.com/Dataman-Cloud/swan/store"
+	"github.com/Dataman-Cloud/swan/store/cache"
+	"github.com/Dataman-Cloud/swan/types"
 
 	log "github.com/Sirupsen/logrus"
-	"github.com/samuel/go-zookeeper/zk"
 )
 
 type Manager struct {
@@ -21,29 +21,31 @@ type Manager struct {
 	apiserver     *api.Server
 	clusterMaster *mole.Master
 	tcpMux        *tcpMux // dispatch tcp Conn to clusterMaster & apiServer
-	ZKClient      *zk.Conn
+	elector       LeaderElector
+	cache         *cache.Cache
 
 	cfg                *config.ManagerConfig
 	leadershipChangeCh chan Leadership
 	errCh              chan error
-	electRootPath      string
 	leader             string
-	myid               string
 }
 
 func New(cfg *config.ManagerConfig) (*Manager, error) {
-	// connect to zk leader
-	conn, err := connect(strings.Split(cfg.ZKURL.Host, ","))
+	// db store initilizing
+	db, err := store.Setup(cfg)
 	if err != nil {
-		return nil, err
+		log.Fatalln("db store setup", err)
 	}
 
-	// db store initilizing
-	db, err := store.Setup(cfg.StoreType, cfg.ZKURL, cfg.EtcdAddrs)
+	// leader elector by the store type
+	elector, err := newElector(cfg, db)
 	if err != nil {
-		log.Fatalln("db store setup", err)
+		return nil, err
 	}
 
+	// the reads of apps, tasks and versions are served from memory
+	c := cache.New(db)
+
 	// tcpMux setup
 	tcpMux := newTCPMux(cfg.Listen)
 	hl := tcpMux.NewHTTPListener()
@@ -54,42 +56,59 @@ func New(cfg *config.ManagerConfig) (*Manager, error) {
 
 	// scheduler setup
 	scfg := mesos.SchedulerConfig{
-		ZKHost:                  strings.Split(cfg.MesosURL.Host, ","),
-		ZKPath:                  cfg.MesosURL.Path,
 		ReconciliationInterval:  cfg.ReconciliationInterval,
 		ReconciliationStep:      cfg.ReconciliationStep,
 		ReconciliationStepDelay: cfg.ReconciliationStepDelay,
 		HeartbeatTimeout:        cfg.HeartbeatTimeout,
+		EnablePreemption:        cfg.EnablePreemption,
+		UsageRetention:          cfg.UsageRetention,
+		User:                    cfg.FrameworkUser,
+		Name:                    cfg.FrameworkName,
+		Principal:               cfg.FrameworkPrincipal,
+		Secret:                  cfg.FrameworkSecret,
+		Roles:                   cfg.FrameworkRoles,
+		Checkpoint:              cfg.FrameworkCheckpoint,
+	}
+
+	// the static masters, eg: http://master1:5050,master2:5050
+	if cfg.MesosURL.Scheme == "http" {
+		scfg.Masters = strings.Split(cfg.MesosURL.Host, ",")
+	} else {
+		scfg.ZKHost = strings.Split(cfg.MesosURL.Host, ",")
+		scfg.ZKPath = cfg.MesosURL.Path
+	}
+
+	scorers, err := types.ParseScorers(cfg.Scorers)
+	if err != nil {
+		return nil, err
 	}
 
-	var s mesos.Strategy
-	switch cfg.Strategy {
-	case "random":
-		s = strategy.NewRandomStrategy()
-	case "binpack", "binpacking":
-		s = strategy.NewBinPackStrategy()
-	case "spread":
-		s = strategy.NewSpreadStrategy()
-	default:
-		s = strategy.NewBinPackStrategy()
+	s, err := strategy.New(&types.Strategy{Name: cfg.Strategy, Scorers: scorers})
+	if err != nil {
+		return nil, err
 	}
 
-	sched, err := mesos.NewScheduler(&scfg, db, s, clusterMaster)
+	sched, err := mesos.NewScheduler(&scfg, c, s, clusterMaster)
 	if err != nil {
 		return nil, err
 	}
 
 	filters := []mesos.Filter{
+		filter.NewAgentFilter(),
 		filter.NewConstraintsFilter(),
+		filter.NewTopologySpreadFilter(),
+		filter.NewAffinityFilter(),
+		filter.NewResourceFilter(),
 	}
 	sched.InitFilters(filters)
+	sched.InitStrategyBuilder(strategy.New)
 
 	// api server
 	srvcfg := api.Config{
 		Listen:   cfg.Listen,
 		LogLevel: cfg.LogLevel,
 	}
-	srv := api.NewServer(&srvcfg, hl, sched, db)
+	srv := api.NewServer(&srvcfg, hl, sched, c)
 	// router := api.NewRouter(sched, db)
 	// srv.InstallRouter(router)
 
@@ -99,43 +118,26 @@ func New(cfg *config.ManagerConfig) (*Manager, error) {
 		sched:              sched,
 		clusterMaster:      clusterMaster,
 		tcpMux:             tcpMux,
-		ZKClient:           conn,
+		elector:            elector,
+		cache:              c,
 		cfg:                cfg,
 		leadershipChangeCh: make(chan Leadership),
 		errCh:              make(chan error, 1),
-		electRootPath:      filepath.Join(cfg.ZKURL.Path, LeaderElectionPath),
 	}, nil
 }
 
 func (m *Manager) Start() error {
-	p := m.electRootPath
-	exists, _, err := m.ZKClient.Exists(p)
-	if err != nil {
-		return err
-	}
-	if !exists {
-		_, err = m.ZKClient.Create(p, []byte{}, ZKFlagNone, ZKDefaultACL)
-		if err != nil {
-			return err
-		}
-	}
-
-	return m.start()
-}
-
-func (m *Manager) start() error {
 	go func() {
-		p, err := m.electLeader()
-		if err != nil {
-			log.Info("Electing lead manager failure, ", err)
+		if err := m.elect(); err != nil {
 			m.errCh <- err
-			return
 		}
+	}()
 
-		if err := m.watchLeader(p); err != nil {
-			log.Info("Electing leader error", err)
+	// the cache lives as long as the manager.
+	go func() {
+		if err := m.cache.Run(nil); err != nil {
+			log.Errorf("start store cache error: %v", err)
 			m.errCh <- err
-			return
 		}
 	}()
 
@@ -173,7 +175,10 @@ func (m *Manager) start() error {
 				m.apiserver.UpdateLeader(m.leader)
 
 			case LeadershipFollower:
-				log.Warnln("became follower, closing all agents ...")
+				log.Warnln("became follower, unsubscribing from mesos and closing all agents ...")
+				if err := m.sched.Unsubscribe(); err != nil {
+					log.Errorf("unsubscribe from mesos leader error: %v", err)
+				}
 				m.clusterMaster.CloseAllAgents()
 				m.apiserver.UpdateLeader(m.leader)
 			}
@@ -51,18 +51,78 @@ func connect(addrs []string) (*zk.Conn, error) {
 	}
 }
 
-func (m *Manager) setLeader(path string) {
-	p := filepath.Join(m.electRootPath, path)
-	_, err := m.ZKClient.Set(p, []byte(m.cfg.Listen), -1)
+// zkElector elects the leader manager by the zookeeper sequential ephemeral nodes,
+// the manager of the smallest one is the leader.
+type zkElector struct {
+	conn *zk.Conn
+	root string
+	addr string
+	myid string
+}
+
+func newZKElector(conn *zk.Conn, root, addr string) (*zkElector, error) {
+	exists, _, err := conn.Exists(root)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		_, err = conn.Create(root, []byte{}, ZKFlagNone, ZKDefaultACL)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &zkElector{
+		conn: conn,
+		root: root,
+		addr: addr,
+	}, nil
+}
+
+func (e *zkElector) Elect(fn func(leader string, self bool)) error {
+	p := filepath.Join(e.root, "0")
+	path, err := e.conn.Create(p, nil, zk.FlagEphemeral|zk.FlagSequence, ZKDefaultACL)
+	if err != nil {
+		log.Info("Electing lead manager failure, ", err)
+		return err
+	}
+
+	e.myid = filepath.Base(path)
+
+	leader, err := e.elect(fn)
+	if err != nil {
+		log.Info("Electing lead manager failure, ", err)
+		return err
+	}
+
+	for {
+		if err := e.watchLeader(leader); err != nil {
+			log.Info("Electing leader error", err)
+			return err
+		}
+
+		log.Info("Lost leading manager. Start electing new leader...")
+
+		leader, err = e.elect(fn)
+		if err != nil {
+			log.Infof("Electing new leader error %s", err.Error())
+			return err
+		}
+	}
+}
+
+func (e *zkElector) setLeader(path string) {
+	p := filepath.Join(e.root, path)
+	_, err := e.conn.Set(p, []byte(e.addr), -1)
 	if err != nil {
 		log.Infof("Update leader address error %s", err.Error())
 	}
 }
 
-func (m *Manager) getLeader(path string) (string, error) {
-	p := filepath.Join(m.electRootPath, path)
+func (e *zkElector) getLeader(path string) (string, error) {
+	p := filepath.Join(e.root, path)
 	for {
-		b, _, err := m.ZKClient.Get(p)
+		b, _, err := e.conn.Get(p)
 		if err != nil {
 			log.Infof("Get leader address error %s", err.Error())
 			return "", err
@@ -76,8 +136,8 @@ func (m *Manager) getLeader(path string) (string, error) {
 	}
 }
 
-func (m *Manager) isLeader(path string) (bool, error, string) {
-	children, _, err := m.ZKClient.Children(m.electRootPath)
+func (e *zkElector) isLeader(path string) (bool, error, string) {
+	children, _, err := e.conn.Children(e.root)
 	if err != nil {
 		return false, err, ""
 	}
@@ -89,73 +149,52 @@ func (m *Manager) isLeader(path string) (bool, error, string) {
 	return path == p, nil, p
 }
 
-func (m *Manager) elect() (string, error) {
-	leader, err, p := m.isLeader(m.myid)
+// elect finds the leader node, and returns its path.
+func (e *zkElector) elect(fn func(leader string, self bool)) (string, error) {
+	leader, err, p := e.isLeader(e.myid)
 	if err != nil {
 		return "", err
 	}
 	if leader {
-		log.Info("Electing leader success.")
-		m.leader = m.cfg.Listen
-		m.setLeader(p)
-		m.leadershipChangeCh <- LeadershipLeader
+		e.setLeader(p)
+		fn(e.addr, true)
 
 		return p, nil
 	}
 
 	log.Infof("Leader manager has been elected.")
 
-	l, err := m.getLeader(p)
+	l, err := e.getLeader(p)
 	if err != nil {
 		if err == zk.ErrNoNode {
 			log.Errorf("Leader lost again. start new electing...")
-			return m.elect()
+			return e.elect(fn)
 		}
 		log.Errorf("Detect new leader error %s", err.Error())
 		return "", err
 	}
-	log.Infof("Detect new leader at %s", l)
-
-	m.leader = l
 
-	m.leadershipChangeCh <- LeadershipFollower
+	fn(l, false)
 
 	return p, nil
-
 }
 
-func (m *Manager) electLeader() (string, error) {
-	p := filepath.Join(m.electRootPath, "0")
-	path, err := m.ZKClient.Create(p, nil, zk.FlagEphemeral|zk.FlagSequence, ZKDefaultACL)
-	if err != nil {
-		return "", err
-	}
-
-	m.myid = filepath.Base(path)
-
-	return m.elect()
-}
-
-func (m *Manager) watchLeader(path string) error {
-	p := filepath.Join(m.electRootPath, path)
-	_, _, childCh, err := m.ZKClient.ChildrenW(p)
-	if err != nil {
-		log.Infof("Watch children error %s", err)
-		return err
-	}
-
+// watchLeader waits until the leader node deleted.
+func (e *zkElector) watchLeader(path string) error {
+	p := filepath.Join(e.root, path)
 	for {
+		_, _, childCh, err := e.conn.ChildrenW(p)
+		if err == zk.ErrNoNode {
+			return nil
+		}
+		if err != nil {
+			log.Infof("Watch children error %s", err)
+			return err
+		}
+
 		childEvent := <-childCh
 		if childEvent.Type == zk.EventNodeDeleted {
-			log.Info("Lost leading manager. Start electing new leader...")
-			// If it is better to run following steps in a seprated goroutine?
-			// (memory leak maybe)
-			p, err := m.elect()
-			if err != nil {
-				log.Infof("Electing new leader error %s", err.Error())
-				return err
-			}
-			m.watchLeader(p)
+			return nil
 		}
 	}
 }
@@ -0,0 +1,385 @@
+package mesos
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+
+	"github.com/Dataman-Cloud/swan/mesosproto"
+	"github.com/Dataman-Cloud/swan/store"
+	"github.com/Dataman-Cloud/swan/types"
+	"github.com/Dataman-Cloud/swan/utils"
+)
+
+var (
+	// how long the apps restored without tasks are adopting the running tasks
+	// after the implicit reconciliation.
+	adoptTimeout = time.Duration(time.Minute)
+)
+
+// taskAddr is the address of the running task in the mesos state, which the status
+// updates of the reconciliation don't carry.
+type taskAddr struct {
+	agentIP string   // of the agent, the host and bridge tasks are reached by
+	ips     []string // of the container
+	ports   []uint64 // the host ports
+}
+
+// adoptTasks asks mesos for all of the tasks of the framework by the implicit
+// reconciliation if any app is adopting, eg: restored from the backup without
+// tasks. The apps are back to noop once the tasks reported are adopted, and the
+// instances not running any more are relaunched.
+func (s *Scheduler) adoptTasks() {
+	apps, err := s.db.ListApps()
+	if err != nil {
+		log.Errorf("list apps for adopting tasks got error: %v", err)
+		return
+	}
+
+	adopting := make([]*types.Application, 0)
+	for _, app := range apps {
+		if app.OpStatus == types.OpStatusAdopting {
+			adopting = append(adopting, app)
+		}
+	}
+
+	if len(adopting) == 0 {
+		return
+	}
+
+	log.Printf("Adopting the running tasks of %d apps", len(adopting))
+
+	addrs, err := s.stateTaskAddrs()
+	if err != nil {
+		log.Errorf("get the tasks of the mesos state got error: %v, the adopted tasks are addressed by their status only", err)
+	}
+
+	s.adoptLock.Lock()
+	s.adoptAddrs = addrs
+	s.adoptLock.Unlock()
+
+	defer func() {
+		s.adoptLock.Lock()
+		s.adoptAddrs = nil
+		s.adoptLock.Unlock()
+	}()
+
+	// the implicit reconciliation without any task given.
+	if err := s.reconcileTasks(nil); err != nil {
+		log.Errorf("reconcile tasks for adopting got error: %v", err)
+		return
+	}
+
+	time.Sleep(adoptTimeout)
+
+	for _, app := range adopting {
+		err := store.UpdateAppWith(s.db, app, func(app *types.Application) {
+			if app.OpStatus == types.OpStatusAdopting {
+				app.OpStatus = types.OpStatusNoop
+			}
+		})
+		if err != nil {
+			log.Errorf("update app %s adopted got error: %v", app.ID, err)
+			continue
+		}
+
+		if err := s.relaunchMissing(app.ID); err != nil {
+			log.Errorf("relaunch the missing tasks of app %s got error: %v", app.ID, err)
+		}
+	}
+}
+
+// adoptTask saves the running task unknown to the store if its app is adopting,
+// eg: `ab3cd5ef6gh7.0.nginx.default.xcm.dataman`. The pod tasks are never adopted.
+func (s *Scheduler) adoptTask(appId, taskId string, status *mesosproto.TaskStatus) (*types.Task, error) {
+	if isTerminalState(status.GetState()) {
+		return nil, fmt.Errorf("task %s is %s", taskId, status.GetState())
+	}
+
+	if _, _, ok := types.SplitPodContainerTaskID(status.TaskId.GetValue()); ok {
+		return nil, fmt.Errorf("pod task %s not adoptable", taskId)
+	}
+
+	app, err := s.db.GetApp(appId)
+	if err != nil {
+		return nil, err
+	}
+
+	if app.OpStatus != types.OpStatusAdopting {
+		return nil, fmt.Errorf("app %s is not adopting", appId)
+	}
+
+	// the running tasks are launched by the latest version if not rolled back.
+	versions, err := s.db.ListVersions(appId)
+	if err != nil || len(versions) == 0 {
+		return nil, fmt.Errorf("app %s has no version to adopt task %s: %v", appId, taskId, err)
+	}
+
+	types.VersionList(versions).Reverse()
+
+	var (
+		parts   = strings.SplitN(taskId, ".", 2)
+		agentId = status.GetAgentId().GetValue()
+	)
+
+	task := &types.Task{
+		ID:      taskId,
+		Name:    parts[1],
+		Weight:  100,
+		Healthy: types.TaskHealthyUnset,
+		AgentId: agentId,
+		Version: versions[0].ID,
+		Created: time.Now(),
+		Updated: time.Now(),
+	}
+
+	addr := s.adoptAddr(taskId)
+
+	// not known to the mesos state, by the agent address as the offers.
+	if addr.agentIP == "" {
+		if agent := s.getAgent(agentId); agent != nil {
+			addr.agentIP = agent.Hostname()
+			if offer := agent.offer(); offer != nil {
+				addr.agentIP = offer.GetIP()
+			}
+		}
+	}
+
+	if ips := containerIPs(status); len(ips) > 0 {
+		addr.ips = ips
+	}
+
+	// addressed the same as launched, see LaunchTasks.
+	cfg := types.NewTaskConfig(versions[0])
+
+	task.IP, task.IPs = addr.agentIP, nil
+	if cfg.Network != "host" && cfg.Network != "bridge" && len(addr.ips) > 0 {
+		task.IP, task.IPs = addr.ips[0], addr.ips
+	}
+
+	if len(addr.ports) > 0 {
+		task.Port = addr.ports[0]
+	}
+
+	if cfg.Network == types.NetworkCNI {
+		task.Port = 0
+		if len(cfg.PortMappings) > 0 {
+			task.Port = uint64(cfg.PortMappings[0].ContainerPort)
+		}
+	}
+
+	if err := s.db.CreateTask(appId, task); err != nil {
+		return nil, err
+	}
+
+	log.Printf("Adopted task %s of app %s on agent %s at %s:%d", taskId, appId, agentId, task.IP, task.Port)
+
+	return s.db.GetTask(appId, taskId)
+}
+
+// adoptAddr returns the address of the task in the mesos state got when adopting
+// started, the state is got again for the task not in it, eg: adopted by the periodic
+// reconciliation instead.
+func (s *Scheduler) adoptAddr(taskId string) taskAddr {
+	s.adoptLock.Lock()
+	a, ok := s.adoptAddrs[taskId]
+	s.adoptLock.Unlock()
+
+	if ok {
+		return *a
+	}
+
+	addrs, err := s.stateTaskAddrs()
+	if err != nil {
+		log.Errorf("get the tasks of the mesos state got error: %v, task %s is addressed by its status only", err, taskId)
+		return taskAddr{}
+	}
+
+	if a, ok := addrs[taskId]; ok {
+		return *a
+	}
+
+	return taskAddr{}
+}
+
+// stateTaskAddrs returns the addresses of the running tasks of the framework in the
+// mesos state, by the task id.
+func (s *Scheduler) stateTaskAddrs() (map[string]*taskAddr, error) {
+	state, err := s.MesosState()
+	if err != nil {
+		return nil, err
+	}
+
+	agentIPs := make(map[string]string)
+	for _, slave := range state.Slaves {
+		agentIPs[slave.ID] = slave.Hostname
+
+		// eg: slave(1)@192.168.1.10:5051
+		if i := strings.LastIndex(slave.PID, "@"); i >= 0 {
+			if host, _, err := net.SplitHostPort(slave.PID[i+1:]); err == nil {
+				agentIPs[slave.ID] = host
+			}
+		}
+	}
+
+	addrs := make(map[string]*taskAddr)
+
+	for _, fw := range state.Frameworks {
+		if fw.Name != s.framework.GetName() {
+			continue
+		}
+
+		for _, t := range fw.Tasks {
+			addr := &taskAddr{
+				agentIP: agentIPs[t.SlaveID],
+				ips:     make([]string, 0),
+				ports:   parsePortRanges(t.Resources.Ports),
+			}
+
+			// the latest status comes last.
+			if n := len(t.Statuses); n > 0 {
+				for _, info := range t.Statuses[n-1].ContainerStatus.NetworkInfos {
+					for _, ip := range info.IpAddresses {
+						if ip.IpAddress != "" && !utils.SliceContains(addr.ips, ip.IpAddress) {
+							addr.ips = append(addr.ips, ip.IpAddress)
+						}
+					}
+				}
+			}
+
+			addrs[t.ID] = addr
+		}
+	}
+
+	return addrs, nil
+}
+
+// parsePortRanges parses the ports of the mesos state, eg: `[31000-31000, 31005-31006]`.
+func parsePortRanges(s string) []uint64 {
+	ports := make([]uint64, 0)
+
+	for _, r := range strings.Split(strings.Trim(s, "[]"), ",") {
+		bounds := strings.SplitN(strings.TrimSpace(r), "-", 2)
+		if len(bounds) != 2 {
+			continue
+		}
+
+		begin, err := strconv.ParseUint(bounds[0], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		end, err := strconv.ParseUint(bounds[1], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		for port := begin; port <= end; port++ {
+			ports = append(ports, port)
+		}
+	}
+
+	return ports
+}
+
+// relaunchMissing launches the instances of the app adopted but not running any more,
+// eg: gone between backed up and restored. The daemon apps are synced instead, and the
+// pod apps are only reported as their tasks are never adopted.
+func (s *Scheduler) relaunchMissing(appId string) error {
+	versions, err := s.db.ListVersions(appId)
+	if err != nil || len(versions) == 0 {
+		return fmt.Errorf("app %s has no version: %v", appId, err)
+	}
+
+	types.VersionList(versions).Reverse()
+
+	ver := versions[0]
+	if ver.IsDaemon() {
+		s.triggerDaemonSync()
+		return nil
+	}
+
+	tasks, err := s.db.ListTasks(appId)
+	if err != nil {
+		return err
+	}
+
+	names := make(map[string]bool)
+	for _, task := range tasks {
+		names[task.Name] = true
+	}
+
+	if ver.Pod != nil {
+		if len(tasks) < int(ver.Instances) {
+			log.Warnf("App %s adopted %d tasks of %d instances, the pod tasks are not adopted", appId, len(tasks), ver.Instances)
+		}
+		return nil
+	}
+
+	for i := 0; i < int(ver.Instances); i++ {
+		name := fmt.Sprintf("%d.%s", i, appId)
+		if names[name] {
+			continue
+		}
+
+		cfg := types.NewTaskConfig(ver)
+
+		if cfg.FixedIP() && i < len(ver.IPs) {
+			cfg.Parameters = append(cfg.Parameters, &types.Parameter{
+				Key:   "ip",
+				Value: ver.IPs[i],
+			})
+
+			cfg.IP = ver.IPs[i]
+		}
+
+		id := fmt.Sprintf("%s.%s", utils.RandomString(12), name)
+
+		task := &types.Task{
+			ID:      id,
+			Name:    name,
+			Weight:  100,
+			Status:  "pending",
+			Healthy: types.TaskHealthyUnset,
+			Version: ver.ID,
+			ErrMsg:  "relaunched, not running when adopted",
+			Created: time.Now(),
+			Updated: time.Now(),
+		}
+
+		if err := s.db.CreateTask(appId, task); err != nil {
+			return err
+		}
+
+		log.Warnf("Relaunching task %s of app %s not running when adopted", name, appId)
+
+		go func() {
+			results, err := s.LaunchTasks([]*Task{NewTask(cfg, id, name)})
+			if err == nil {
+				err = results[id]
+			}
+
+			if err != nil {
+				log.Errorf("relaunch task %s not adopted got error: %v", id, err)
+
+				task, dberr := s.db.GetTask(appId, id)
+				if dberr != nil {
+					return
+				}
+
+				if dberr = store.UpdateTaskWith(s.db, appId, task, func(task *types.Task) {
+					task.Status = "Failed"
+					task.ErrMsg = err.Error()
+				}); dberr != nil {
+					log.Errorf("update task %s got error: %v", id, dberr)
+				}
+			}
+		}()
+	}
+
+	return nil
+}
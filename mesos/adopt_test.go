@@ -0,0 +1,224 @@
+package mesos_test
+
+import (
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/Dataman-Cloud/swan/mesos"
+	"github.com/Dataman-Cloud/swan/mesos/filter"
+	"github.com/Dataman-Cloud/swan/mesos/mesostest"
+	"github.com/Dataman-Cloud/swan/mesos/strategy"
+	"github.com/Dataman-Cloud/swan/mesosproto"
+	"github.com/Dataman-Cloud/swan/mole"
+	"github.com/Dataman-Cloud/swan/store"
+	"github.com/Dataman-Cloud/swan/store/memory"
+	"github.com/Dataman-Cloud/swan/types"
+)
+
+const waitTimeout = 20 * time.Second
+
+func waitFor(t *testing.T, what string, cond func() bool) {
+	if !mesostest.WaitFor(waitTimeout, cond) {
+		t.Fatalf("timed out waiting for %s", what)
+	}
+}
+
+// newScheduler subscribes the scheduler of the store to the master with one agent.
+func newScheduler(t *testing.T, m *mesostest.Master, db store.Store) *mesos.Scheduler {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	st, err := strategy.New(&types.Strategy{Name: types.StrategySpread})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sched, err := mesos.NewScheduler(&mesos.SchedulerConfig{
+		Masters:                []string{m.Addr()},
+		ReconciliationInterval: 3600,
+		HeartbeatTimeout:       60,
+		User:                   "root",
+		Name:                   "swan",
+	}, db, st, mole.NewMaster(ln))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sched.InitFilters([]mesos.Filter{filter.NewResourceFilter()})
+	sched.InitStrategyBuilder(strategy.New)
+
+	if err := sched.Subscribe(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { sched.Unsubscribe() })
+
+	waitFor(t, "the offers of the agent", func() bool { return sched.DaemonAgents(nil) == 1 })
+
+	return sched
+}
+
+// TestAdoptTasks restores the app without tasks while one of its two tasks is still
+// running, the running one is adopted with its host port once the manager restarted,
+// and the other relaunched.
+func TestAdoptTasks(t *testing.T) {
+	defer mesos.SetAdoptTimeout(mesos.SetAdoptTimeout(2 * time.Second))
+
+	m := mesostest.NewMaster()
+	defer m.Close()
+
+	m.AddAgent("agent0", mesostest.Resources{
+		CPUs:  4,
+		Mem:   4096,
+		Disk:  10240,
+		Ports: [2]uint64{31000, 31099},
+	}, nil)
+
+	db, err := memory.NewMemoryStore("")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sched := newScheduler(t, m, db)
+
+	appId := "nginx.default.bbk." + sched.ClusterName()
+
+	ver := &types.Version{
+		ID:        "1",
+		Name:      "nginx",
+		RunAs:     "bbk",
+		Instances: 2,
+		CPUs:      0.1,
+		Mem:       32,
+		Container: &types.Container{
+			Type: "docker",
+			Docker: &types.Docker{
+				Image:        "nginx",
+				Network:      "bridge",
+				PortMappings: []*types.PortMapping{{ContainerPort: 80, Name: "web", Protocol: "tcp"}},
+			},
+		},
+	}
+
+	if err := db.CreateApp(&types.Application{ID: appId, Name: "nginx", OpStatus: types.OpStatusNoop, Version: []string{ver.ID}}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := db.CreateVersion(appId, ver); err != nil {
+		t.Fatal(err)
+	}
+
+	tasks := make([]*mesos.Task, 0, 2)
+	for i := 0; i < 2; i++ {
+		var (
+			name = fmt.Sprintf("%d.%s", i, appId)
+			id   = fmt.Sprintf("ab3cd5ef6gh%d.%s", i, name)
+		)
+
+		if err := db.CreateTask(appId, &types.Task{ID: id, Name: name, Version: ver.ID}); err != nil {
+			t.Fatal(err)
+		}
+
+		tasks = append(tasks, mesos.NewTask(types.NewTaskConfig(ver), id, name))
+	}
+
+	if _, err := sched.LaunchTasks(tasks); err != nil {
+		t.Fatal(err)
+	}
+
+	waitFor(t, "2 tasks running", func() bool {
+		tasks, err := db.ListTasks(appId)
+		if err != nil || len(tasks) != 2 {
+			return false
+		}
+
+		for _, task := range tasks {
+			if task.Status != "TASK_RUNNING" {
+				return false
+			}
+		}
+
+		return len(m.Tasks()) == 2
+	})
+
+	sched.Unsubscribe()
+
+	// restored without tasks, one of them has gone meanwhile.
+	for _, task := range tasks {
+		if err := db.DeleteTask(task.ID()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	app, err := db.GetApp(appId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := store.UpdateAppWith(db, app, func(app *types.Application) {
+		app.OpStatus = types.OpStatusAdopting
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := m.UpdateTask(tasks[1].ID(), mesosproto.TaskState_TASK_KILLED, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	running := m.Task(tasks[0].ID())
+
+	var hostPort uint64
+	for _, res := range running.Info.GetResources() {
+		if res.GetName() == "ports" {
+			hostPort = res.GetRanges().GetRange()[0].GetBegin()
+		}
+	}
+
+	// adopting once the manager restarted.
+	newScheduler(t, m, db)
+
+	var adopted *types.Task
+	waitFor(t, "the running task adopted", func() bool {
+		adopted, err = db.GetTask(appId, tasks[0].ID())
+		return err == nil
+	})
+
+	if adopted.Port != hostPort || adopted.IP != "127.0.0.1" {
+		t.Errorf("expected the task adopted at 127.0.0.1:%d, got %s:%d", hostPort, adopted.IP, adopted.Port)
+	}
+
+	waitFor(t, "the gone task relaunched", func() bool {
+		app, err := db.GetApp(appId)
+		if err != nil || app.OpStatus != types.OpStatusNoop {
+			return false
+		}
+
+		tasks, err := db.ListTasks(appId)
+		if err != nil || len(tasks) != 2 {
+			return false
+		}
+
+		for _, task := range tasks {
+			if task.Status != "TASK_RUNNING" {
+				return false
+			}
+		}
+
+		return len(m.Tasks()) == 2
+	})
+
+	relaunched, err := db.ListTasks(appId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, task := range relaunched {
+		if task.Name == tasks[1].GetName() && task.ID == tasks[1].ID() {
+			t.Errorf("expected task %s relaunched with a new id", task.Name)
+		}
+	}
+}
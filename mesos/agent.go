@@ -27,6 +27,14 @@ func newAgent(id, hostname string, attrs []*mesosproto.Attribute) *Agent {
 	}
 }
 
+func (s *Agent) ID() string {
+	return s.id
+}
+
+func (s *Agent) Hostname() string {
+	return s.hostname
+}
+
 func (s *Agent) MarshalJSON() ([]byte, error) {
 	s.RLock()
 	defer s.RUnlock()
@@ -57,13 +65,6 @@ func (s *Agent) removeOffer(offerID string) bool {
 	return found
 }
 
-func (s *Agent) empty() bool {
-	s.RLock()
-	defer s.RUnlock()
-
-	return len(s.offers) == 0 && len(s.tasks) == 0
-}
-
 func (s *Agent) getOffer(offerId string) *Offer {
 	s.RLock()
 	defer s.RUnlock()
@@ -88,6 +89,58 @@ func (s *Agent) getOffers() []*Offer {
 	return offers
 }
 
+// reservedOffers returns the offers holding resources of the reservation.
+func (s *Agent) reservedOffers(id string) []*Offer {
+	offers := make([]*Offer, 0)
+	for _, offer := range s.getOffers() {
+		if offer.HasReservation(id) {
+			offers = append(offers, offer)
+		}
+	}
+
+	return offers
+}
+
+// HasReservation reports whether the agent is offering resources of the reservation.
+func (s *Agent) HasReservation(id string) bool {
+	return len(s.reservedOffers(id)) > 0
+}
+
+// offersOf returns the offers allocated to the role, all of the offers if the
+// framework isn't MULTI_ROLE.
+func (s *Agent) offersOf(role string) []*Offer {
+	offers := make([]*Offer, 0)
+	for _, offer := range s.getOffers() {
+		if r := offer.GetRole(); r == "" || r == role {
+			offers = append(offers, offer)
+		}
+	}
+
+	return offers
+}
+
+// allocatedOffers returns the offers allocated to the role holding the most cpus on
+// the agent, as the offers allocated to different roles can't be accepted together.
+func (s *Agent) allocatedOffers() []*Offer {
+	var (
+		role string
+		max  = -1.0
+		cpus = make(map[string]float64)
+	)
+
+	for _, offer := range s.getOffers() {
+		cpus[offer.GetRole()] += offer.GetCpus()
+	}
+
+	for r, c := range cpus {
+		if c > max {
+			role, max = r, c
+		}
+	}
+
+	return s.offersOf(role)
+}
+
 func (s *Agent) offer() *Offer {
 	s.RLock()
 	defer s.RUnlock()
@@ -143,7 +196,7 @@ func (s *Agent) getTasks() []*Task {
 }
 
 func (s *Agent) Resources() (cpus, mem, disk float64, ports []uint64) {
-	for _, offer := range s.getOffers() {
+	for _, offer := range s.allocatedOffers() {
 		cpus += offer.GetCpus()
 		mem += offer.GetMem()
 		disk += offer.GetDisk()
@@ -156,6 +209,12 @@ func (s *Agent) Resources() (cpus, mem, disk float64, ports []uint64) {
 func (s *Agent) Attributes() map[string]string {
 	attrs := make(map[string]string)
 
+	for _, attr := range s.attrs {
+		if attr.GetType() == mesosproto.Value_TEXT {
+			attrs[attr.GetName()] = attr.GetText().GetValue()
+		}
+	}
+
 	for _, offer := range s.getOffers() {
 		for k, v := range offer.GetAttrs() {
 			attrs[k] = v
@@ -111,6 +111,7 @@ func (s *Scheduler) buildAgentDNSRecord(ev *types.TaskEvent) *resolver.Record {
 		ID:          ev.TaskID,
 		Parent:      ev.AppID,
 		IP:          ev.IP,
+		IPs:         ev.IPs,
 		Port:        fmt.Sprintf("%d", ev.Port),
 		Weight:      ev.Weight,
 		ProxyRecord: false,
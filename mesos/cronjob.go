@@ -0,0 +1,190 @@
+package mesos
+
+import (
+	"sort"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+
+	"github.com/Dataman-Cloud/swan/store"
+	"github.com/Dataman-Cloud/swan/types"
+	"github.com/Dataman-Cloud/swan/utils/cron"
+)
+
+const (
+	cronJobCheckInterval = time.Duration(10 * time.Second)
+)
+
+type jobsByCreated []*types.Job
+
+func (l jobsByCreated) Len() int           { return len(l) }
+func (l jobsByCreated) Swap(i, j int)      { l[i], l[j] = l[j], l[i] }
+func (l jobsByCreated) Less(i, j int) bool { return l[i].CreatedAt.Before(l[j].CreatedAt) }
+
+// scheduleCronJobs creates the jobs of the cron jobs those are due, until the
+// subscription stopped.
+func (s *Scheduler) scheduleCronJobs(stop chan struct{}) {
+	ticker := time.NewTicker(cronJobCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		var now time.Time
+
+		select {
+		case now = <-ticker.C:
+		case <-stop:
+			return
+		}
+
+		cronJobs, err := s.db.ListCronJobs()
+		if err != nil {
+			log.Errorf("list cron jobs got error: %v", err)
+			continue
+		}
+
+		for _, cronJob := range cronJobs {
+			if cronJob.Suspend {
+				continue
+			}
+
+			if err := s.scheduleCronJob(cronJob, now); err != nil {
+				log.Errorf("schedule cron job %s got error: %v", cronJob.ID, err)
+			}
+		}
+	}
+}
+
+func (s *Scheduler) scheduleCronJob(cronJob *types.CronJob, now time.Time) error {
+	schedule, err := cron.Parse(cronJob.Schedule)
+	if err != nil {
+		return err
+	}
+
+	last := cronJob.LastScheduleTime
+	if last.IsZero() {
+		last = cronJob.CreatedAt
+	}
+
+	// only the latest missed schedule is run, eg: after leader changed.
+	var due time.Time
+	for next := schedule.Next(last); !next.IsZero() && !next.After(now); next = schedule.Next(next) {
+		due = next
+	}
+
+	if due.IsZero() {
+		return nil
+	}
+
+	cronJob.LastScheduleTime = due
+	if err := s.db.UpdateCronJob(cronJob); err != nil {
+		return err
+	}
+
+	jobs, err := s.cronJobJobs(cronJob.ID)
+	if err != nil {
+		return err
+	}
+
+	active := make([]*types.Job, 0)
+	for _, job := range jobs {
+		if !job.Finished() {
+			active = append(active, job)
+		}
+	}
+
+	// the jobs replaced are still counted by the quota until stopped.
+	freed := &types.ResourceUsage{}
+
+	if len(active) > 0 {
+		switch cronJob.ConcurrencyPolicy {
+		case types.ConcurrencyForbid:
+			log.Printf("Skip cron job %s scheduled at %s, %d job(s) still running", cronJob.ID, due, len(active))
+			return nil
+		case types.ConcurrencyReplace:
+			for _, job := range active {
+				log.Printf("Replacing job %s of cron job %s", job.ID, cronJob.ID)
+				if err := s.StopJob(job.ID); err != nil {
+					log.Errorf("stop job %s got error: %v", job.ID, err)
+					continue
+				}
+
+				if job.Template != nil {
+					freed.Add(types.NewResourceUsage(job.Template, 1))
+				}
+			}
+		}
+	}
+
+	job := cronJob.NewJob(due)
+
+	quotaReq := types.NewResourceUsage(job.Template, 1)
+	quotaReq.Sub(freed)
+
+	// the job exceeding the quota is kept failed in the history without run.
+	if err := store.CheckQuota(s.db, job.RunAs, quotaReq); err != nil {
+		log.Warnf("Cron job %s scheduled at %s not run: %v", cronJob.ID, due, err)
+
+		job.Status = types.JobFailed
+		job.ErrMsg = err.Error()
+		job.FinishedAt = time.Now()
+
+		if err := s.db.CreateJob(job); err != nil {
+			return err
+		}
+
+		s.cleanCronJobHistory(cronJob, jobs)
+
+		return nil
+	}
+
+	if err := s.db.CreateJob(job); err != nil {
+		return err
+	}
+
+	log.Printf("Cron job %s created job %s", cronJob.ID, job.ID)
+
+	if err := s.RunJob(job); err != nil {
+		return err
+	}
+
+	s.cleanCronJobHistory(cronJob, jobs)
+
+	return nil
+}
+
+// cronJobJobs returns the jobs created by the cron job, the oldest first.
+func (s *Scheduler) cronJobJobs(id string) ([]*types.Job, error) {
+	jobs, err := s.db.ListJobs()
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make(jobsByCreated, 0)
+	for _, job := range jobs {
+		if job.CronJob == id {
+			ret = append(ret, job)
+		}
+	}
+
+	sort.Sort(ret)
+
+	return ret, nil
+}
+
+// cleanCronJobHistory deletes the oldest finished jobs beyond the history limit.
+func (s *Scheduler) cleanCronJobHistory(cronJob *types.CronJob, jobs []*types.Job) {
+	finished := make([]*types.Job, 0)
+	for _, job := range jobs {
+		if job.Finished() {
+			finished = append(finished, job)
+		}
+	}
+
+	for len(finished) > cronJob.HistoryLimit {
+		if err := s.db.DeleteJob(finished[0].ID); err != nil {
+			log.Errorf("delete job %s got error: %v", finished[0].ID, err)
+		}
+
+		finished = finished[1:]
+	}
+}
@@ -0,0 +1,276 @@
+package mesos
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+
+	"github.com/Dataman-Cloud/swan/mesosproto"
+	"github.com/Dataman-Cloud/swan/store"
+	"github.com/Dataman-Cloud/swan/types"
+	"github.com/Dataman-Cloud/swan/utils"
+)
+
+const (
+	daemonSyncInterval = time.Duration(30 * time.Second)
+)
+
+// triggerDaemonSync asks the daemon syncer to run as soon as possible.
+func (s *Scheduler) triggerDaemonSync() {
+	select {
+	case s.daemonSync <- struct{}{}:
+	default: // already triggered
+	}
+}
+
+// syncDaemons keeps one task of every daemon app on every matching agent,
+// on new agents and periodically, until the subscription stopped.
+func (s *Scheduler) syncDaemons(stop chan struct{}) {
+	ticker := time.NewTicker(daemonSyncInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-s.daemonSync:
+		case <-stop:
+			return
+		}
+
+		apps, err := s.db.ListApps()
+		if err != nil {
+			log.Errorf("list apps for daemon sync got error: %v", err)
+			continue
+		}
+
+		for _, app := range apps {
+			if op := app.OpStatus; op != "" && op != types.OpStatusNoop {
+				continue // being created, updated or deleted
+			}
+
+			if len(app.Version) == 0 {
+				continue
+			}
+
+			ver, err := s.db.GetVersion(app.ID, app.Version[0])
+			if err != nil {
+				log.Errorf("find version %s of app %s got error: %v", app.Version[0], app.ID, err)
+				continue
+			}
+
+			if !ver.IsDaemon() {
+				continue
+			}
+
+			if err := s.syncDaemon(app, ver); err != nil {
+				log.Errorf("sync daemon app %s got error: %v", app.ID, err)
+			}
+		}
+	}
+}
+
+// syncDaemon launches the daemon app's task on the matching agents holding offers
+// but without any task of the app.
+func (s *Scheduler) syncDaemon(app *types.Application, ver *types.Version) error {
+	tasks, err := s.db.ListTasks(app.ID)
+	if err != nil {
+		return err
+	}
+
+	var (
+		hosted = make(map[string]bool)
+		index  = 0
+	)
+
+	for _, task := range tasks {
+		if n, err := strconv.Atoi(strings.SplitN(task.Name, ".", 2)[0]); err == nil && n >= index {
+			index = n + 1
+		}
+
+		if task.AgentId == "" {
+			continue
+		}
+
+		// failed tasks are rescheduled on the same agent.
+		if isActiveStatus(task.Status) || task.Status == mesosproto.TaskState_TASK_FAILED.String() {
+			hosted[task.AgentId] = true
+			continue
+		}
+
+		// the terminated task is replaced below.
+		if err := s.db.DeleteTask(task.ID); err != nil {
+			log.Errorf("delete terminated daemon task %s got error: %v", task.ID, err)
+		}
+	}
+
+	var (
+		cfg       = types.NewTaskConfig(ver)
+		cpus, mem = cfg.CPUs, cfg.Mem
+	)
+
+	// every launch is checked against the quota, the matching agents may join later.
+	quota, used, err := s.quotaOf(app.RunAs)
+	if err != nil {
+		return err
+	}
+
+	for _, agent := range s.getAgents() {
+		if hosted[agent.ID()] || len(agent.getOffers()) == 0 {
+			continue
+		}
+
+		if !types.MatchConstraints(ver.Constraints, agent.Attributes()) {
+			continue
+		}
+
+		if c, m, _, _ := agent.Resources(); c < cpus || m < mem {
+			continue
+		}
+
+		req := types.NewResourceUsage(ver, 1)
+		if quota != nil {
+			if err := quota.Check(used, req); err != nil {
+				return fmt.Errorf("launch on agent %s: %v", agent.Hostname(), err)
+			}
+		}
+
+		if err := s.launchDaemonTask(app.ID, ver, agent, index); err != nil {
+			log.Errorf("launch daemon task of app %s on agent %s got error: %v", app.ID, agent.Hostname(), err)
+			continue
+		}
+
+		used.Add(req)
+		index++
+	}
+
+	return nil
+}
+
+// quotaOf returns the quota of the runAs with its current usage, nil quota if the
+// runAs is not limited.
+func (s *Scheduler) quotaOf(runAs string) (*types.Quota, *types.ResourceUsage, error) {
+	quota, err := s.db.GetQuota(runAs)
+	if err != nil {
+		if strings.Contains(err.Error(), "not exists") {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+
+	used, err := store.QuotaUsage(s.db, runAs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return quota, used, nil
+}
+
+// DaemonAgents returns the number of the known agents matching the constraints,
+// that is how many tasks the daemon app runs.
+func (s *Scheduler) DaemonAgents(constraints []*types.Constraint) int {
+	n := 0
+	for _, agent := range s.getAgents() {
+		if types.MatchConstraints(constraints, agent.Attributes()) {
+			n++
+		}
+	}
+
+	return n
+}
+
+func (s *Scheduler) launchDaemonTask(appId string, ver *types.Version, agent *Agent, index int) error {
+	var (
+		name = fmt.Sprintf("%d.%s", index, appId)
+		id   = fmt.Sprintf("%s.%s", utils.RandomString(12), name)
+	)
+
+	cfg := types.NewTaskConfig(ver)
+	cfg.AgentID = agent.ID()
+
+	task := &types.Task{
+		ID:      id,
+		Name:    name,
+		Weight:  100,
+		Status:  "pending",
+		Healthy: types.TaskHealthyUnset,
+		AgentId: agent.ID(), // the agent is known before launching
+		Version: ver.ID,
+		Created: time.Now(),
+		Updated: time.Now(),
+	}
+
+	if err := s.db.CreateTask(appId, task); err != nil {
+		return err
+	}
+
+	log.Printf("Launching daemon task %s on agent %s", id, agent.Hostname())
+
+	go func() {
+		results, err := s.LaunchTasks([]*Task{NewTask(cfg, id, name)})
+		if err == nil {
+			err = results[id]
+		}
+
+		if err != nil {
+			log.Errorf("launch daemon task %s got error: %v", id, err)
+
+			task, dberr := s.db.GetTask(appId, id)
+			if dberr != nil {
+				return
+			}
+
+			if dberr = store.UpdateTaskWith(s.db, appId, task, func(task *types.Task) {
+				task.Status = "Failed"
+				task.ErrMsg = err.Error()
+			}); dberr != nil {
+				log.Errorf("update task %s got error: %v", id, dberr)
+			}
+		}
+	}()
+
+	return nil
+}
+
+// reapDaemonTasks removes the daemon tasks on the agent which has gone.
+func (s *Scheduler) reapDaemonTasks(agentId string) {
+	apps, err := s.db.ListApps()
+	if err != nil {
+		log.Errorf("list apps for reaping daemon tasks got error: %v", err)
+		return
+	}
+
+	for _, app := range apps {
+		if len(app.Version) == 0 {
+			continue
+		}
+
+		ver, err := s.db.GetVersion(app.ID, app.Version[0])
+		if err != nil || !ver.IsDaemon() {
+			continue
+		}
+
+		tasks, err := s.db.ListTasks(app.ID)
+		if err != nil {
+			log.Errorf("list tasks of app %s got error: %v", app.ID, err)
+			continue
+		}
+
+		for _, task := range tasks {
+			if task.AgentId != agentId {
+				continue
+			}
+
+			log.Printf("Reaping daemon task %s on removed agent %s", task.ID, agentId)
+
+			s.CancelAppLaunches(app.ID, task.ID)
+			s.unmeterTask(task.ID)
+
+			if err := s.db.DeleteTask(task.ID); err != nil {
+				log.Errorf("delete daemon task %s got error: %v", task.ID, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,10 @@
+package mesos
+
+import "time"
+
+// SetAdoptTimeout sets how long the apps are adopting, it returns the previous one.
+func SetAdoptTimeout(d time.Duration) time.Duration {
+	prev := adoptTimeout
+	adoptTimeout = d
+	return prev
+}
@@ -9,6 +9,19 @@ type Filter interface {
 	Filter(config *types.TaskConfig, agents []*Agent) []*Agent
 }
 
+// ResourceFilter is a Filter only checks the offered resources of agents. It's skipped
+// while looking for agents to preempt on, as the resources will be released by victims.
+type ResourceFilter interface {
+	Filter
+	ResourceOnly()
+}
+
+// ContextFilter is a Filter needs to know the app being placed and the running tasks.
+type ContextFilter interface {
+	Filter
+	FilterContext(ctx *PlacementContext, agents []*Agent) []*Agent
+}
+
 //func NewFilter() []Filter {
 //	filters := []Filter{
 //		filter.NewResourceFilter(),
@@ -17,11 +30,41 @@ type Filter interface {
 //	return filters
 //}
 
-func ApplyFilters(filters []Filter, config *types.TaskConfig, agents []*Agent) []*Agent {
+// placementFilters returns the filters those not only check the offered resources.
+func placementFilters(filters []Filter) []Filter {
+	ret := make([]Filter, 0, len(filters))
+	for _, f := range filters {
+		if _, ok := f.(ResourceFilter); ok {
+			continue
+		}
+		ret = append(ret, f)
+	}
+
+	return ret
+}
+
+// resourceFilters returns the filters only check the offered resources.
+func resourceFilters(filters []Filter) []Filter {
+	ret := make([]Filter, 0, len(filters))
+	for _, f := range filters {
+		if _, ok := f.(ResourceFilter); ok {
+			ret = append(ret, f)
+		}
+	}
+
+	return ret
+}
+
+func ApplyFilters(filters []Filter, ctx *PlacementContext, agents []*Agent) []*Agent {
 	accepted := agents
 
 	for _, filter := range filters {
-		accepted = filter.Filter(config, accepted)
+		if f, ok := filter.(ContextFilter); ok {
+			accepted = f.FilterContext(ctx, accepted)
+			continue
+		}
+
+		accepted = filter.Filter(ctx.Config, accepted)
 	}
 
 	return accepted
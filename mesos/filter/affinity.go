@@ -0,0 +1,49 @@
+package filter
+
+import (
+	"github.com/Dataman-Cloud/swan/mesos"
+	"github.com/Dataman-Cloud/swan/types"
+)
+
+type affinityFilter struct{}
+
+func NewAffinityFilter() *affinityFilter {
+	return &affinityFilter{}
+}
+
+// Filter does nothing without the running tasks, see FilterContext.
+func (f *affinityFilter) Filter(config *types.TaskConfig, agents []*mesos.Agent) []*mesos.Agent {
+	return agents
+}
+
+// FilterContext accepts the agents satisfying all of the required affinity rules.
+func (f *affinityFilter) FilterContext(ctx *mesos.PlacementContext, agents []*mesos.Agent) []*mesos.Agent {
+	required := make([]*types.AppAffinity, 0)
+	for _, rule := range ctx.Config.Affinity {
+		if rule.Required {
+			required = append(required, rule)
+		}
+	}
+
+	if len(required) == 0 {
+		return agents
+	}
+
+	candidates := make([]*mesos.Agent, 0)
+
+	for _, agent := range agents {
+		match := true
+		for _, rule := range required {
+			if !ctx.Satisfies(agent.ID(), rule) {
+				match = false
+				break
+			}
+		}
+
+		if match {
+			candidates = append(candidates, agent)
+		}
+	}
+
+	return candidates
+}
@@ -0,0 +1,29 @@
+package filter
+
+import (
+	"github.com/Dataman-Cloud/swan/mesos"
+	"github.com/Dataman-Cloud/swan/types"
+)
+
+type agentFilter struct{}
+
+func NewAgentFilter() *agentFilter {
+	return &agentFilter{}
+}
+
+// Filter only accepts the pinned agent if the task is pinned.
+func (f *agentFilter) Filter(config *types.TaskConfig, agents []*mesos.Agent) []*mesos.Agent {
+	if config.AgentID == "" {
+		return agents
+	}
+
+	candidates := make([]*mesos.Agent, 0, 1)
+
+	for _, agent := range agents {
+		if agent.ID() == config.AgentID {
+			candidates = append(candidates, agent)
+		}
+	}
+
+	return candidates
+}
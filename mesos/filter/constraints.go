@@ -12,21 +12,10 @@ func NewConstraintsFilter() *constraintsFilter {
 }
 
 func (f *constraintsFilter) Filter(config *types.TaskConfig, agents []*mesos.Agent) []*mesos.Agent {
-	constraints := config.Constraints
-
 	candidates := make([]*mesos.Agent, 0)
 
 	for _, agent := range agents {
-		match := true
-		for _, constraint := range constraints {
-			if constraint.Match(agent.Attributes()) {
-				continue
-			}
-			match = false
-			break
-		}
-
-		if match {
+		if types.MatchConstraints(config.Constraints, agent.Attributes()) {
 			candidates = append(candidates, agent)
 		}
 	}
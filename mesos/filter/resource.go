@@ -12,15 +12,25 @@ func NewResourceFilter() *resourceFilter {
 	return &resourceFilter{}
 }
 
+func (f *resourceFilter) ResourceOnly() {}
+
 func (f *resourceFilter) Filter(config *types.TaskConfig, agents []*mesos.Agent) []*mesos.Agent {
 	candidates := make([]*mesos.Agent, 0)
 
 	for _, agent := range agents {
 		cpus, mem, disk, ports := agent.Resources()
 
+		// the task launched on its reservation only needs the unreserved ports.
+		if r := config.Reservation; r != nil && r.AgentID != "" {
+			if agent.HasReservation(r.ID) && len(ports) >= len(config.PortMappings) {
+				candidates = append(candidates, agent)
+			}
+			continue
+		}
+
 		if cpus >= config.CPUs &&
 			mem >= config.Mem &&
-			disk >= config.Disk &&
+			disk >= config.Disk+config.PersistentSize() &&
 			len(ports) >= len(config.PortMappings) {
 			candidates = append(candidates, agent)
 		}
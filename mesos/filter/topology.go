@@ -0,0 +1,83 @@
+package filter
+
+import (
+	"github.com/Dataman-Cloud/swan/mesos"
+	"github.com/Dataman-Cloud/swan/types"
+)
+
+type topologySpreadFilter struct{}
+
+func NewTopologySpreadFilter() *topologySpreadFilter {
+	return &topologySpreadFilter{}
+}
+
+// Filter does nothing without the running tasks, see FilterContext.
+func (f *topologySpreadFilter) Filter(config *types.TaskConfig, agents []*mesos.Agent) []*mesos.Agent {
+	return agents
+}
+
+// FilterContext accepts the agents in the domains where one more task of the app
+// keeps the skew within the limit. The domains are the values of the attribute on
+// all of the known agents those match the app's constraints, and the agents without
+// the attribute are rejected.
+func (f *topologySpreadFilter) FilterContext(ctx *mesos.PlacementContext, agents []*mesos.Agent) []*mesos.Agent {
+	spread := ctx.Config.TopologySpread
+	if spread == nil {
+		return agents
+	}
+
+	var (
+		counts = make(map[string]int)    // domain -> task count of the app
+		domain = make(map[string]string) // agent id -> domain
+	)
+
+	for _, agent := range ctx.Agents {
+		attrs := agent.Attributes()
+
+		v, ok := attrs[spread.Key]
+		if !ok || v == "" {
+			continue
+		}
+
+		domain[agent.ID()] = v
+
+		if _, ok := counts[v]; !ok && types.MatchConstraints(ctx.Config.Constraints, attrs) {
+			counts[v] = 0
+		}
+	}
+
+	for agentId, placed := range ctx.Placed() {
+		v, ok := domain[agentId]
+		if !ok {
+			continue
+		}
+
+		for _, p := range placed {
+			if p.AppID == ctx.AppID {
+				counts[v]++
+			}
+		}
+	}
+
+	min := -1
+	for _, n := range counts {
+		if min < 0 || n < min {
+			min = n
+		}
+	}
+
+	candidates := make([]*mesos.Agent, 0)
+
+	for _, agent := range agents {
+		v, ok := domain[agent.ID()]
+		if !ok {
+			continue
+		}
+
+		if counts[v]+1-min <= spread.MaxSkew {
+			candidates = append(candidates, agent)
+		}
+	}
+
+	return candidates
+}
@@ -38,3 +38,93 @@ func defaultFramework() *mesosproto.FrameworkInfo {
 		},
 	}
 }
+
+// newFramework builds the framework info by the scheduler config, the defaults are
+// kept for those not configured. The framework with multiple roles registers with
+// the MULTI_ROLE capability, a single role is set as the legacy `role` to work with
+// the masters not supporting it.
+func newFramework(cfg *SchedulerConfig) *mesosproto.FrameworkInfo {
+	fw := defaultFramework()
+
+	if cfg.User != "" {
+		fw.User = proto.String(cfg.User)
+	}
+
+	if cfg.Name != "" {
+		fw.Name = proto.String(cfg.Name)
+	}
+
+	if cfg.Principal != "" {
+		fw.Principal = proto.String(cfg.Principal)
+	}
+
+	if cfg.Checkpoint {
+		fw.Checkpoint = proto.Bool(true)
+	}
+
+	switch len(cfg.Roles) {
+	case 0:
+	case 1:
+		fw.Role = proto.String(cfg.Roles[0])
+	default:
+		fw.Roles = cfg.Roles
+		fw.Capabilities = append(fw.Capabilities, &mesosproto.FrameworkInfo_Capability{
+			Type: mesosproto.FrameworkInfo_Capability_MULTI_ROLE.Enum(),
+		})
+	}
+
+	return fw
+}
+
+// roles returns the roles the framework registered with, except the default `*`.
+func (s *Scheduler) roles() []string {
+	if roles := s.framework.GetRoles(); len(roles) > 0 {
+		return roles
+	}
+
+	if role := s.framework.GetRole(); role != "" && role != "*" {
+		return []string{role}
+	}
+
+	return nil
+}
+
+// allocate sets the role the offers are allocated to on the resources of the
+// operations, which is required by the MULTI_ROLE framework.
+func allocate(role string, operations []*mesosproto.Offer_Operation) {
+	if role == "" {
+		return
+	}
+
+	resources := make([][]*mesosproto.Resource, 0)
+
+	for _, op := range operations {
+		switch op.GetType() {
+		case mesosproto.Offer_Operation_LAUNCH:
+			for _, t := range op.GetLaunch().GetTaskInfos() {
+				resources = append(resources, t.GetResources(), t.GetExecutor().GetResources())
+			}
+		case mesosproto.Offer_Operation_LAUNCH_GROUP:
+			resources = append(resources, op.GetLaunchGroup().GetExecutor().GetResources())
+			for _, t := range op.GetLaunchGroup().GetTaskGroup().GetTasks() {
+				resources = append(resources, t.GetResources())
+			}
+		case mesosproto.Offer_Operation_RESERVE:
+			resources = append(resources, op.GetReserve().GetResources())
+		case mesosproto.Offer_Operation_UNRESERVE:
+			resources = append(resources, op.GetUnreserve().GetResources())
+		case mesosproto.Offer_Operation_CREATE:
+			resources = append(resources, op.GetCreate().GetVolumes())
+		case mesosproto.Offer_Operation_DESTROY:
+			resources = append(resources, op.GetDestroy().GetVolumes())
+		}
+	}
+
+	for _, rs := range resources {
+		for _, r := range rs {
+			r.AllocationInfo = &mesosproto.Resource_AllocationInfo{
+				Role: proto.String(role),
+			}
+		}
+	}
+}
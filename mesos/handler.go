@@ -5,7 +5,9 @@ import (
 	"time"
 
 	"github.com/Dataman-Cloud/swan/mesosproto"
+	"github.com/Dataman-Cloud/swan/store"
 	"github.com/Dataman-Cloud/swan/types"
+	"github.com/Dataman-Cloud/swan/utils"
 
 	log "github.com/Sirupsen/logrus"
 )
@@ -35,6 +37,8 @@ func (s *Scheduler) subscribedHandler(event *mesosproto.Event) {
 	s.startWatcher(interval) // connection watcher
 
 	s.startReconcile()
+
+	go s.adoptTasks()
 }
 
 func (s *Scheduler) offersHandler(event *mesosproto.Event) {
@@ -44,6 +48,8 @@ func (s *Scheduler) offersHandler(event *mesosproto.Event) {
 
 	log.Debugf("Receiving %d offer(s) from mesos", len(offers))
 
+	var added bool
+
 	for _, offer := range offers {
 		agentId := offer.AgentId.GetValue()
 		attrs := offer.GetAttributes()
@@ -53,10 +59,16 @@ func (s *Scheduler) offersHandler(event *mesosproto.Event) {
 		if a == nil {
 			a = newAgent(agentId, hostname, attrs)
 			s.addAgent(a)
+			added = true
 		}
 
 		s.addOffer(offer)
 	}
+
+	// new agents get the tasks of daemon apps.
+	if added {
+		s.triggerDaemonSync()
+	}
 }
 
 func (s *Scheduler) rescindedHandler(event *mesosproto.Event) {
@@ -90,6 +102,19 @@ func (s *Scheduler) updateHandler(event *mesosproto.Event) {
 		appId = parts[2]
 	}
 
+	// the pod task follows the states of all its containers.
+	if podId, container, ok := types.SplitPodContainerTaskID(taskId); ok {
+		if status = s.podStatus(appId, podId, container, status); status == nil {
+			return
+		}
+
+		state, taskId, healthy = status.GetState(), podId, status.GetHealthy()
+	}
+
+	if isTerminalState(state) {
+		s.unmeterTask(taskId)
+	}
+
 	// only broadcast unhealthy event and return
 	if state == mesosproto.TaskState_TASK_FINISHED ||
 		state == mesosproto.TaskState_TASK_UNKNOWN ||
@@ -111,41 +136,64 @@ func (s *Scheduler) updateHandler(event *mesosproto.Event) {
 	// obtain db task & update
 	task, err := s.db.GetTask(appId, taskId)
 	if err != nil {
-		return
+		// unknown to the store, adopted if its app restored without tasks.
+		if task, err = s.adoptTask(appId, taskId, status); err != nil {
+			return
+		}
 	}
 
-	task.Status = state.String()
-
 	ver, err := s.db.GetVersion(appId, task.Version) // task corresponding version
 	if err != nil {
 		log.Errorf("find task version got error: %v. task %s, version %s", err, task.ID, task.Version)
 		return
 	}
 
+	if state == mesosproto.TaskState_TASK_RUNNING {
+		s.meterTask(appId, taskId, ver)
+	}
+
 	var (
-		previousHealthy = task.Healthy // save previous
+		previousHealthy string // save previous
+		addrChanged     bool
 	)
-	if ver.HealthCheck != nil {
-		task.Healthy = types.TaskUnHealthy
-		if healthy {
-			task.Healthy = types.TaskHealthy
+
+	// the task may be changed by the api at the same time, it's set again on the
+	// latest one if conflicted.
+	err = store.UpdateTaskWith(s.db, appId, task, func(task *types.Task) {
+		task.Status = state.String()
+
+		previousHealthy = task.Healthy
+		if ver.HealthCheck != nil || (ver.Pod != nil && ver.Pod.HasHealthCheck()) {
+			task.Healthy = types.TaskUnHealthy
+			if healthy {
+				task.Healthy = types.TaskHealthy
+			}
+		} else {
+			task.Healthy = types.TaskHealthyUnset
 		}
-	} else {
-		task.Healthy = types.TaskHealthyUnset
-	}
 
-	if state != mesosproto.TaskState_TASK_RUNNING {
-		task.ErrMsg = status.GetReason().String() + ":" + status.GetMessage()
-	}
+		// refresh the container addresses. the agent address is kept for host
+		// and bridge network, as the task is reached by the host port.
+		addrChanged = false
+		if network := types.NewTaskConfig(ver).Network; network != "host" && network != "bridge" {
+			if ips := containerIPs(status); len(ips) > 0 && !sameAddrs(ips, task.IPs) {
+				task.IP, task.IPs = ips[0], ips
+				addrChanged = true
+			}
+		}
 
-	if err := s.db.UpdateTask(appId, task); err != nil {
+		if state != mesosproto.TaskState_TASK_RUNNING {
+			task.ErrMsg = status.GetReason().String() + ":" + status.GetMessage()
+		}
+	})
+	if err != nil {
 		log.Errorf("update task status error: %v, %s", err, state.String())
 		return
 	}
 
 	// broadcasting task events
 	log.Debugf("task %s healthy: %s --> %s (%s)", taskId, previousHealthy, task.Healthy, task.Status)
-	if previousHealthy == task.Healthy { // skip on no-change
+	if previousHealthy == task.Healthy && !addrChanged { // skip on no-change
 		return
 	}
 
@@ -181,6 +229,7 @@ func (s *Scheduler) updateHandler(event *mesosproto.Event) {
 		AppSticky:      sticky,
 		TaskID:         taskId,
 		IP:             task.IP,
+		IPs:            task.IPs,
 		Port:           task.Port,
 		Weight:         task.Weight,
 		GatewayEnabled: proxyEnabled,
@@ -208,8 +257,9 @@ func (s *Scheduler) errHandler(event *mesosproto.Event) {
 	ev := event.GetError()
 
 	log.Debugf("Receive error msg %s", ev.GetMessage())
+
+	// the events watcher reconnects once the connection is closed.
 	s.stop()
-	s.reconnect()
 }
 
 func (s *Scheduler) failureHandler(event *mesosproto.Event) {
@@ -227,7 +277,41 @@ func (s *Scheduler) failureHandler(event *mesosproto.Event) {
 
 	log.Debugf("Receive msg for agent %s removed.", agentId.GetValue())
 
+	s.removeAgent(agentId.GetValue())
+
+	s.reapDaemonTasks(agentId.GetValue())
+
 }
 
 func (s *Scheduler) messageHandler(event *mesosproto.Event) {
 }
+
+// containerIPs returns the ip addresses (ipv4 and ipv6) assigned to the container
+// on all of its networks.
+func containerIPs(status *mesosproto.TaskStatus) []string {
+	ips := make([]string, 0)
+
+	for _, info := range status.GetContainerStatus().GetNetworkInfos() {
+		for _, addr := range info.GetIpAddresses() {
+			if ip := addr.GetIpAddress(); ip != "" && !utils.SliceContains(ips, ip) {
+				ips = append(ips, ip)
+			}
+		}
+	}
+
+	return ips
+}
+
+func sameAddrs(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
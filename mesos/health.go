@@ -62,6 +62,7 @@ func (s *Scheduler) FullTaskEventsAndRecords() []*types.CombinedEvents {
 				AppAlias:       alias,
 				TaskID:         task.ID,
 				IP:             task.IP,
+				IPs:            task.IPs,
 				Port:           task.Port,
 				Weight:         task.Weight,
 				GatewayEnabled: proxyEnabled,
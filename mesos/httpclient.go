@@ -5,7 +5,11 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"net/url"
+	"sync"
 	"time"
+
+	log "github.com/Sirupsen/logrus"
 )
 
 const (
@@ -15,12 +19,22 @@ const (
 	UserAgent = "swan"
 
 	MesosSchedulerAPI = "/api/v1/scheduler"
+
+	// max redirects followed by a call, the leader may change during the redirection.
+	maxRedirects = 3
 )
 
 type httpClient struct {
-	streamID string
-	endPoint string
-	client   *http.Client
+	sync.RWMutex // protects the followings three, the calls are sent concurrently
+	streamID     string
+	leader       string
+	endPoint     string
+
+	client *http.Client
+
+	// basic auth credential of the framework, sent if secret provided.
+	principal string
+	secret    string
 }
 
 func NewHTTPClient(leader string) *httpClient {
@@ -32,25 +46,84 @@ func NewHTTPClient(leader string) *httpClient {
 					KeepAlive: HttpKeepaliveDuration,
 				}).Dial,
 			},
+			// the redirects from non-leading masters are followed by hand, to
+			// learn the new leader.
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
 		},
 	}
 
-	c.endPoint = "http://" + leader + MesosSchedulerAPI
+	c.setLeader(leader)
 
 	return c
 }
 
-func (c *httpClient) send(payload []byte) (*http.Response, error) {
-	httpReq, err := http.NewRequest("POST", c.endPoint, bytes.NewReader(payload))
+func (c *httpClient) setLeader(leader string) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.leader = leader
+	c.endPoint = "http://" + leader + MesosSchedulerAPI
+}
+
+func (c *httpClient) getLeader() string {
+	c.RLock()
+	defer c.RUnlock()
+
+	return c.leader
+}
+
+// send posts the call to the leader. The 307 redirect of a non-leading master is
+// followed only for the subscription, and the new leader is used since then. Other
+// calls got redirected fail, they are sent again once resubscribed to the new leader.
+func (c *httpClient) send(payload []byte, subscribe bool) (*http.Response, error) {
+	for i := 0; ; i++ {
+		resp, err := c.do(payload)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.StatusCode != http.StatusTemporaryRedirect || i >= maxRedirects {
+			return resp, nil
+		}
+
+		leader, err := redirectedLeader(resp)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		if !subscribe {
+			return nil, fmt.Errorf("mesos master %s is not the leader, redirected to %s", c.getLeader(), leader)
+		}
+
+		log.Printf("Redirected from mesos master %s to leader %s", c.getLeader(), leader)
+
+		// the stream belongs to the previous leader.
+		c.Reset()
+		c.setLeader(leader)
+	}
+}
+
+func (c *httpClient) do(payload []byte) (*http.Response, error) {
+	c.RLock()
+	endPoint, streamID := c.endPoint, c.streamID
+	c.RUnlock()
+
+	httpReq, err := http.NewRequest("POST", endPoint, bytes.NewReader(payload))
 	if err != nil {
 		return nil, err
 	}
 
 	httpReq.Header.Set("Content-Type", "application/x-protobuf")
-	httpReq.Header.Set("Accept", "application/json")
+	httpReq.Header.Set("Accept", "application/x-protobuf")
 	httpReq.Header.Set("User-Agent", UserAgent)
-	if c.streamID != "" {
-		httpReq.Header.Set("Mesos-Stream-Id", c.streamID)
+	if streamID != "" {
+		httpReq.Header.Set("Mesos-Stream-Id", streamID)
+	}
+	if c.secret != "" {
+		httpReq.SetBasicAuth(c.principal, c.secret)
 	}
 
 	httpResp, err := c.client.Do(httpReq)
@@ -58,13 +131,43 @@ func (c *httpClient) send(payload []byte) (*http.Response, error) {
 		return nil, fmt.Errorf("Unable to do request: %s", err)
 	}
 
-	if httpResp.Header.Get("Mesos-Stream-Id") != "" {
-		c.streamID = httpResp.Header.Get("Mesos-Stream-Id")
+	if id := httpResp.Header.Get("Mesos-Stream-Id"); id != "" {
+		c.Lock()
+		c.streamID = id
+		c.Unlock()
 	}
 
 	return httpResp, nil
 }
 
+// redirectedLeader returns the leader address in the `Location` header of the
+// redirect, eg: `//10.0.0.2:5050/api/v1/scheduler`.
+func redirectedLeader(resp *http.Response) (string, error) {
+	location := resp.Header.Get("Location")
+	if location == "" {
+		return "", fmt.Errorf("redirected without location")
+	}
+
+	u, err := url.Parse(location)
+	if err != nil {
+		return "", fmt.Errorf("parse redirect location %s got error: %v", location, err)
+	}
+
+	if u.Host == "" {
+		return "", fmt.Errorf("no leader found in redirect location %s", location)
+	}
+
+	return u.Host, nil
+}
+
+func (c *httpClient) setCredential(principal, secret string) {
+	c.principal = principal
+	c.secret = secret
+}
+
 func (c *httpClient) Reset() {
+	c.Lock()
+	defer c.Unlock()
+
 	c.streamID = ""
 }
@@ -0,0 +1,341 @@
+package mesos
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+
+	"github.com/Dataman-Cloud/swan/mesosproto"
+	"github.com/Dataman-Cloud/swan/types"
+	"github.com/Dataman-Cloud/swan/utils"
+)
+
+var (
+	errJobStopped          = errors.New("job stopped")
+	errJobDeadlineExceeded = errors.New("job deadline exceeded")
+	errJobLeft             = errors.New("job left to the next leader")
+)
+
+// watchTask returns a channel receiving the terminal status of the task.
+func (s *Scheduler) watchTask(taskId string) chan *mesosproto.TaskStatus {
+	s.watchLock.Lock()
+	defer s.watchLock.Unlock()
+
+	ch := make(chan *mesosproto.TaskStatus, 1)
+	s.watchers[taskId] = ch
+
+	return ch
+}
+
+func (s *Scheduler) unwatchTask(taskId string) {
+	s.watchLock.Lock()
+	defer s.watchLock.Unlock()
+
+	delete(s.watchers, taskId)
+}
+
+func (s *Scheduler) notifyWatchers(status *mesosproto.TaskStatus) {
+	if !isTerminalState(status.GetState()) {
+		return
+	}
+
+	s.watchLock.Lock()
+	defer s.watchLock.Unlock()
+
+	if ch, ok := s.watchers[status.TaskId.GetValue()]; ok {
+		select {
+		case ch <- status:
+		default:
+		}
+	}
+}
+
+// RunJob runs the job in background until it succeeds, fails or is stopped. The
+// job is left to the next leader once the subscription stopped.
+func (s *Scheduler) RunJob(job *types.Job) error {
+	sub := s.subscribed()
+	if sub == nil {
+		return fmt.Errorf("job %s not run, not subscribed to mesos", job.ID)
+	}
+
+	s.watchLock.Lock()
+	defer s.watchLock.Unlock()
+
+	if _, ok := s.jobs[job.ID]; ok {
+		return fmt.Errorf("job %s is already running", job.ID)
+	}
+
+	stop := make(chan struct{})
+	s.jobs[job.ID] = stop
+
+	go func() {
+		s.runJob(job, stop, sub)
+
+		s.watchLock.Lock()
+		delete(s.jobs, job.ID)
+		s.watchLock.Unlock()
+	}()
+
+	return nil
+}
+
+// StopJob kills the running task of the job and marks it failed.
+func (s *Scheduler) StopJob(id string) error {
+	s.watchLock.Lock()
+	defer s.watchLock.Unlock()
+
+	stop, ok := s.jobs[id]
+	if !ok {
+		return fmt.Errorf("job %s not running", id)
+	}
+
+	close(stop)
+	delete(s.jobs, id)
+
+	// the job may be waiting in the launch queue.
+	s.CancelAppLaunches(id)
+
+	return nil
+}
+
+// resumeJobs continues the unfinished jobs left by the previous leader.
+func (s *Scheduler) resumeJobs() {
+	jobs, err := s.db.ListJobs()
+	if err != nil {
+		log.Errorf("list jobs for resuming got error: %v", err)
+		return
+	}
+
+	for _, job := range jobs {
+		if job.Finished() {
+			continue
+		}
+
+		log.Printf("Resuming job %s", job.ID)
+
+		if err := s.RunJob(job); err != nil {
+			log.Errorf("resume job %s got error: %v", job.ID, err)
+		}
+	}
+}
+
+func (s *Scheduler) runJob(job *types.Job, stop, sub chan struct{}) {
+	var (
+		ver      = job.Version()
+		deadline <-chan time.Time
+	)
+
+	if job.StartedAt.IsZero() {
+		job.StartedAt = time.Now()
+	}
+
+	if job.Deadline > 0 {
+		deadline = time.After(job.StartedAt.Add(time.Duration(job.Deadline) * time.Second).Sub(time.Now()))
+	}
+
+	job.Status = types.JobRunning
+	s.saveJob(job)
+
+	for {
+		// stopped or timed out while launching.
+		select {
+		case <-deadline:
+			s.finishJob(job, types.JobFailed, errJobDeadlineExceeded.Error())
+			return
+		case <-stop:
+			s.finishJob(job, types.JobFailed, errJobStopped.Error())
+			return
+		case <-sub:
+			log.Printf("Job %s is left to the next leader", job.ID)
+			return
+		default:
+		}
+
+		var attempt *types.JobAttempt
+
+		// the attempt left by the previous leader is still in flight.
+		if n := len(job.Attempts); n > 0 && job.Attempts[n-1].FinishedAt.IsZero() {
+			attempt = job.Attempts[n-1]
+		} else {
+			if failed := len(job.Attempts); failed > job.Retries {
+				s.finishJob(job, types.JobFailed, fmt.Sprintf("failed after %d attempt(s)", failed))
+				return
+			}
+
+			attempt = s.newJobAttempt(job)
+		}
+
+		watcher := s.watchTask(attempt.TaskID)
+
+		if attempt.AgentID == "" {
+			err := s.launchJobAttempt(job, ver, attempt, deadline, stop, sub)
+			switch err {
+			case nil:
+			case errJobDeadlineExceeded, errJobStopped:
+				s.killJobAttempt(job, ver, attempt, err)
+				return
+			case errJobLeft:
+				s.unwatchTask(attempt.TaskID)
+				log.Printf("Job %s is left to the next leader", job.ID)
+				return
+			default:
+				s.unwatchTask(attempt.TaskID)
+				s.finishJobAttempt(job, attempt, "Failed", err.Error())
+				continue
+			}
+		}
+
+		select {
+		case status := <-watcher:
+			s.unwatchTask(attempt.TaskID)
+
+			if status.GetState() == mesosproto.TaskState_TASK_FINISHED {
+				s.finishJobAttempt(job, attempt, status.GetState().String(), "")
+				s.finishJob(job, types.JobSucceeded, "")
+				return
+			}
+
+			s.finishJobAttempt(job, attempt, status.GetState().String(), status.GetReason().String()+":"+status.GetMessage())
+
+		case <-deadline:
+			s.killJobAttempt(job, ver, attempt, errJobDeadlineExceeded)
+			return
+
+		case <-stop:
+			s.killJobAttempt(job, ver, attempt, errJobStopped)
+			return
+
+		case <-sub:
+			s.unwatchTask(attempt.TaskID)
+			log.Printf("Job %s is left to the next leader", job.ID)
+			return
+		}
+	}
+}
+
+func (s *Scheduler) newJobAttempt(job *types.Job) *types.JobAttempt {
+	var (
+		name = fmt.Sprintf("%d.%s", len(job.Attempts), job.ID)
+		id   = fmt.Sprintf("%s.%s", utils.RandomString(12), name)
+	)
+
+	attempt := &types.JobAttempt{
+		TaskID:    id,
+		Status:    "pending",
+		StartedAt: time.Now(),
+	}
+
+	job.Attempts = append(job.Attempts, attempt)
+	s.saveJob(job)
+
+	return attempt
+}
+
+// launchJobAttempt launches the task of the attempt and waits until it's running,
+// or the job is timed out, stopped or left to the next leader meanwhile.
+func (s *Scheduler) launchJobAttempt(job *types.Job, ver *types.Version, attempt *types.JobAttempt, deadline <-chan time.Time, stop, sub chan struct{}) error {
+	var (
+		name = strings.SplitN(attempt.TaskID, ".", 2)[1]
+		cfg  = types.NewTaskConfig(ver)
+		task = NewTask(cfg, attempt.TaskID, name)
+		done = make(chan error, 1)
+	)
+
+	task.oneOff = true
+
+	go func() {
+		results, err := s.LaunchTasks([]*Task{task})
+		if err == nil {
+			err = results[attempt.TaskID]
+		}
+
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			return err
+		}
+	case <-deadline:
+		s.abandonJobLaunch(job, ver, task, done)
+		return errJobDeadlineExceeded
+	case <-stop:
+		s.abandonJobLaunch(job, ver, task, done)
+		return errJobStopped
+	case <-sub:
+		s.CancelAppLaunches(job.ID, attempt.TaskID)
+		return errJobLeft
+	}
+
+	attempt.AgentID = task.AgentId.GetValue()
+	attempt.Status = mesosproto.TaskState_TASK_RUNNING.String()
+	s.saveJob(job)
+
+	s.meterTask(job.ID, attempt.TaskID, ver)
+
+	return nil
+}
+
+// abandonJobLaunch cancels the launch of the job's task, the task is killed if it's
+// being placed already and launched later.
+func (s *Scheduler) abandonJobLaunch(job *types.Job, ver *types.Version, task *Task, done chan error) {
+	taskId := task.ID()
+
+	if s.CancelAppLaunches(job.ID, taskId) > 0 {
+		return
+	}
+
+	go func() {
+		if err := <-done; err != nil {
+			return
+		}
+
+		log.Printf("Killing task %s of job %s launched after abandoned", taskId, job.ID)
+
+		if err := s.gracefulKill(taskId, task.AgentId.GetValue(), ver.KillPolicy); err != nil {
+			log.Errorf("kill task %s of job %s got error: %v", taskId, job.ID, err)
+		}
+	}()
+}
+
+func (s *Scheduler) killJobAttempt(job *types.Job, ver *types.Version, attempt *types.JobAttempt, reason error) {
+	s.CancelAppLaunches(job.ID, attempt.TaskID)
+
+	if attempt.AgentID != "" {
+		if err := s.gracefulKill(attempt.TaskID, attempt.AgentID, ver.KillPolicy); err != nil {
+			log.Errorf("kill task %s of job %s got error: %v", attempt.TaskID, job.ID, err)
+		}
+	}
+
+	s.unwatchTask(attempt.TaskID)
+	s.finishJobAttempt(job, attempt, mesosproto.TaskState_TASK_KILLED.String(), reason.Error())
+	s.finishJob(job, types.JobFailed, reason.Error())
+}
+
+func (s *Scheduler) finishJobAttempt(job *types.Job, attempt *types.JobAttempt, status, errmsg string) {
+	attempt.Status = status
+	attempt.ErrMsg = errmsg
+	attempt.FinishedAt = time.Now()
+
+	s.saveJob(job)
+}
+
+func (s *Scheduler) finishJob(job *types.Job, status, errmsg string) {
+	log.Printf("Job %s %s %s", job.ID, status, errmsg)
+
+	job.Status = status
+	job.ErrMsg = errmsg
+	job.FinishedAt = time.Now()
+
+	s.saveJob(job)
+}
+
+func (s *Scheduler) saveJob(job *types.Job) {
+	if err := s.db.UpdateJob(job); err != nil {
+		log.Errorf("update job %s got error: %v", job.ID, err)
+	}
+}
@@ -43,6 +43,35 @@ func (s *Scheduler) FrameworkState() (*megos.Framework, error) {
 // megosClient is just a helper mesos http client via vendor `andygrunwald/megos` which
 // only `GET` on mesos http endpoints, we only use it to obtain cluster's states quickly.
 func (s *Scheduler) megosClient() (*megos.Client, error) {
+	masters, err := s.masters()
+	if err != nil {
+		return nil, err
+	}
+
+	// the master requires http authentication.
+	if s.cfg.Secret != "" {
+		for _, master := range masters {
+			master.User = url.UserPassword(s.framework.GetPrincipal(), s.cfg.Secret)
+		}
+	}
+
+	return megos.NewClient(masters, nil), nil
+}
+
+// masters returns the static masters, or the masters registered in zk.
+func (s *Scheduler) masters() ([]*url.URL, error) {
+	if len(s.cfg.Masters) > 0 {
+		masters := make([]*url.URL, 0, len(s.cfg.Masters))
+		for _, addr := range s.cfg.Masters {
+			masters = append(masters, &url.URL{
+				Scheme: "http",
+				Host:   addr,
+			})
+		}
+
+		return masters, nil
+	}
+
 	conn, connCh, err := zk.Connect(s.cfg.ZKHost, 10*time.Second)
 	if err != nil {
 		return nil, err
@@ -88,5 +117,5 @@ func (s *Scheduler) megosClient() (*megos.Client, error) {
 		})
 	}
 
-	return megos.NewClient(masters, nil), nil
+	return masters, nil
 }
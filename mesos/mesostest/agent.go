@@ -0,0 +1,388 @@
+package mesostest
+
+import (
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/Dataman-Cloud/swan/mesosproto"
+)
+
+// Resources are the total resources of a simulated agent.
+type Resources struct {
+	CPUs  float64
+	Mem   float64
+	Disk  float64
+	Ports [2]uint64 // the port range [begin, end], none if zero
+}
+
+// Agent is a simulated agent, the free resources are offered as a whole. It's
+// only accessed with the master locked.
+type Agent struct {
+	ID       string
+	Hostname string
+	IP       string
+	Attrs    map[string]string
+
+	total Resources
+	cpus  float64 // used by tasks
+	mem   float64
+	disk  float64
+	ports map[uint64]bool
+
+	reservations map[string]*reservation // reservation key -> the reserved resources
+
+	offerID string // the outstanding offer, empty if none
+}
+
+// reservation is the resources reserved for a role with the same reservation info,
+// which are taken from the unreserved ones of the agent.
+type reservation struct {
+	role string
+	info *mesosproto.Resource_ReservationInfo
+
+	cpus float64 // reserved
+	mem  float64
+	disk float64 // besides the volumes
+
+	usedCPUs float64 // used by tasks
+	usedMem  float64
+	usedDisk float64
+
+	volumes map[string]*mesosproto.Resource // persistence id -> volume
+	mounted map[string]bool                 // the volumes used by tasks
+}
+
+// reservationKey identifies the reservation of the reserved resource.
+func reservationKey(r *mesosproto.Resource) string {
+	return r.GetRole() + " " + proto.CompactTextString(r.GetReservation())
+}
+
+func isReserved(r *mesosproto.Resource) bool {
+	return r.GetRole() != "*" || r.GetReservation() != nil
+}
+
+func newAgent(id, hostname string, res Resources, attrs map[string]string) *Agent {
+	return &Agent{
+		ID:       id,
+		Hostname: hostname,
+		IP:       "127.0.0.1",
+		Attrs:    attrs,
+		total:    res,
+		ports:    make(map[uint64]bool),
+
+		reservations: make(map[string]*reservation),
+	}
+}
+
+// hasFree reports whether there're resources left to offer.
+func (a *Agent) hasFree() bool {
+	if a.total.CPUs-a.cpus > 0 && a.total.Mem-a.mem > 0 {
+		return true
+	}
+
+	for _, rv := range a.reservations {
+		if rv.cpus-rv.usedCPUs > 0 || rv.mem-rv.usedMem > 0 || len(rv.mounted) < len(rv.volumes) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// use takes the resources, which are released by passing release as true. The
+// reserved resources are taken from their reservations.
+func (a *Agent) use(resources []*mesosproto.Resource, release bool) {
+	sign := 1.0
+	if release {
+		sign = -1.0
+	}
+
+	for _, r := range resources {
+		if isReserved(r) {
+			a.useReserved(r, sign)
+			continue
+		}
+
+		switch r.GetName() {
+		case "cpus":
+			a.cpus += sign * r.GetScalar().GetValue()
+		case "mem":
+			a.mem += sign * r.GetScalar().GetValue()
+		case "disk":
+			a.disk += sign * r.GetScalar().GetValue()
+		case "ports":
+			for _, rg := range r.GetRanges().GetRange() {
+				for p := rg.GetBegin(); p <= rg.GetEnd(); p++ {
+					if release {
+						delete(a.ports, p)
+					} else {
+						a.ports[p] = true
+					}
+				}
+			}
+		}
+	}
+}
+
+func (a *Agent) useReserved(r *mesosproto.Resource, sign float64) {
+	rv, ok := a.reservations[reservationKey(r)]
+	if !ok {
+		return
+	}
+
+	if id := r.GetDisk().GetPersistence().GetId(); id != "" {
+		if sign > 0 {
+			rv.mounted[id] = true
+		} else {
+			delete(rv.mounted, id)
+		}
+		return
+	}
+
+	switch r.GetName() {
+	case "cpus":
+		rv.usedCPUs += sign * r.GetScalar().GetValue()
+	case "mem":
+		rv.usedMem += sign * r.GetScalar().GetValue()
+	case "disk":
+		rv.usedDisk += sign * r.GetScalar().GetValue()
+	}
+}
+
+// reserve takes the unreserved resources for the reservations of them.
+func (a *Agent) reserve(resources []*mesosproto.Resource) {
+	for _, r := range resources {
+		key := reservationKey(r)
+
+		rv, ok := a.reservations[key]
+		if !ok {
+			rv = &reservation{
+				role:    r.GetRole(),
+				info:    r.GetReservation(),
+				volumes: make(map[string]*mesosproto.Resource),
+				mounted: make(map[string]bool),
+			}
+			a.reservations[key] = rv
+		}
+
+		v := r.GetScalar().GetValue()
+
+		switch r.GetName() {
+		case "cpus":
+			a.cpus += v
+			rv.cpus += v
+		case "mem":
+			a.mem += v
+			rv.mem += v
+		case "disk":
+			a.disk += v
+			rv.disk += v
+		}
+	}
+}
+
+// unreserve gives the reserved resources back, the reservation left nothing is
+// removed.
+func (a *Agent) unreserve(resources []*mesosproto.Resource) {
+	for _, r := range resources {
+		key := reservationKey(r)
+
+		rv, ok := a.reservations[key]
+		if !ok {
+			continue
+		}
+
+		v := r.GetScalar().GetValue()
+
+		switch r.GetName() {
+		case "cpus":
+			a.cpus -= v
+			rv.cpus -= v
+		case "mem":
+			a.mem -= v
+			rv.mem -= v
+		case "disk":
+			a.disk -= v
+			rv.disk -= v
+		}
+
+		if rv.cpus <= 0 && rv.mem <= 0 && rv.disk <= 0 && len(rv.volumes) == 0 {
+			delete(a.reservations, key)
+		}
+	}
+}
+
+// create creates the persistent volumes in the reserved disk.
+func (a *Agent) create(volumes []*mesosproto.Resource) {
+	for _, vol := range volumes {
+		rv, ok := a.reservations[reservationKey(vol)]
+		if !ok {
+			continue
+		}
+
+		rv.disk -= vol.GetScalar().GetValue()
+		rv.volumes[vol.GetDisk().GetPersistence().GetId()] = vol
+	}
+}
+
+// destroy destroys the persistent volumes, the disk stays reserved.
+func (a *Agent) destroy(volumes []*mesosproto.Resource) {
+	for _, vol := range volumes {
+		rv, ok := a.reservations[reservationKey(vol)]
+		if !ok {
+			continue
+		}
+
+		id := vol.GetDisk().GetPersistence().GetId()
+		if _, ok := rv.volumes[id]; !ok {
+			continue
+		}
+
+		rv.disk += rv.volumes[id].GetScalar().GetValue()
+		delete(rv.volumes, id)
+		delete(rv.mounted, id)
+	}
+}
+
+// volumeIDs returns the persistence ids of the volumes on the agent.
+func (a *Agent) volumeIDs() []string {
+	ids := make([]string, 0)
+	for _, rv := range a.reservations {
+		for id := range rv.volumes {
+			ids = append(ids, id)
+		}
+	}
+
+	sort.Strings(ids)
+
+	return ids
+}
+
+// freeResources returns the resources not used by tasks, the unreserved ones
+// followed by the reserved ones and the volumes not mounted.
+func (a *Agent) freeResources() []*mesosproto.Resource {
+	rs := make([]*mesosproto.Resource, 0, 4)
+
+	for _, s := range []struct {
+		name  string
+		value float64
+	}{
+		{"cpus", a.total.CPUs - a.cpus},
+		{"mem", a.total.Mem - a.mem},
+		{"disk", a.total.Disk - a.disk},
+	} {
+		if s.value <= 0 {
+			continue
+		}
+
+		rs = append(rs, &mesosproto.Resource{
+			Name:   proto.String(s.name),
+			Type:   mesosproto.Value_SCALAR.Enum(),
+			Scalar: &mesosproto.Value_Scalar{Value: proto.Float64(s.value)},
+		})
+	}
+
+	if ranges := a.freePorts(); len(ranges) > 0 {
+		rs = append(rs, &mesosproto.Resource{
+			Name:   proto.String("ports"),
+			Type:   mesosproto.Value_RANGES.Enum(),
+			Ranges: &mesosproto.Value_Ranges{Range: ranges},
+		})
+	}
+
+	keys := make([]string, 0, len(a.reservations))
+	for key := range a.reservations {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		rs = append(rs, a.reservations[key].free()...)
+	}
+
+	return rs
+}
+
+func (rv *reservation) free() []*mesosproto.Resource {
+	rs := make([]*mesosproto.Resource, 0, 3+len(rv.volumes))
+
+	for _, s := range []struct {
+		name  string
+		value float64
+	}{
+		{"cpus", rv.cpus - rv.usedCPUs},
+		{"mem", rv.mem - rv.usedMem},
+		{"disk", rv.disk - rv.usedDisk},
+	} {
+		if s.value <= 0 {
+			continue
+		}
+
+		rs = append(rs, &mesosproto.Resource{
+			Name:        proto.String(s.name),
+			Type:        mesosproto.Value_SCALAR.Enum(),
+			Scalar:      &mesosproto.Value_Scalar{Value: proto.Float64(s.value)},
+			Role:        proto.String(rv.role),
+			Reservation: rv.info,
+		})
+	}
+
+	ids := make([]string, 0, len(rv.volumes))
+	for id := range rv.volumes {
+		if !rv.mounted[id] {
+			ids = append(ids, id)
+		}
+	}
+
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		rs = append(rs, rv.volumes[id])
+	}
+
+	return rs
+}
+
+func (a *Agent) freePorts() []*mesosproto.Value_Range {
+	var (
+		ranges     = make([]*mesosproto.Value_Range, 0)
+		begin, end = a.total.Ports[0], a.total.Ports[1]
+		cur        *mesosproto.Value_Range
+	)
+
+	if begin == 0 || end < begin {
+		return ranges
+	}
+
+	for p := begin; p <= end; p++ {
+		if a.ports[p] {
+			cur = nil
+			continue
+		}
+
+		if cur == nil {
+			cur = &mesosproto.Value_Range{Begin: proto.Uint64(p), End: proto.Uint64(p)}
+			ranges = append(ranges, cur)
+			continue
+		}
+
+		cur.End = proto.Uint64(p)
+	}
+
+	return ranges
+}
+
+func (a *Agent) attributes() []*mesosproto.Attribute {
+	attrs := make([]*mesosproto.Attribute, 0, len(a.Attrs))
+	for k, v := range a.Attrs {
+		attrs = append(attrs, &mesosproto.Attribute{
+			Name: proto.String(k),
+			Type: mesosproto.Value_TEXT.Enum(),
+			Text: &mesosproto.Value_Text{Value: proto.String(v)},
+		})
+	}
+
+	return attrs
+}
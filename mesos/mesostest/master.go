@@ -0,0 +1,920 @@
+// Package mesostest provides an in-process fake mesos master, for running the
+// scheduler end to end without a mesos cluster or zookeeper.
+//
+// The master serves the v1 scheduler api and the `/master/state` endpoint with the
+// agents and the tasks of the framework, so the scheduler works with it as a static
+// master, eg: `http://<master.Addr()>`. The agents are simulated in the master,
+// their free resources are offered to the subscribed framework, and the launched
+// tasks are reported running at once unless disabled by SetAutoRun.
+//
+// The resources reserved by the framework are taken from the unreserved ones of the
+// agent, and offered with the role and reservation info they're reserved with. The
+// persistent volumes are created in them, and offered until mounted by a task.
+package mesostest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/Dataman-Cloud/swan/mesosproto"
+	"github.com/Dataman-Cloud/swan/utils"
+)
+
+const (
+	defaultCluster   = "mesostest"
+	defaultHeartbeat = 15 * time.Second
+)
+
+// Task is a task launched on the master.
+type Task struct {
+	ID      string
+	Name    string
+	AgentID string
+	State   mesosproto.TaskState
+	Info    *mesosproto.TaskInfo
+
+	group    string                 // executor id of the task group
+	executor []*mesosproto.Resource // taken by the first task of the group
+}
+
+// Master is the fake mesos master.
+type Master struct {
+	id  string
+	srv *httptest.Server
+
+	sync.Mutex
+	cluster     string
+	heartbeat   time.Duration
+	autoRun     bool
+	leader      *Master // calls are redirected to the leader if set
+	frameworkID string
+	framework   *mesosproto.FrameworkInfo
+	stream      *stream
+	agents      map[string]*Agent
+	agentOrder  []string
+	offers      map[string]*Agent // outstanding offer id -> agent
+	tasks       map[string]*Task
+	calls       []*mesosproto.Call
+	seq         int
+}
+
+// NewMaster starts a fake master listening on a local port.
+func NewMaster() *Master {
+	m := &Master{
+		id:        utils.RandomString(8),
+		cluster:   defaultCluster,
+		heartbeat: defaultHeartbeat,
+		autoRun:   true,
+		agents:    make(map[string]*Agent),
+		offers:    make(map[string]*Agent),
+		tasks:     make(map[string]*Task),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/scheduler", m.serveScheduler)
+	mux.HandleFunc("/master/state", m.serveState)
+	mux.HandleFunc("/master/state.json", m.serveState)
+
+	m.srv = httptest.NewServer(mux)
+
+	return m
+}
+
+// Addr returns the address of the master, eg: `127.0.0.1:36121`.
+func (m *Master) Addr() string {
+	return m.srv.Listener.Addr().String()
+}
+
+// Close disconnects the framework and shuts down the master.
+func (m *Master) Close() {
+	m.Lock()
+	m.closeStream()
+	m.Unlock()
+
+	m.srv.Close()
+}
+
+// SetCluster sets the cluster name reported by the state endpoint.
+func (m *Master) SetCluster(name string) {
+	m.Lock()
+	defer m.Unlock()
+
+	m.cluster = name
+}
+
+// SetHeartbeat sets the heartbeat interval of the subscriptions made later.
+func (m *Master) SetHeartbeat(d time.Duration) {
+	m.Lock()
+	defer m.Unlock()
+
+	m.heartbeat = d
+}
+
+// SetAutoRun sets whether the launched tasks are reported running at once, the
+// tasks stay staging if disabled, until UpdateTask called.
+func (m *Master) SetAutoRun(auto bool) {
+	m.Lock()
+	defer m.Unlock()
+
+	m.autoRun = auto
+}
+
+// RedirectTo makes the master a non-leading one, the calls are redirected to the
+// leader and the state endpoint reports the leader. nil makes it leading again.
+func (m *Master) RedirectTo(leader *Master) {
+	m.Lock()
+	defer m.Unlock()
+
+	m.leader = leader
+	if leader != nil {
+		m.closeStream()
+	}
+}
+
+// Failover closes the subscription stream and drops the outstanding offers, like
+// the leader failed over, the framework id is kept for the resubscription.
+func (m *Master) Failover() {
+	m.Lock()
+	defer m.Unlock()
+
+	m.closeStream()
+}
+
+// FrameworkID returns the id of the framework registered.
+func (m *Master) FrameworkID() string {
+	m.Lock()
+	defer m.Unlock()
+
+	return m.frameworkID
+}
+
+// Framework returns the framework info of the last subscription.
+func (m *Master) Framework() *mesosproto.FrameworkInfo {
+	m.Lock()
+	defer m.Unlock()
+
+	return m.framework
+}
+
+// Subscribed reports whether a framework is connected.
+func (m *Master) Subscribed() bool {
+	m.Lock()
+	defer m.Unlock()
+
+	return m.stream != nil
+}
+
+// AddAgent adds a simulated agent, its resources are offered at once.
+func (m *Master) AddAgent(hostname string, res Resources, attrs map[string]string) *Agent {
+	m.Lock()
+	defer m.Unlock()
+
+	m.seq++
+
+	a := newAgent(fmt.Sprintf("%s-S%d", m.id, m.seq), hostname, res, attrs)
+
+	m.agents[a.ID] = a
+	m.agentOrder = append(m.agentOrder, a.ID)
+
+	m.offer(a)
+
+	return a
+}
+
+// RemoveAgent removes the agent, its tasks are gone and the framework is notified
+// with the agent failure.
+func (m *Master) RemoveAgent(id string) {
+	m.Lock()
+	defer m.Unlock()
+
+	a, ok := m.agents[id]
+	if !ok {
+		return
+	}
+
+	m.rescind(a)
+
+	for _, t := range m.sortedTasks() {
+		if t.AgentID != id {
+			continue
+		}
+
+		t.State = mesosproto.TaskState_TASK_GONE
+		m.sendUpdate(t, mesosproto.TaskStatus_REASON_AGENT_REMOVED.Enum(), "agent removed")
+		delete(m.tasks, t.ID)
+	}
+
+	delete(m.agents, id)
+	for i, aid := range m.agentOrder {
+		if aid == id {
+			m.agentOrder = append(m.agentOrder[:i], m.agentOrder[i+1:]...)
+			break
+		}
+	}
+
+	m.send(&mesosproto.Event{
+		Type: mesosproto.Event_FAILURE.Enum(),
+		Failure: &mesosproto.Event_Failure{
+			AgentId: &mesosproto.AgentID{Value: proto.String(id)},
+		},
+	})
+}
+
+// Offer offers the free resources of the agents without outstanding offers.
+func (m *Master) Offer() {
+	m.Lock()
+	defer m.Unlock()
+
+	for _, id := range m.agentOrder {
+		m.offer(m.agents[id])
+	}
+}
+
+// UpdateTask changes the task state and sends the status update, the resources
+// of the terminated task are released and offered.
+func (m *Master) UpdateTask(id string, state mesosproto.TaskState, message string) error {
+	m.Lock()
+	defer m.Unlock()
+
+	t, ok := m.tasks[id]
+	if !ok {
+		return fmt.Errorf("task %s not exists", id)
+	}
+
+	t.State = state
+
+	var reason *mesosproto.TaskStatus_Reason
+	if state == mesosproto.TaskState_TASK_FAILED {
+		reason = mesosproto.TaskStatus_REASON_COMMAND_EXECUTOR_FAILED.Enum()
+	}
+
+	m.sendUpdate(t, reason, message)
+
+	if isTerminal(state) {
+		m.release(t)
+	}
+
+	return nil
+}
+
+// Task returns a copy of the task.
+func (m *Master) Task(id string) *Task {
+	m.Lock()
+	defer m.Unlock()
+
+	t, ok := m.tasks[id]
+	if !ok {
+		return nil
+	}
+
+	c := *t
+	return &c
+}
+
+// Tasks returns copies of the tasks not terminated.
+func (m *Master) Tasks() []*Task {
+	m.Lock()
+	defer m.Unlock()
+
+	tasks := make([]*Task, 0, len(m.tasks))
+	for _, t := range m.sortedTasks() {
+		c := *t
+		tasks = append(tasks, &c)
+	}
+
+	return tasks
+}
+
+// Volumes returns the persistence ids of the volumes created on the agent.
+func (m *Master) Volumes(agentID string) []string {
+	m.Lock()
+	defer m.Unlock()
+
+	a, ok := m.agents[agentID]
+	if !ok {
+		return nil
+	}
+
+	return a.volumeIDs()
+}
+
+// Calls returns the calls received of the type, all of the calls if typ is nil.
+func (m *Master) Calls(typ *mesosproto.Call_Type) []*mesosproto.Call {
+	m.Lock()
+	defer m.Unlock()
+
+	calls := make([]*mesosproto.Call, 0)
+	for _, c := range m.calls {
+		if typ == nil || c.GetType() == *typ {
+			calls = append(calls, c)
+		}
+	}
+
+	return calls
+}
+
+// WaitFor polls the condition until it's satisfied or timed out.
+func WaitFor(timeout time.Duration, cond func() bool) bool {
+	deadline := time.Now().Add(timeout)
+
+	for time.Now().Before(deadline) {
+		if cond() {
+			return true
+		}
+
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	return cond()
+}
+
+func (m *Master) serveState(w http.ResponseWriter, r *http.Request) {
+	m.Lock()
+	defer m.Unlock()
+
+	leader := m.pid()
+	if m.leader != nil {
+		leader = m.leader.pid()
+	}
+
+	slaves := make([]map[string]interface{}, 0, len(m.agents))
+	for _, id := range m.agentOrder {
+		a := m.agents[id]
+		slaves = append(slaves, map[string]interface{}{
+			"id":       a.ID,
+			"pid":      fmt.Sprintf("slave(1)@%s:5051", a.IP),
+			"hostname": a.Hostname,
+			"active":   true,
+		})
+	}
+
+	frameworks := make([]map[string]interface{}, 0)
+	if m.framework != nil {
+		tasks := make([]map[string]interface{}, 0, len(m.tasks))
+		for _, t := range m.sortedTasks() {
+			tasks = append(tasks, m.stateTask(t))
+		}
+
+		frameworks = append(frameworks, map[string]interface{}{
+			"id":     m.frameworkID,
+			"name":   m.framework.GetName(),
+			"active": m.stream != nil,
+			"tasks":  tasks,
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"id":         m.id,
+		"pid":        m.pid(),
+		"hostname":   "127.0.0.1",
+		"cluster":    m.cluster,
+		"leader":     leader,
+		"slaves":     slaves,
+		"frameworks": frameworks,
+	})
+}
+
+// stateTask is the task in the state, with the host ports and the container address
+// of its latest status.
+func (m *Master) stateTask(t *Task) map[string]interface{} {
+	ports := make([]string, 0)
+	for _, res := range t.Info.GetResources() {
+		if res.GetName() != "ports" {
+			continue
+		}
+
+		for _, r := range res.GetRanges().GetRange() {
+			ports = append(ports, fmt.Sprintf("%d-%d", r.GetBegin(), r.GetEnd()))
+		}
+	}
+
+	status := map[string]interface{}{
+		"state": t.State.String(),
+	}
+
+	if a, ok := m.agents[t.AgentID]; ok && t.State == mesosproto.TaskState_TASK_RUNNING {
+		status["container_status"] = map[string]interface{}{
+			"network_infos": []interface{}{
+				map[string]interface{}{
+					"ip_addresses": []interface{}{
+						map[string]interface{}{"ip_address": a.IP},
+					},
+				},
+			},
+		}
+	}
+
+	return map[string]interface{}{
+		"id":           t.ID,
+		"name":         t.Name,
+		"framework_id": m.frameworkID,
+		"slave_id":     t.AgentID,
+		"state":        t.State.String(),
+		"resources": map[string]interface{}{
+			"ports": "[" + strings.Join(ports, ", ") + "]",
+		},
+		"statuses": []interface{}{status},
+	}
+}
+
+func (m *Master) pid() string {
+	return "master@" + m.Addr()
+}
+
+func (m *Master) serveScheduler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	m.Lock()
+	leader := m.leader
+	m.Unlock()
+
+	if leader != nil {
+		w.Header().Set("Location", "//"+leader.Addr()+"/api/v1/scheduler")
+		w.WriteHeader(http.StatusTemporaryRedirect)
+		return
+	}
+
+	bs, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	call := new(mesosproto.Call)
+	if err := proto.Unmarshal(bs, call); err != nil {
+		http.Error(w, "decode call got error: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if call.GetType() == mesosproto.Call_SUBSCRIBE {
+		m.subscribe(w, r, call)
+		return
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	m.calls = append(m.calls, call)
+
+	if m.stream == nil || r.Header.Get("Mesos-Stream-Id") != m.stream.id {
+		http.Error(w, "the framework is not subscribed or with an invalid Mesos-Stream-Id", http.StatusBadRequest)
+		return
+	}
+
+	switch call.GetType() {
+	case mesosproto.Call_ACCEPT:
+		m.accept(call.GetAccept())
+	case mesosproto.Call_DECLINE:
+		for _, id := range call.GetDecline().GetOfferIds() {
+			if a, ok := m.offers[id.GetValue()]; ok {
+				a.offerID = ""
+				delete(m.offers, id.GetValue())
+			}
+		}
+	case mesosproto.Call_KILL:
+		m.kill(call.GetKill().GetTaskId().GetValue())
+	case mesosproto.Call_RECONCILE:
+		m.reconcile(call.GetReconcile())
+	case mesosproto.Call_TEARDOWN:
+		m.closeStream()
+		for _, t := range m.sortedTasks() {
+			t.State = mesosproto.TaskState_TASK_KILLED
+			m.release(t)
+		}
+		m.frameworkID = ""
+	}
+
+	// the others, eg: ACKNOWLEDGE, are only recorded.
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (m *Master) subscribe(w http.ResponseWriter, r *http.Request, call *mesosproto.Call) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming not supported", http.StatusInternalServerError)
+		return
+	}
+
+	m.Lock()
+
+	m.calls = append(m.calls, call)
+	m.closeStream()
+
+	fw := call.GetSubscribe().GetFrameworkInfo()
+	if id := fw.GetId().GetValue(); id != "" {
+		m.frameworkID = id
+	}
+	if m.frameworkID == "" {
+		m.frameworkID = m.id + "-0000"
+	}
+
+	m.framework = fw
+
+	st := newStream(m.id + "-" + strconv.Itoa(m.seq))
+	m.seq++
+	m.stream = st
+
+	m.send(&mesosproto.Event{
+		Type: mesosproto.Event_SUBSCRIBED.Enum(),
+		Subscribed: &mesosproto.Event_Subscribed{
+			FrameworkId:              &mesosproto.FrameworkID{Value: proto.String(m.frameworkID)},
+			HeartbeatIntervalSeconds: proto.Float64(m.heartbeat.Seconds()),
+		},
+	})
+
+	for _, id := range m.agentOrder {
+		m.offer(m.agents[id])
+	}
+
+	heartbeat := time.NewTicker(m.heartbeat)
+	defer heartbeat.Stop()
+
+	m.Unlock()
+
+	defer func() {
+		close(st.gone) // unblocks the senders before locking
+
+		m.Lock()
+		if m.stream == st {
+			m.closeStream()
+		}
+		m.Unlock()
+	}()
+
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	w.Header().Set("Mesos-Stream-Id", st.id)
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for {
+		select {
+		case ev := <-st.events:
+			if err := writeRecord(w, ev); err != nil {
+				return
+			}
+			flusher.Flush()
+		case <-heartbeat.C:
+			if err := writeRecord(w, &mesosproto.Event{Type: mesosproto.Event_HEARTBEAT.Enum()}); err != nil {
+				return
+			}
+			flusher.Flush()
+		case <-st.done:
+			return
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
+
+// accept applies the operations on the offers in order, the tasks launched on
+// invalid offers are dropped and the other operations on them are ignored.
+func (m *Master) accept(accept *mesosproto.Call_Accept) {
+	var (
+		agent *Agent
+		valid = true
+	)
+
+	for _, id := range accept.GetOfferIds() {
+		a, ok := m.offers[id.GetValue()]
+		if !ok {
+			valid = false
+			continue
+		}
+
+		agent = a
+		a.offerID = ""
+		delete(m.offers, id.GetValue())
+	}
+
+	if agent == nil {
+		valid = false
+	}
+
+	for _, op := range accept.GetOperations() {
+		switch op.GetType() {
+		case mesosproto.Offer_Operation_RESERVE:
+			if valid {
+				agent.reserve(op.GetReserve().GetResources())
+			}
+		case mesosproto.Offer_Operation_UNRESERVE:
+			if valid {
+				agent.unreserve(op.GetUnreserve().GetResources())
+			}
+		case mesosproto.Offer_Operation_CREATE:
+			if valid {
+				agent.create(op.GetCreate().GetVolumes())
+			}
+		case mesosproto.Offer_Operation_DESTROY:
+			if valid {
+				agent.destroy(op.GetDestroy().GetVolumes())
+			}
+		case mesosproto.Offer_Operation_LAUNCH:
+			for _, info := range op.GetLaunch().GetTaskInfos() {
+				m.launch(agent, info, nil, false, valid)
+			}
+		case mesosproto.Offer_Operation_LAUNCH_GROUP:
+			executor := op.GetLaunchGroup().GetExecutor()
+			for i, info := range op.GetLaunchGroup().GetTaskGroup().GetTasks() {
+				m.launch(agent, info, executor, i == 0, valid)
+			}
+		}
+	}
+
+	if agent != nil {
+		m.offer(agent)
+	}
+}
+
+// launch runs the task on the agent, the resources of the task group's executor
+// are taken by its first task.
+func (m *Master) launch(agent *Agent, info *mesosproto.TaskInfo, executor *mesosproto.ExecutorInfo, first, valid bool) {
+	t := &Task{
+		ID:    info.GetTaskId().GetValue(),
+		Name:  info.GetName(),
+		State: mesosproto.TaskState_TASK_STAGING,
+		Info:  info,
+	}
+
+	if !valid {
+		t.State = mesosproto.TaskState_TASK_DROPPED
+		m.sendUpdate(t, mesosproto.TaskStatus_REASON_INVALID_OFFERS.Enum(), "invalid offers")
+		return
+	}
+
+	t.AgentID = agent.ID
+	agent.use(info.GetResources(), false)
+
+	if executor != nil {
+		t.group = executor.GetExecutorId().GetValue()
+		if first {
+			t.executor = executor.GetResources()
+			agent.use(t.executor, false)
+		}
+	}
+
+	m.tasks[t.ID] = t
+
+	if m.autoRun {
+		t.State = mesosproto.TaskState_TASK_RUNNING
+	}
+
+	m.sendUpdate(t, nil, "")
+}
+
+// kill kills the task, or the whole task group it belongs to.
+func (m *Master) kill(id string) {
+	t, ok := m.tasks[id]
+	if !ok {
+		m.sendUpdate(&Task{ID: id, State: mesosproto.TaskState_TASK_KILLED}, nil, "task unknown")
+		return
+	}
+
+	for _, k := range m.sortedTasks() {
+		if k.ID != id && (t.group == "" || k.group != t.group) {
+			continue
+		}
+
+		k.State = mesosproto.TaskState_TASK_KILLED
+		m.sendUpdate(k, nil, "killed")
+
+		m.release(k)
+	}
+}
+
+// reconcile sends the latest states of the tasks, all of the tasks for the implicit
+// reconciliation, the tasks unknown are reported as TASK_UNKNOWN.
+func (m *Master) reconcile(rec *mesosproto.Call_Reconcile) {
+	if len(rec.GetTasks()) == 0 {
+		for _, t := range m.sortedTasks() {
+			m.sendUpdate(t, mesosproto.TaskStatus_REASON_RECONCILIATION.Enum(), "")
+		}
+		return
+	}
+
+	for _, rt := range rec.GetTasks() {
+		id := rt.GetTaskId().GetValue()
+
+		t, ok := m.tasks[id]
+		if !ok {
+			t = &Task{
+				ID:      id,
+				AgentID: rt.GetAgentId().GetValue(),
+				State:   mesosproto.TaskState_TASK_UNKNOWN,
+			}
+		}
+
+		m.sendUpdate(t, mesosproto.TaskStatus_REASON_RECONCILIATION.Enum(), "")
+	}
+}
+
+// release frees the resources of the terminated task and offers them.
+func (m *Master) release(t *Task) {
+	delete(m.tasks, t.ID)
+
+	a, ok := m.agents[t.AgentID]
+	if !ok {
+		return
+	}
+
+	a.use(t.Info.GetResources(), true)
+	a.use(t.executor, true)
+
+	m.rescind(a)
+	m.offer(a)
+}
+
+// offer sends the free resources of the agent as an offer, unless it has an
+// outstanding offer or nothing left.
+func (m *Master) offer(a *Agent) {
+	if m.stream == nil || a.offerID != "" || !a.hasFree() {
+		return
+	}
+
+	m.seq++
+
+	a.offerID = fmt.Sprintf("%s-O%d", m.id, m.seq)
+	m.offers[a.offerID] = a
+
+	// offered to the first role of the MULTI_ROLE framework.
+	var allocation *mesosproto.Resource_AllocationInfo
+	if roles := m.framework.GetRoles(); len(roles) > 0 {
+		allocation = &mesosproto.Resource_AllocationInfo{Role: proto.String(roles[0])}
+	}
+
+	m.send(&mesosproto.Event{
+		Type: mesosproto.Event_OFFERS.Enum(),
+		Offers: &mesosproto.Event_Offers{
+			Offers: []*mesosproto.Offer{
+				{
+					AllocationInfo: allocation,
+					Id:             &mesosproto.OfferID{Value: proto.String(a.offerID)},
+					FrameworkId:    &mesosproto.FrameworkID{Value: proto.String(m.frameworkID)},
+					AgentId:        &mesosproto.AgentID{Value: proto.String(a.ID)},
+					Hostname:       proto.String(a.Hostname),
+					Url: &mesosproto.URL{
+						Scheme: proto.String("http"),
+						Address: &mesosproto.Address{
+							Hostname: proto.String(a.Hostname),
+							Ip:       proto.String(a.IP),
+							Port:     proto.Int32(5051),
+						},
+					},
+					Resources:  a.freeResources(),
+					Attributes: a.attributes(),
+				},
+			},
+		},
+	})
+}
+
+// rescind takes back the outstanding offer of the agent.
+func (m *Master) rescind(a *Agent) {
+	if a.offerID == "" {
+		return
+	}
+
+	m.send(&mesosproto.Event{
+		Type: mesosproto.Event_RESCIND.Enum(),
+		Rescind: &mesosproto.Event_Rescind{
+			OfferId: &mesosproto.OfferID{Value: proto.String(a.offerID)},
+		},
+	})
+
+	delete(m.offers, a.offerID)
+	a.offerID = ""
+}
+
+func (m *Master) sendUpdate(t *Task, reason *mesosproto.TaskStatus_Reason, message string) {
+	status := &mesosproto.TaskStatus{
+		TaskId:    &mesosproto.TaskID{Value: proto.String(t.ID)},
+		State:     t.State.Enum(),
+		Source:    mesosproto.TaskStatus_SOURCE_MASTER.Enum(),
+		Reason:    reason,
+		Message:   proto.String(message),
+		Timestamp: proto.Float64(float64(time.Now().UnixNano()) / 1e9),
+		Uuid:      []byte(utils.RandomString(16)),
+	}
+
+	if t.AgentID != "" {
+		status.AgentId = &mesosproto.AgentID{Value: proto.String(t.AgentID)}
+	}
+
+	if a, ok := m.agents[t.AgentID]; ok && t.State == mesosproto.TaskState_TASK_RUNNING {
+		status.ContainerStatus = &mesosproto.ContainerStatus{
+			NetworkInfos: []*mesosproto.NetworkInfo{
+				{
+					IpAddresses: []*mesosproto.NetworkInfo_IPAddress{
+						{IpAddress: proto.String(a.IP)},
+					},
+				},
+			},
+		}
+	}
+
+	m.send(&mesosproto.Event{
+		Type:   mesosproto.Event_UPDATE.Enum(),
+		Update: &mesosproto.Event_Update{Status: status},
+	})
+}
+
+// send queues the event to the subscription stream, dropped if not subscribed.
+func (m *Master) send(ev *mesosproto.Event) {
+	if m.stream == nil {
+		return
+	}
+
+	select {
+	case m.stream.events <- ev:
+	case <-m.stream.done:
+	case <-m.stream.gone:
+	}
+}
+
+// closeStream disconnects the framework, the outstanding offers are dropped.
+func (m *Master) closeStream() {
+	if m.stream == nil {
+		return
+	}
+
+	close(m.stream.done)
+	m.stream = nil
+
+	for id, a := range m.offers {
+		a.offerID = ""
+		delete(m.offers, id)
+	}
+}
+
+func (m *Master) sortedTasks() []*Task {
+	tasks := make([]*Task, 0, len(m.tasks))
+	for _, id := range m.agentOrder {
+		for _, t := range m.tasks {
+			if t.AgentID == id {
+				tasks = append(tasks, t)
+			}
+		}
+	}
+
+	return tasks
+}
+
+type stream struct {
+	id     string
+	events chan *mesosproto.Event
+	done   chan struct{} // closed by the master
+	gone   chan struct{} // closed as the connection finished
+}
+
+func newStream(id string) *stream {
+	return &stream{
+		id:     id,
+		events: make(chan *mesosproto.Event, 4096),
+		done:   make(chan struct{}),
+		gone:   make(chan struct{}),
+	}
+}
+
+// writeRecord writes the event in the RecordIO framing.
+func writeRecord(w http.ResponseWriter, ev *mesosproto.Event) error {
+	bs, err := proto.Marshal(ev)
+	if err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintf(w, "%d\n", len(bs)); err != nil {
+		return err
+	}
+
+	_, err = w.Write(bs)
+	return err
+}
+
+func isTerminal(state mesosproto.TaskState) bool {
+	switch state {
+	case mesosproto.TaskState_TASK_FINISHED,
+		mesosproto.TaskState_TASK_FAILED,
+		mesosproto.TaskState_TASK_KILLED,
+		mesosproto.TaskState_TASK_ERROR,
+		mesosproto.TaskState_TASK_LOST,
+		mesosproto.TaskState_TASK_DROPPED,
+		mesosproto.TaskState_TASK_GONE,
+		mesosproto.TaskState_TASK_GONE_BY_OPERATOR:
+		return true
+	}
+
+	return false
+}
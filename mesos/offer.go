@@ -3,7 +3,10 @@ package mesos
 import (
 	"encoding/json"
 
+	"github.com/golang/protobuf/proto"
+
 	"github.com/Dataman-Cloud/swan/mesosproto"
+	"github.com/Dataman-Cloud/swan/utils"
 )
 
 type Offer struct {
@@ -15,7 +18,22 @@ type Offer struct {
 	portRanges []*portRange
 	attrs      map[string]string
 	hostname   string
+	ip         string
 	agentId    string
+	role       string                            // allocated to, for the MULTI_ROLE framework
+	reserved   map[string][]*mesosproto.Resource // reservation id -> reserved resources
+	static     []*roleShare                      // reserved for the framework's roles, counted as capacity
+}
+
+// roleShare is the resources of the offer reserved for a role of the framework
+// without any swan reservation, eg: the static reservations by `--resources`. The
+// tasks launched on them are given the role, see roleResources.
+type roleShare struct {
+	role  string
+	cpus  float64
+	mem   float64
+	disk  float64
+	ports map[uint64]bool
 }
 
 type portRange struct {
@@ -27,11 +45,16 @@ func (r *portRange) MarshalJSON() ([]byte, error) {
 	return json.Marshal([]uint64{r.begin, r.end})
 }
 
-func newOffer(offer *mesosproto.Offer) *Offer {
+// newOffer parses the offer, the resources reserved for the given roles without
+// any swan reservation are counted as the unreserved ones.
+func newOffer(offer *mesosproto.Offer, roles []string) *Offer {
 	f := &Offer{
 		id:       offer.GetId().GetValue(),
 		hostname: offer.GetHostname(),
+		ip:       offer.GetUrl().GetAddress().GetIp(),
 		agentId:  offer.GetAgentId().GetValue(),
+		role:     offer.GetAllocationInfo().GetRole(),
+		reserved: make(map[string][]*mesosproto.Resource),
 	}
 
 	var (
@@ -40,7 +63,35 @@ func newOffer(offer *mesosproto.Offer) *Offer {
 		portRanges      []*portRange
 	)
 
+	shares := make(map[string]*roleShare)
+
 	for _, resource := range offer.Resources {
+		// the reserved resources are only used by the tasks of their reservations.
+		if role := resource.GetRole(); role != "" && role != "*" {
+			if id := reservationOf(resource); id != "" {
+				f.reserved[id] = append(f.reserved[id], resource)
+				continue
+			}
+
+			// the volumes are only mounted by the tasks created them.
+			if resource.GetDisk().GetPersistence() != nil {
+				continue
+			}
+
+			if role != f.role && !utils.SliceContains(roles, role) {
+				continue
+			}
+
+			share, ok := shares[role]
+			if !ok {
+				share = &roleShare{role: role, ports: make(map[uint64]bool)}
+				shares[role] = share
+				f.static = append(f.static, share)
+			}
+
+			share.add(resource)
+		}
+
 		if *resource.Name == "cpus" {
 			cpus += *resource.Scalar.Value
 		}
@@ -118,10 +169,21 @@ func (f *Offer) GetPortRange() (ranges []string) {
 	return
 }
 
+// HasReservation reports whether the offer holds resources of the reservation.
+func (f *Offer) HasReservation(id string) bool {
+	return len(f.reserved[id]) > 0
+}
+
 func (f *Offer) GetAgentId() string {
 	return f.agentId
 }
 
+// GetRole returns the role the offer is allocated to, empty if the framework
+// isn't MULTI_ROLE.
+func (f *Offer) GetRole() string {
+	return f.role
+}
+
 func (f *Offer) GetAttrs() map[string]string {
 	return f.attrs
 }
@@ -130,6 +192,15 @@ func (f *Offer) GetHostname() string {
 	return f.hostname
 }
 
+// GetIP returns the agent's address, the hostname if unknown.
+func (f *Offer) GetIP() string {
+	if f.ip == "" {
+		return f.hostname
+	}
+
+	return f.ip
+}
+
 func (f *Offer) MarshalJSON() ([]byte, error) {
 	m := map[string]interface{}{
 		"id":       f.id,
@@ -138,12 +209,155 @@ func (f *Offer) MarshalJSON() ([]byte, error) {
 		"disk":     f.disk,
 		"ports":    f.portRanges,
 		"hostname": f.hostname,
+		"role":     f.role,
 		"attrs":    f.attrs,
+		"reserved": f.reservations(),
 	}
 
 	return json.Marshal(m)
 }
 
+func (f *Offer) reservations() []string {
+	ids := make([]string, 0, len(f.reserved))
+	for id := range f.reserved {
+		ids = append(ids, id)
+	}
+
+	return ids
+}
+
+func (r *roleShare) add(resource *mesosproto.Resource) {
+	switch resource.GetName() {
+	case "cpus":
+		r.cpus += resource.GetScalar().GetValue()
+	case "mem":
+		r.mem += resource.GetScalar().GetValue()
+	case "disk":
+		r.disk += resource.GetScalar().GetValue()
+	case "ports":
+		for _, rg := range resource.GetRanges().GetRange() {
+			for p := rg.GetBegin(); p <= rg.GetEnd(); p++ {
+				r.ports[p] = true
+			}
+		}
+	}
+}
+
+// take takes the amount of the scalar resource up to v, and returns the amount taken.
+func (r *roleShare) take(name string, v float64) float64 {
+	var left *float64
+
+	switch name {
+	case "cpus":
+		left = &r.cpus
+	case "mem":
+		left = &r.mem
+	case "disk":
+		left = &r.disk
+	default:
+		return 0
+	}
+
+	if v > *left {
+		v = *left
+	}
+	*left -= v
+
+	return v
+}
+
+// roleResources takes the unreserved cpus, mem, disk and ports of the task from the
+// static reservations of the offers first, those are given the role reserved for,
+// and the rest are left unreserved. The shares are consumed, so each task of one
+// launch takes its own.
+func roleResources(offers []*Offer, resources []*mesosproto.Resource) []*mesosproto.Resource {
+	shares := make([]*roleShare, 0)
+	for _, offer := range offers {
+		shares = append(shares, offer.static...)
+	}
+
+	if len(shares) == 0 {
+		return resources
+	}
+
+	rs := make([]*mesosproto.Resource, 0, len(resources))
+
+	for _, res := range resources {
+		if role := res.GetRole(); (role != "" && role != "*") || res.GetReservation() != nil {
+			rs = append(rs, res)
+			continue
+		}
+
+		switch res.GetType() {
+		case mesosproto.Value_SCALAR:
+			left := res.GetScalar().GetValue()
+
+			for _, share := range shares {
+				if v := share.take(res.GetName(), left); v > 0 {
+					rs = append(rs, withRole(res, share.role, &mesosproto.Value_Scalar{Value: proto.Float64(v)}, nil))
+					left -= v
+				}
+			}
+
+			if left > 0 {
+				rs = append(rs, withRole(res, "", &mesosproto.Value_Scalar{Value: proto.Float64(left)}, nil))
+			}
+		case mesosproto.Value_RANGES:
+			if res.GetName() != "ports" {
+				rs = append(rs, res)
+				continue
+			}
+
+			ranges := make(map[string]*mesosproto.Value_Ranges) // role -> ports
+			roles := make([]string, 0)
+
+			for _, rg := range res.GetRanges().GetRange() {
+				for p := rg.GetBegin(); p <= rg.GetEnd(); p++ {
+					role := ""
+					for _, share := range shares {
+						if share.ports[p] {
+							delete(share.ports, p)
+							role = share.role
+							break
+						}
+					}
+
+					if _, ok := ranges[role]; !ok {
+						ranges[role] = &mesosproto.Value_Ranges{}
+						roles = append(roles, role)
+					}
+
+					ranges[role].Range = append(ranges[role].Range, &mesosproto.Value_Range{
+						Begin: proto.Uint64(p),
+						End:   proto.Uint64(p),
+					})
+				}
+			}
+
+			for _, role := range roles {
+				rs = append(rs, withRole(res, role, nil, ranges[role]))
+			}
+		default:
+			rs = append(rs, res)
+		}
+	}
+
+	return rs
+}
+
+// withRole copies the resource with the role and the value given, unreserved if the
+// role is empty.
+func withRole(res *mesosproto.Resource, role string, scalar *mesosproto.Value_Scalar, ranges *mesosproto.Value_Ranges) *mesosproto.Resource {
+	r := proto.Clone(res).(*mesosproto.Resource)
+
+	r.Scalar, r.Ranges = scalar, ranges
+	if role != "" {
+		r.Role = proto.String(role)
+	}
+
+	return r
+}
+
 func (f *Offer) getPorts(n int) []uint64 {
 	return f.ports[0:n]
 }
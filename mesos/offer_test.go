@@ -0,0 +1,95 @@
+package mesos
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/Dataman-Cloud/swan/mesosproto"
+	"github.com/Dataman-Cloud/swan/types"
+)
+
+func scalar(name, role string, v float64) *mesosproto.Resource {
+	return &mesosproto.Resource{
+		Name:   proto.String(name),
+		Type:   mesosproto.Value_SCALAR.Enum(),
+		Role:   proto.String(role),
+		Scalar: &mesosproto.Value_Scalar{Value: proto.Float64(v)},
+	}
+}
+
+func ports(role string, begin, end uint64) *mesosproto.Resource {
+	return &mesosproto.Resource{
+		Name: proto.String("ports"),
+		Type: mesosproto.Value_RANGES.Enum(),
+		Role: proto.String(role),
+		Ranges: &mesosproto.Value_Ranges{
+			Range: []*mesosproto.Value_Range{{Begin: proto.Uint64(begin), End: proto.Uint64(end)}},
+		},
+	}
+}
+
+// TestOfferStaticReservation counts the resources statically reserved for the
+// framework's roles as capacity, and launches the tasks on them with the role.
+func TestOfferStaticReservation(t *testing.T) {
+	reserved := scalar("cpus", "swan", 4)
+	reserved.Reservation = &mesosproto.Resource_ReservationInfo{
+		Labels: &mesosproto.Labels{Labels: []*mesosproto.Label{{Key: proto.String(types.ReservationLabel), Value: proto.String("0.mysql")}}},
+	}
+
+	offer := &mesosproto.Offer{
+		Id:      &mesosproto.OfferID{Value: proto.String("offer0")},
+		AgentId: &mesosproto.AgentID{Value: proto.String("agent0")},
+		Resources: []*mesosproto.Resource{
+			scalar("cpus", "*", 1),
+			scalar("cpus", "swan", 2),
+			scalar("cpus", "other", 8),
+			scalar("mem", "swan", 512),
+			ports("swan", 31000, 31000),
+			ports("*", 31001, 31009),
+			reserved,
+		},
+	}
+
+	f := newOffer(offer, []string{"swan"})
+
+	if f.GetCpus() != 3 || f.GetMem() != 512 || len(f.GetPorts()) != 10 {
+		t.Fatalf("expected cpus 3, mem 512 and 10 ports, got %v, %v and %d", f.GetCpus(), f.GetMem(), len(f.GetPorts()))
+	}
+
+	rs := roleResources([]*Offer{f}, []*mesosproto.Resource{
+		scalar("cpus", "*", 2.5),
+		scalar("mem", "*", 256),
+		ports("*", 31000, 31001),
+	})
+
+	got := make(map[string]float64)
+	for _, r := range rs {
+		switch r.GetType() {
+		case mesosproto.Value_SCALAR:
+			got[r.GetName()+" "+r.GetRole()] += r.GetScalar().GetValue()
+		case mesosproto.Value_RANGES:
+			for _, rg := range r.GetRanges().GetRange() {
+				got[r.GetName()+" "+r.GetRole()] += float64(rg.GetEnd() - rg.GetBegin() + 1)
+			}
+		}
+	}
+
+	for key, v := range map[string]float64{
+		"cpus swan":  2,
+		"cpus *":     0.5,
+		"mem swan":   256,
+		"ports swan": 1,
+		"ports *":    1,
+	} {
+		if got[key] != v {
+			t.Errorf("%s: expected %v, got %v", key, v, got[key])
+		}
+	}
+
+	// the share left for the next task.
+	rs = roleResources([]*Offer{f}, []*mesosproto.Resource{scalar("mem", "*", 512)})
+	if len(rs) != 2 || rs[0].GetRole() != "swan" || rs[0].GetScalar().GetValue() != 256 {
+		t.Errorf("expected 256 mem of role swan left, got %v", rs)
+	}
+}
@@ -0,0 +1,73 @@
+package mesos
+
+import (
+	log "github.com/Sirupsen/logrus"
+
+	"github.com/Dataman-Cloud/swan/mesosproto"
+	"github.com/Dataman-Cloud/swan/types"
+)
+
+// PlacedTask is a db task which has been placed on an agent, with its app
+// and the version it's running.
+type PlacedTask struct {
+	AppID   string
+	Task    *types.Task
+	Version *types.Version
+}
+
+// tasksByAgent returns all of the placed db tasks those not terminated yet,
+// grouped by agent id.
+func (s *Scheduler) tasksByAgent() (map[string][]*PlacedTask, error) {
+	apps, err := s.db.ListApps()
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make(map[string][]*PlacedTask)
+
+	for _, app := range apps {
+		tasks, err := s.db.ListTasks(app.ID)
+		if err != nil {
+			log.Errorf("list tasks of app %s got error: %v", app.ID, err)
+			continue
+		}
+
+		versions := make(map[string]*types.Version)
+
+		for _, task := range tasks {
+			if task.AgentId == "" || !isActiveStatus(task.Status) {
+				continue
+			}
+
+			ver, ok := versions[task.Version]
+			if !ok {
+				ver, err = s.db.GetVersion(app.ID, task.Version)
+				if err != nil {
+					log.Errorf("find version %s of app %s got error: %v", task.Version, app.ID, err)
+					continue
+				}
+				versions[task.Version] = ver
+			}
+
+			ret[task.AgentId] = append(ret[task.AgentId], &PlacedTask{
+				AppID:   app.ID,
+				Task:    task,
+				Version: ver,
+			})
+		}
+	}
+
+	return ret, nil
+}
+
+// isActiveStatus reports whether the db task status is a mesos task state which
+// is not terminal, the task is holding resources on its agent. The launched task
+// stays pending until its first status update arrives.
+func isActiveStatus(status string) bool {
+	state, ok := mesosproto.TaskState_value[status]
+	if !ok {
+		return status == "pending"
+	}
+
+	return !isTerminalState(mesosproto.TaskState(state))
+}
@@ -0,0 +1,217 @@
+package mesos
+
+import (
+	"strings"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/golang/protobuf/proto"
+
+	"github.com/Dataman-Cloud/swan/mesosproto"
+	"github.com/Dataman-Cloud/swan/store"
+	"github.com/Dataman-Cloud/swan/types"
+)
+
+func (t *Task) isPod() bool {
+	return t.cfg != nil && t.cfg.Pod != nil
+}
+
+// podDone collects the container status of the pod, the pod launch is done when
+// any container failed or all of the containers are done.
+func (t *Task) podDone(status *mesosproto.TaskStatus) (bool, error) {
+	if err := t.DetectError(status); err != nil {
+		return true, err
+	}
+
+	_, container, ok := types.SplitPodContainerTaskID(status.TaskId.GetValue())
+	if !ok {
+		return false, nil
+	}
+
+	t.states[container] = status
+
+	if len(t.states) < len(t.cfg.Pod.Containers) {
+		return false, nil
+	}
+
+	for _, s := range t.states {
+		if !t.IsDone(s) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// runtimeTaskID returns the id of the runtime task which the mesos task belongs
+// to, the pod task for the pod containers.
+func runtimeTaskID(taskId string) string {
+	if podId, _, ok := types.SplitPodContainerTaskID(taskId); ok {
+		return podId
+	}
+
+	return taskId
+}
+
+// mesosTaskIDs returns the mesos tasks of the db task.
+func mesosTaskIDs(task *types.Task) []string {
+	if len(task.Containers) == 0 {
+		return []string{task.ID}
+	}
+
+	ids := make([]string, 0, len(task.Containers))
+	for _, c := range task.Containers {
+		ids = append(ids, c.TaskID)
+	}
+
+	return ids
+}
+
+// killTarget returns the mesos task to be killed for the db task. Killing any
+// container of the pod makes the default executor kill the whole task group.
+func (s *Scheduler) killTarget(taskId string) string {
+	parts := strings.SplitN(taskId, ".", 3)
+	if len(parts) < 3 {
+		return taskId
+	}
+
+	task, err := s.db.GetTask(parts[2], taskId)
+	if err != nil || len(task.Containers) == 0 {
+		return taskId
+	}
+
+	return task.Containers[0].TaskID
+}
+
+// newTaskContainers returns the initial container statuses of the pod task.
+func newTaskContainers(id string, pod *types.Pod) []*types.TaskContainer {
+	containers := make([]*types.TaskContainer, 0, len(pod.Containers))
+
+	for _, c := range pod.Containers {
+		containers = append(containers, &types.TaskContainer{
+			Name:    c.Name,
+			TaskID:  types.PodContainerTaskID(id, c.Name),
+			Status:  "pending",
+			Healthy: types.TaskHealthyUnset,
+		})
+	}
+
+	return containers
+}
+
+// podStatus saves the container status into the db pod task, and returns the
+// status of the whole pod.
+func (s *Scheduler) podStatus(appId, podId, container string, status *mesosproto.TaskStatus) *mesosproto.TaskStatus {
+	task, err := s.db.GetTask(appId, podId)
+	if err != nil {
+		return nil
+	}
+
+	ver, err := s.db.GetVersion(appId, task.Version)
+	if err != nil {
+		log.Errorf("find task version got error: %v. task %s, version %s", err, task.ID, task.Version)
+		return nil
+	}
+
+	err = store.UpdateTaskWith(s.db, appId, task, func(task *types.Task) {
+		for _, c := range task.Containers {
+			if c.Name != container {
+				continue
+			}
+
+			c.Status = status.GetState().String()
+			c.ErrMsg = ""
+			if status.GetState() != mesosproto.TaskState_TASK_RUNNING {
+				c.ErrMsg = status.GetReason().String() + ":" + status.GetMessage()
+			}
+
+			c.Healthy = types.TaskHealthyUnset
+			if pc := podContainer(ver.Pod, container); pc != nil && pc.HealthCheck != nil {
+				c.Healthy = types.TaskUnHealthy
+				if status.GetHealthy() {
+					c.Healthy = types.TaskHealthy
+				}
+			}
+		}
+	})
+	if err != nil {
+		log.Errorf("update pod task %s container %s got error: %v", podId, container, err)
+		return nil
+	}
+
+	healthy := true
+	for _, c := range task.Containers {
+		if c.Healthy == types.TaskUnHealthy {
+			healthy = false
+		}
+	}
+
+	return &mesosproto.TaskStatus{
+		TaskId:  &mesosproto.TaskID{Value: proto.String(podId)},
+		AgentId: status.AgentId,
+		State:   podState(task.Containers).Enum(),
+		Reason:  status.Reason,
+		Message: status.Message,
+		Healthy: proto.Bool(healthy),
+	}
+}
+
+// podState sums up the container states. The pod fails as any container fails,
+// finishes as all containers finish, and runs as all containers are running
+// (or finished, eg: init containers).
+func podState(containers []*types.TaskContainer) mesosproto.TaskState {
+	var (
+		finished = 0
+		running  = 0
+		other    = mesosproto.TaskState_TASK_STAGING
+	)
+
+	for _, c := range containers {
+		v, ok := mesosproto.TaskState_value[c.Status]
+		if !ok { // pending
+			continue
+		}
+
+		state := mesosproto.TaskState(v)
+
+		switch state {
+		case mesosproto.TaskState_TASK_FINISHED:
+			finished++
+		case mesosproto.TaskState_TASK_RUNNING:
+			running++
+		case mesosproto.TaskState_TASK_KILLING:
+			other = state
+		default:
+			if isTerminalState(state) {
+				return state
+			}
+
+			if other != mesosproto.TaskState_TASK_KILLING {
+				other = state
+			}
+		}
+	}
+
+	if finished == len(containers) {
+		return mesosproto.TaskState_TASK_FINISHED
+	}
+
+	if other != mesosproto.TaskState_TASK_KILLING && finished+running == len(containers) {
+		return mesosproto.TaskState_TASK_RUNNING
+	}
+
+	return other
+}
+
+func podContainer(pod *types.Pod, name string) *types.PodContainer {
+	if pod == nil {
+		return nil
+	}
+
+	for _, c := range pod.Containers {
+		if c.Name == name {
+			return c
+		}
+	}
+
+	return nil
+}
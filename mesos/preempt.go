@@ -0,0 +1,286 @@
+package mesos
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/golang/protobuf/proto"
+
+	"github.com/Dataman-Cloud/swan/mesosproto"
+	"github.com/Dataman-Cloud/swan/store"
+	"github.com/Dataman-Cloud/swan/types"
+	"github.com/Dataman-Cloud/swan/utils"
+)
+
+const (
+	// how long a launch waits for resources before preempting lower priority tasks.
+	preemptionDelay = time.Duration(15 * time.Second)
+)
+
+var (
+	errNoVictims = errors.New("no lower priority tasks to preempt")
+)
+
+type victimList []*PlacedTask
+
+func (vl victimList) Len() int      { return len(vl) }
+func (vl victimList) Swap(i, j int) { vl[i], vl[j] = vl[j], vl[i] }
+func (vl victimList) Less(i, j int) bool {
+	if vl[i].Version.Priority != vl[j].Version.Priority {
+		return vl[i].Version.Priority < vl[j].Version.Priority
+	}
+
+	// the youngest goes first
+	return vl[i].Task.Created.After(vl[j].Task.Created)
+}
+
+// pickVictims returns the lower priority tasks on the agent that need to be killed
+// to make room for the config, nil if it can't be done on this agent. The host ports
+// of the victims are freed as well, the config needs as many ports as its port mappings,
+// including the fixed host ports of the cni networks.
+func pickVictims(config *types.TaskConfig, agent *Agent, placed []*PlacedTask) victimList {
+	cpus, mem, disk, offered := agent.Resources()
+
+	ports := make(map[uint64]bool)
+	for _, port := range offered {
+		ports[port] = true
+	}
+
+	fixed := hostPorts(config.Network, config.PortMappings, 0)
+
+	fits := func() bool {
+		if cpus < config.CPUs || mem < config.Mem || disk < config.Disk {
+			return false
+		}
+
+		if len(ports) < len(config.PortMappings) {
+			return false
+		}
+
+		for _, port := range fixed {
+			if !ports[port] {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	lower := make(victimList, 0)
+	for _, p := range placed {
+		if p.Version.Priority < config.Priority {
+			lower = append(lower, p)
+		}
+	}
+
+	sort.Sort(lower)
+
+	victims := make(victimList, 0)
+	for _, p := range lower {
+		if fits() {
+			break
+		}
+
+		victims = append(victims, p)
+
+		cpus += p.Version.CPUs
+		mem += p.Version.Mem
+		disk += p.Version.Disk
+
+		if c := p.Version.Container; c != nil && c.Docker != nil {
+			for _, port := range hostPorts(c.Docker.Network, c.PortMappings(), p.Task.Port) {
+				ports[port] = true
+			}
+		}
+	}
+
+	if !fits() {
+		return nil
+	}
+
+	return victims
+}
+
+// hostPorts returns the host ports taken by the port mappings on the network, the port
+// is the one assigned from the offers to the task, which the host and bridge networks
+// take. The fixed host ports of the cni networks are only known from the mappings.
+func hostPorts(network string, pms []*types.PortMapping, port uint64) []uint64 {
+	ports := make([]uint64, 0)
+
+	for _, pm := range pms {
+		switch network {
+		case "host":
+			if pm.HostPort == 0 && port > 0 {
+				return []uint64{port}
+			}
+		case "bridge":
+			if port > 0 {
+				return []uint64{port}
+			}
+		case types.NetworkCNI:
+			if pm.HostPort > 0 {
+				ports = append(ports, uint64(pm.HostPort))
+			}
+		}
+	}
+
+	return ports
+}
+
+// preempt looks for the agent on which the fewest lower priority tasks need to be
+// killed to place the config. The victims are killed and put back into the launch
+// queue, and the launch itself keeps waiting for the released offers.
+func (s *Scheduler) preempt(ctx *PlacementContext) error {
+	var (
+		config = ctx.Config
+		placed = ctx.Placed()
+	)
+
+	var (
+		chosen  *Agent
+		victims victimList
+	)
+
+	for _, agent := range ApplyFilters(placementFilters(s.filters), ctx, s.getAgents()) {
+		vs := pickVictims(config, agent, placed[agent.ID()])
+		if vs == nil {
+			continue
+		}
+
+		if len(vs) == 0 { // enough resources already, just waiting for offers.
+			return nil
+		}
+
+		if chosen == nil || len(vs) < len(victims) {
+			chosen, victims = agent, vs
+		}
+	}
+
+	if chosen == nil {
+		return errNoVictims
+	}
+
+	log.Printf("Preempting %d task(s) on agent %s for priority %d launch", len(victims), chosen.Hostname(), config.Priority)
+
+	for _, v := range victims {
+		if err := s.evict(v, config.Priority); err != nil {
+			log.Errorf("preempt task %s got error: %v", v.Task.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// evict kills the victim gracefully and requeues it as a new task with the same name.
+func (s *Scheduler) evict(v *PlacedTask, priority int32) error {
+	var (
+		appId = v.AppID
+		t     = v.Task
+	)
+
+	if err := s.gracefulKill(t.ID, t.AgentId, v.Version.KillPolicy); err != nil {
+		return err
+	}
+
+	if err := s.db.DeleteTask(t.ID); err != nil {
+		return err
+	}
+
+	cfg := types.NewTaskConfig(v.Version)
+
+	if cfg.FixedIP() {
+		cfg.Parameters = append(cfg.Parameters, &types.Parameter{
+			Key:   "ip",
+			Value: t.IP,
+		})
+
+		cfg.IP = t.IP
+	}
+
+	var (
+		name = t.Name
+		id   = fmt.Sprintf("%s.%s", utils.RandomString(12), name)
+	)
+
+	task := &types.Task{
+		ID:      id,
+		Name:    name,
+		Weight:  t.Weight,
+		Status:  "pending",
+		Healthy: types.TaskHealthyUnset,
+		Version: t.Version,
+		ErrMsg:  fmt.Sprintf("preempted by a priority %d launch", priority),
+		Created: t.Created,
+		Updated: time.Now(),
+	}
+
+	if err := s.db.CreateTask(appId, task); err != nil {
+		return err
+	}
+
+	go func() {
+		results, err := s.LaunchTasks([]*Task{NewTask(cfg, id, name)})
+		if err == nil {
+			err = results[id]
+		}
+
+		if err != nil {
+			log.Errorf("relaunch preempted task %s got error: %v", id, err)
+
+			task, dberr := s.db.GetTask(appId, id)
+			if dberr != nil {
+				return
+			}
+
+			if dberr = store.UpdateTaskWith(s.db, appId, task, func(task *types.Task) {
+				task.Status = "Failed"
+				task.ErrMsg = err.Error()
+			}); dberr != nil {
+				log.Errorf("update task %s got error: %v", id, dberr)
+			}
+		}
+	}()
+
+	return nil
+}
+
+// gracefulKill sends kill call with the kill policy of the task's version.
+func (s *Scheduler) gracefulKill(taskId, agentId string, policy *types.KillPolicy) error {
+	log.Debugln("Killing task gracefully ", taskId)
+
+	call := &mesosproto.Call{
+		FrameworkId: s.FrameworkId(),
+		Type:        mesosproto.Call_KILL.Enum(),
+		Kill: &mesosproto.Call_Kill{
+			TaskId: &mesosproto.TaskID{
+				Value: proto.String(s.killTarget(taskId)),
+			},
+			AgentId: &mesosproto.AgentID{
+				Value: proto.String(agentId),
+			},
+		},
+	}
+
+	if policy != nil && policy.Duration > 0 {
+		call.Kill.KillPolicy = &mesosproto.KillPolicy{
+			GracePeriod: &mesosproto.DurationInfo{
+				Nanoseconds: proto.Int64(policy.Duration * 1000 * 1000),
+			},
+		}
+	}
+
+	resp, err := s.Send(call)
+	if err != nil {
+		return err
+	}
+
+	if code := resp.StatusCode; code != http.StatusAccepted {
+		return fmt.Errorf("kill call send but the status code not 202 got %d", code)
+	}
+
+	return nil
+}
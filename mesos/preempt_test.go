@@ -0,0 +1,71 @@
+package mesos
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/Dataman-Cloud/swan/mesosproto"
+	"github.com/Dataman-Cloud/swan/types"
+)
+
+// TestPickVictimsPorts picks the victims for the host ports, the cpus, mem and disk
+// offered are enough already.
+func TestPickVictimsPorts(t *testing.T) {
+	agent := newAgent("agent0", "host0", nil)
+	agent.addOffer(newOffer(&mesosproto.Offer{
+		Id:      &mesosproto.OfferID{Value: proto.String("offer0")},
+		AgentId: &mesosproto.AgentID{Value: proto.String("agent0")},
+		Resources: []*mesosproto.Resource{
+			scalar("cpus", "*", 4),
+			scalar("mem", "*", 4096),
+			ports("*", 31000, 31000),
+		},
+	}, nil))
+
+	placed := func(id string, network string, port uint64) *PlacedTask {
+		return &PlacedTask{
+			AppID: "nginx.default.bbk.dataman",
+			Task:  &types.Task{ID: id, Port: port},
+			Version: &types.Version{
+				CPUs: 0.1,
+				Container: &types.Container{
+					Docker: &types.Docker{
+						Network:      network,
+						PortMappings: []*types.PortMapping{{ContainerPort: 80, Name: "web"}},
+					},
+				},
+			},
+		}
+	}
+
+	tasks := []*PlacedTask{placed("bridge", "bridge", 31005), placed("cni", types.NetworkCNI, 0)}
+
+	// the fixed host port held by the bridge task.
+	fixed := &types.TaskConfig{
+		CPUs:         0.5,
+		Priority:     10,
+		Network:      types.NetworkCNI,
+		PortMappings: []*types.PortMapping{{ContainerPort: 80, HostPort: 31005}},
+	}
+
+	if vs := pickVictims(fixed, agent, tasks); len(vs) != 1 || vs[0].Task.ID != "bridge" {
+		t.Errorf("expected the bridge task preempted for its port, got %v", vs)
+	}
+
+	// two ports but the cni task without a host port freed.
+	two := &types.TaskConfig{
+		CPUs:         0.5,
+		Priority:     10,
+		Network:      "bridge",
+		PortMappings: []*types.PortMapping{{ContainerPort: 80}, {ContainerPort: 443}},
+	}
+
+	if vs := pickVictims(two, agent, tasks[1:]); vs != nil {
+		t.Errorf("expected no victims for the ports, got %v", vs)
+	}
+
+	if vs := pickVictims(two, agent, tasks); len(vs) != 1 || vs[0].Task.ID != "bridge" {
+		t.Errorf("expected the bridge task preempted for its port, got %v", vs)
+	}
+}
@@ -0,0 +1,366 @@
+package mesos
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Dataman-Cloud/swan/types"
+	"github.com/Dataman-Cloud/swan/utils"
+)
+
+var (
+	errLaunchCancelled = errors.New("launch cancelled")
+)
+
+type launchResult struct {
+	rets map[string]error
+	err  error
+}
+
+// appIdOf returns the app id from task name `index.appId`.
+func appIdOf(taskName string) string {
+	if parts := strings.SplitN(taskName, ".", 2); len(parts) == 2 {
+		return parts[1]
+	}
+
+	return ""
+}
+
+// pendingLaunch is a batch of tasks waiting in the launch queue. It's kept in the
+// queue while dispatching, until placed and done, so it's still listed and can be
+// cancelled while waiting for the offers.
+type pendingLaunch struct {
+	id          string
+	appId       string
+	priority    int32
+	tasks       []*Task
+	enqueued    time.Time
+	dispatching bool // popped and being placed, protected by the queue
+
+	sync.Mutex          // protects followings two
+	cancelled  []string // ids of tasks removed while queued or dispatching
+	stopped    bool
+
+	stop chan struct{} // closed once the whole launch cancelled
+	done chan *launchResult
+}
+
+func newPendingLaunch(tasks []*Task) *pendingLaunch {
+	p := &pendingLaunch{
+		id:       utils.RandomString(12),
+		tasks:    tasks,
+		enqueued: time.Now(),
+		stop:     make(chan struct{}),
+		done:     make(chan *launchResult, 1),
+	}
+
+	p.appId = appIdOf(tasks[0].GetName())
+
+	if cfg := tasks[0].cfg; cfg != nil {
+		p.priority = cfg.Priority
+	}
+
+	return p
+}
+
+func (p *pendingLaunch) finish(rets map[string]error, err error) {
+	p.Lock()
+	if rets != nil {
+		for _, id := range p.cancelled {
+			rets[id] = errLaunchCancelled
+		}
+	}
+	p.Unlock()
+
+	p.done <- &launchResult{rets, err}
+}
+
+// cancel stops the placement of the whole launch.
+func (p *pendingLaunch) cancel() {
+	p.Lock()
+	defer p.Unlock()
+
+	if !p.stopped {
+		p.stopped = true
+		close(p.stop)
+	}
+}
+
+// cancelTasks marks the tasks cancelled, they're dropped before launched.
+func (p *pendingLaunch) cancelTasks(ids []string) {
+	p.Lock()
+	defer p.Unlock()
+
+	p.cancelled = append(p.cancelled, ids...)
+}
+
+// live returns the tasks not cancelled.
+func (p *pendingLaunch) live(tasks []*Task) []*Task {
+	p.Lock()
+	defer p.Unlock()
+
+	ret := make([]*Task, 0, len(tasks))
+	for _, t := range tasks {
+		if !utils.SliceContains(p.cancelled, t.ID()) {
+			ret = append(ret, t)
+		}
+	}
+
+	return ret
+}
+
+// launchQueue holds all of the pending launches, ordered by priority (higher first)
+// and then by age (older first). LaunchTasks callers block until their launch is
+// popped out and finished by the dispatcher.
+type launchQueue struct {
+	sync.Mutex
+	cond  *sync.Cond
+	items []*pendingLaunch
+}
+
+func newLaunchQueue() *launchQueue {
+	q := &launchQueue{
+		items: make([]*pendingLaunch, 0),
+	}
+	q.cond = sync.NewCond(&q.Mutex)
+
+	return q
+}
+
+func (q *launchQueue) Len() int      { return len(q.items) }
+func (q *launchQueue) Swap(i, j int) { q.items[i], q.items[j] = q.items[j], q.items[i] }
+func (q *launchQueue) Less(i, j int) bool {
+	if q.items[i].priority != q.items[j].priority {
+		return q.items[i].priority > q.items[j].priority
+	}
+
+	return q.items[i].enqueued.Before(q.items[j].enqueued)
+}
+
+func (q *launchQueue) push(p *pendingLaunch) {
+	q.Lock()
+	defer q.Unlock()
+
+	q.items = append(q.items, p)
+	sort.Stable(q)
+
+	q.cond.Signal()
+}
+
+// pop blocks until there is any launch not dispatching, and returns the first one
+// marked dispatching. It's kept in the queue until done.
+func (q *launchQueue) pop() *pendingLaunch {
+	q.Lock()
+	defer q.Unlock()
+
+	for {
+		for _, p := range q.items {
+			if !p.dispatching {
+				p.dispatching = true
+				return p
+			}
+		}
+
+		q.cond.Wait()
+	}
+}
+
+// remove takes the launch with given id out of the queue, the dispatching one is
+// stopped, and finished by the dispatcher instead of the caller.
+func (q *launchQueue) remove(id string) (p *pendingLaunch, dispatching bool) {
+	q.Lock()
+	defer q.Unlock()
+
+	for i, p := range q.items {
+		if p.id == id {
+			q.items = append(q.items[:i], q.items[i+1:]...)
+			if p.dispatching {
+				p.cancel()
+			}
+			return p, p.dispatching
+		}
+	}
+
+	return nil, false
+}
+
+func (q *launchQueue) setPriority(id string, priority int32) error {
+	q.Lock()
+	defer q.Unlock()
+
+	for _, p := range q.items {
+		if p.id == id {
+			if p.dispatching {
+				return fmt.Errorf("launch %s is dispatching", id)
+			}
+
+			p.priority = priority
+			sort.Stable(q)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("launch %s not found", id)
+}
+
+// removeApp drops the tasks of the app. If no task ids given, all of the app's
+// launches are removed. The queued launches those become empty are returned so
+// the caller can notify the waiters, the dispatching ones are stopped and counted
+// in stopped, and finished by the dispatcher. The tasks of the dispatching launch
+// kept are dropped before launched.
+func (q *launchQueue) removeApp(appId string, taskIds []string) (removed []*pendingLaunch, stopped int) {
+	q.Lock()
+	defer q.Unlock()
+
+	var (
+		kept = make([]*pendingLaunch, 0, len(q.items))
+	)
+
+	removed = make([]*pendingLaunch, 0)
+
+	for _, p := range q.items {
+		if p.appId != appId {
+			kept = append(kept, p)
+			continue
+		}
+
+		if len(taskIds) > 0 {
+			var (
+				tasks     = make([]*Task, 0, len(p.tasks))
+				cancelled = make([]string, 0)
+			)
+
+			for _, t := range p.live(p.tasks) {
+				if utils.SliceContains(taskIds, t.ID()) {
+					cancelled = append(cancelled, t.ID())
+					continue
+				}
+				tasks = append(tasks, t)
+			}
+
+			p.cancelTasks(cancelled)
+
+			// the tasks of the dispatching launch are read by the dispatcher.
+			if !p.dispatching {
+				p.tasks = tasks
+			}
+
+			if len(tasks) > 0 {
+				kept = append(kept, p)
+				continue
+			}
+		}
+
+		if p.dispatching {
+			p.cancel()
+			stopped++
+			continue
+		}
+
+		removed = append(removed, p)
+	}
+
+	q.items = kept
+
+	return removed, stopped
+}
+
+// done takes the dispatched launch out of the queue, if not removed yet.
+func (q *launchQueue) done(p *pendingLaunch) {
+	q.Lock()
+	defer q.Unlock()
+
+	for i, item := range q.items {
+		if item == p {
+			q.items = append(q.items[:i], q.items[i+1:]...)
+			return
+		}
+	}
+}
+
+func (q *launchQueue) size() int {
+	q.Lock()
+	defer q.Unlock()
+
+	return len(q.items)
+}
+
+func (q *launchQueue) list() []*types.QueuedLaunch {
+	q.Lock()
+	defer q.Unlock()
+
+	ret := make([]*types.QueuedLaunch, 0, len(q.items))
+	for i, p := range q.items {
+		tasks := p.live(p.tasks)
+
+		ids := make([]string, 0, len(tasks))
+		for _, t := range tasks {
+			ids = append(ids, t.ID())
+		}
+
+		ret = append(ret, &types.QueuedLaunch{
+			ID:          p.id,
+			AppID:       p.appId,
+			Priority:    p.priority,
+			Position:    i,
+			Tasks:       ids,
+			Dispatching: p.dispatching,
+			EnqueuedAt:  p.enqueued,
+		})
+	}
+
+	return ret
+}
+
+// dispatchLaunches pops the pending launches one by one and places them.
+func (s *Scheduler) dispatchLaunches() {
+	for {
+		p := s.queue.pop()
+
+		rets, err := s.launchTasks(p, p.tasks)
+
+		s.queue.done(p)
+		p.finish(rets, err)
+	}
+}
+
+// QueuedLaunches returns all of the pending launches in dispatching order.
+func (s *Scheduler) QueuedLaunches() []*types.QueuedLaunch {
+	return s.queue.list()
+}
+
+// CancelLaunch removes a pending launch from the queue, the waiting caller
+// will get a cancelled error. The dispatching one stops waiting for the offers.
+func (s *Scheduler) CancelLaunch(id string) error {
+	p, dispatching := s.queue.remove(id)
+	if p == nil {
+		return fmt.Errorf("launch %s not found", id)
+	}
+
+	if !dispatching {
+		p.finish(nil, errLaunchCancelled)
+	}
+
+	return nil
+}
+
+// UpdateLaunchPriority reorders a pending launch with a new priority.
+func (s *Scheduler) UpdateLaunchPriority(id string, priority int32) error {
+	return s.queue.setPriority(id, priority)
+}
+
+// CancelAppLaunches removes the app's pending tasks (all of them if no task id
+// given), the dispatching ones included, and returns the number of launches
+// cancelled entirely.
+func (s *Scheduler) CancelAppLaunches(appId string, taskIds ...string) int {
+	removed, stopped := s.queue.removeApp(appId, taskIds)
+	for _, p := range removed {
+		p.finish(nil, errLaunchCancelled)
+	}
+
+	return len(removed) + stopped
+}
@@ -0,0 +1,91 @@
+package mesos_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Dataman-Cloud/swan/mesos"
+	"github.com/Dataman-Cloud/swan/mesos/mesostest"
+	"github.com/Dataman-Cloud/swan/store/memory"
+	"github.com/Dataman-Cloud/swan/types"
+)
+
+// TestCancelDispatchingLaunch cancels the launch waiting for the offers, it's still
+// listed in the queue meanwhile and never launched.
+func TestCancelDispatchingLaunch(t *testing.T) {
+	m := mesostest.NewMaster()
+	defer m.Close()
+
+	m.AddAgent("agent0", mesostest.Resources{
+		CPUs:  1,
+		Mem:   1024,
+		Disk:  10240,
+		Ports: [2]uint64{31000, 31099},
+	}, nil)
+
+	db, err := memory.NewMemoryStore("")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sched := newScheduler(t, m, db)
+
+	if rets, err := sched.LaunchTasks(nil); err != nil || len(rets) != 0 {
+		t.Fatalf("expected nothing launched, got %v, %v", rets, err)
+	}
+
+	appId := "nginx.default.bbk." + sched.ClusterName()
+
+	// more cpus than the agent has.
+	ver := &types.Version{
+		ID:        "1",
+		Name:      "nginx",
+		RunAs:     "bbk",
+		Instances: 1,
+		CPUs:      2,
+		Mem:       32,
+		Container: &types.Container{
+			Type:   "docker",
+			Docker: &types.Docker{Image: "nginx", Network: "host"},
+		},
+	}
+
+	name := "0." + appId
+	id := fmt.Sprintf("ab3cd5ef6gh0.%s", name)
+
+	errCh := make(chan error, 1)
+	go func() {
+		rets, err := sched.LaunchTasks([]*mesos.Task{mesos.NewTask(types.NewTaskConfig(ver), id, name)})
+		if err == nil {
+			err = rets[id]
+		}
+		errCh <- err
+	}()
+
+	waitFor(t, "the launch dispatching", func() bool {
+		queued := sched.QueuedLaunches()
+		return len(queued) == 1 && queued[0].Dispatching
+	})
+
+	if n := sched.CancelAppLaunches(appId); n != 1 {
+		t.Errorf("expected 1 launch cancelled, got %d", n)
+	}
+
+	select {
+	case err := <-errCh:
+		if err == nil || err.Error() != "launch cancelled" {
+			t.Errorf("expected launch cancelled, got %v", err)
+		}
+	case <-time.After(waitTimeout):
+		t.Fatal("timed out waiting for the launch cancelled")
+	}
+
+	if queued := sched.QueuedLaunches(); len(queued) != 0 {
+		t.Errorf("expected the queue empty, got %d launches", len(queued))
+	}
+
+	if tasks := m.Tasks(); len(tasks) != 0 {
+		t.Errorf("expected nothing launched, got %d tasks", len(tasks))
+	}
+}
@@ -2,64 +2,126 @@ package mesos
 
 import (
 	"bufio"
-	"bytes"
+	"errors"
+	"fmt"
 	"io"
-	"strconv"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/Dataman-Cloud/swan/mesosproto"
+)
+
+const (
+	// max size of a record, the OFFERS event of a large cluster is far below it.
+	maxRecordSize = 64 << 20
+
+	// max length of the record header, the decimal size plus '\n'.
+	maxHeaderSize = 20
+)
+
+var (
+	errRecordTooLarge = errors.New("recordio: record too large")
+	errBadHeader      = errors.New("recordio: malformed record header")
 )
 
-// NOTE(nmg): copy from github.com/mesos/mesos-go/recordio
+// RecordReader reads the records out of the RecordIO framing, each record is a
+// decimal size line followed by the bytes of that size, eg: `5\nhello`.
+//
+// Records are read one by one as they're consumed, so a slow consumer holds the
+// stream without buffering more than one record.
+type RecordReader struct {
+	r   *bufio.Reader
+	buf []byte // reused between records
+}
 
-// NewReader returns an io.Reader that unpacks the data read from r out of
-// RecordIO framing before returning it.
-func NewReader(r io.Reader) io.Reader {
+func NewRecordReader(r io.Reader) *RecordReader {
 	br, ok := r.(*bufio.Reader)
 	if !ok {
 		br = bufio.NewReader(r)
 	}
-	return &reader{r: br}
+
+	return &RecordReader{r: br}
 }
 
-type reader struct {
-	r       *bufio.Reader
-	pending uint64
+// ReadRecord returns the next record, which is only valid until the next call.
+func (rr *RecordReader) ReadRecord() ([]byte, error) {
+	size, err := rr.size()
+	if err != nil {
+		return nil, err
+	}
+
+	if uint64(cap(rr.buf)) < size {
+		rr.buf = make([]byte, size)
+	}
+
+	rec := rr.buf[:size]
+	if _, err := io.ReadFull(rr.r, rec); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+
+	return rec, nil
 }
 
-func (rr *reader) Read(p []byte) (n int, err error) {
-	for err == nil && len(p) > 0 {
-		if rr.pending == 0 {
-			if n > 0 && !rr.more() {
-				// We've read enough. Don't potentially block reading the next header.
-				break
+// size reads the record header strictly, only decimal digits are allowed.
+func (rr *RecordReader) size() (uint64, error) {
+	var (
+		size uint64
+		n    int
+	)
+
+	for {
+		c, err := rr.r.ReadByte()
+		if err != nil {
+			if err == io.EOF && n > 0 {
+				err = io.ErrUnexpectedEOF
 			}
-			rr.pending, err = rr.size()
-			continue
+			return 0, err
+		}
+
+		if c == '\n' {
+			break
+		}
+
+		if c < '0' || c > '9' || n >= maxHeaderSize {
+			return 0, errBadHeader
+		}
+
+		size = size*10 + uint64(c-'0')
+		n++
+
+		if size > maxRecordSize {
+			return 0, errRecordTooLarge
 		}
-		read, hi := 0, min(rr.pending, uint64(len(p)))
-		read, err = rr.r.Read(p[:hi])
-		n += read
-		p = p[read:]
-		rr.pending -= uint64(read)
 	}
-	return n, err
+
+	if n == 0 {
+		return 0, errBadHeader
+	}
+
+	return size, nil
 }
 
-func (rr *reader) more() bool {
-	peek, err := rr.r.Peek(rr.r.Buffered())
-	return err != nil && bytes.IndexByte(peek, '\n') >= 0
+// EventDecoder decodes the protobuf events of the subscription stream.
+type EventDecoder struct {
+	rr *RecordReader
 }
 
-func (rr *reader) size() (uint64, error) {
-	header, err := rr.r.ReadSlice('\n')
+func NewEventDecoder(r io.Reader) *EventDecoder {
+	return &EventDecoder{rr: NewRecordReader(r)}
+}
+
+func (d *EventDecoder) Decode(ev *mesosproto.Event) error {
+	rec, err := d.rr.ReadRecord()
 	if err != nil {
-		return 0, err
+		return err
 	}
-	// NOTE(tsenart): https://github.com/golang/go/issues/2632
-	return strconv.ParseUint(string(bytes.TrimSpace(header)), 10, 64)
-}
 
-func min(a, b uint64) uint64 {
-	if a < b {
-		return a
+	if err := proto.Unmarshal(rec, ev); err != nil {
+		return fmt.Errorf("decode event got error: %v", err)
 	}
-	return b
+
+	return nil
 }
@@ -0,0 +1,203 @@
+package mesos
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/Dataman-Cloud/swan/mesosproto"
+)
+
+// the fixtures are single events of the subscription stream in the JSON format of
+// the mesos v1 scheduler API: an OFFERS event of 40 agents and a TASK_RUNNING UPDATE.
+const (
+	offersFixture = "offers.json"
+	updateFixture = "update.json"
+)
+
+func loadEvent(tb testing.TB, name string) *mesosproto.Event {
+	bs, err := ioutil.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		tb.Fatal(err)
+	}
+
+	ev := new(mesosproto.Event)
+	if err := json.Unmarshal(bs, ev); err != nil {
+		tb.Fatalf("decode %s got error: %v", name, err)
+	}
+
+	return ev
+}
+
+// frame returns the record in the RecordIO framing.
+func frame(rec []byte) []byte {
+	return append([]byte(fmt.Sprintf("%d\n", len(rec))), rec...)
+}
+
+// repeatReader reads the data n times over.
+type repeatReader struct {
+	data []byte
+	off  int
+	n    int
+}
+
+func (r *repeatReader) Read(p []byte) (int, error) {
+	if r.n == 0 {
+		return 0, io.EOF
+	}
+
+	n := copy(p, r.data[r.off:])
+	r.off += n
+	if r.off == len(r.data) {
+		r.off = 0
+		r.n--
+	}
+
+	return n, nil
+}
+
+// jsonRecordReader unpacks the records of the JSON subscription stream decoded
+// before the protobuf one, as the baseline of the benchmarks.
+type jsonRecordReader struct {
+	r       *bufio.Reader
+	pending uint64
+}
+
+func (rr *jsonRecordReader) Read(p []byte) (n int, err error) {
+	for err == nil && len(p) > 0 {
+		if rr.pending == 0 {
+			if n > 0 && !rr.more() {
+				break
+			}
+			rr.pending, err = rr.size()
+			continue
+		}
+
+		hi := uint64(len(p))
+		if rr.pending < hi {
+			hi = rr.pending
+		}
+
+		read := 0
+		read, err = rr.r.Read(p[:hi])
+		n += read
+		p = p[read:]
+		rr.pending -= uint64(read)
+	}
+
+	return n, err
+}
+
+func (rr *jsonRecordReader) more() bool {
+	peek, err := rr.r.Peek(rr.r.Buffered())
+	return err != nil && bytes.IndexByte(peek, '\n') >= 0
+}
+
+func (rr *jsonRecordReader) size() (uint64, error) {
+	header, err := rr.r.ReadSlice('\n')
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseUint(string(bytes.TrimSpace(header)), 10, 64)
+}
+
+func TestEventDecoder(t *testing.T) {
+	for _, name := range []string{offersFixture, updateFixture} {
+		ev := loadEvent(t, name)
+
+		rec, err := proto.Marshal(ev)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		dec := NewEventDecoder(&repeatReader{data: frame(rec), n: 3})
+		for i := 0; i < 3; i++ {
+			got := new(mesosproto.Event)
+			if err := dec.Decode(got); err != nil {
+				t.Fatalf("%s: decode event %d got error: %v", name, i, err)
+			}
+
+			if !proto.Equal(got, ev) {
+				t.Fatalf("%s: decoded event %d differs", name, i)
+			}
+		}
+
+		if err := dec.Decode(new(mesosproto.Event)); err != io.EOF {
+			t.Errorf("%s: expected EOF at the end of the stream, got %v", name, err)
+		}
+	}
+}
+
+func TestEventDecoderTruncated(t *testing.T) {
+	rec, err := proto.Marshal(loadEvent(t, updateFixture))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := frame(rec)
+
+	dec := NewEventDecoder(bytes.NewReader(data[:len(data)-1]))
+	if err := dec.Decode(new(mesosproto.Event)); err != io.ErrUnexpectedEOF {
+		t.Errorf("expected unexpected EOF, got %v", err)
+	}
+
+	dec = NewEventDecoder(bytes.NewReader([]byte("12x\n")))
+	if err := dec.Decode(new(mesosproto.Event)); err != errBadHeader {
+		t.Errorf("expected bad header, got %v", err)
+	}
+}
+
+func benchmarkProtobuf(b *testing.B, name string) {
+	rec, err := proto.Marshal(loadEvent(b, name))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	data := frame(rec)
+	dec := NewEventDecoder(&repeatReader{data: data, n: b.N})
+
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if err := dec.Decode(new(mesosproto.Event)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func benchmarkJSON(b *testing.B, name string) {
+	rec, err := json.Marshal(loadEvent(b, name))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	data := frame(rec)
+	dec := json.NewDecoder(&jsonRecordReader{r: bufio.NewReader(&repeatReader{data: data, n: b.N})})
+
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		var ev *mesosproto.Event
+		if err := dec.Decode(&ev); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecodeOffersProtobuf(b *testing.B) { benchmarkProtobuf(b, offersFixture) }
+func BenchmarkDecodeOffersJSON(b *testing.B)     { benchmarkJSON(b, offersFixture) }
+func BenchmarkDecodeUpdateProtobuf(b *testing.B) { benchmarkProtobuf(b, updateFixture) }
+func BenchmarkDecodeUpdateJSON(b *testing.B)     { benchmarkJSON(b, updateFixture) }
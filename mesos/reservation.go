@@ -0,0 +1,325 @@
+package mesos
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/golang/protobuf/proto"
+
+	"github.com/Dataman-Cloud/swan/mesosproto"
+	"github.com/Dataman-Cloud/swan/types"
+)
+
+const (
+	// how long a release waits for the offer holding the reserved resources.
+	releaseTimeout = time.Duration(30 * time.Second)
+)
+
+var (
+	errNoFrameworkRole = errors.New("stateful app requires the framework registered with a role, see --framework-roles")
+)
+
+// reservationOf returns the reservation id labeled on the reserved resource.
+func reservationOf(resource *mesosproto.Resource) string {
+	for _, l := range resource.GetReservation().GetLabels().GetLabels() {
+		if l.GetKey() == types.ReservationLabel {
+			return l.GetValue()
+		}
+	}
+
+	return ""
+}
+
+// loadReservation attaches the reservation of the stateful task to its config. A
+// new reservation is made for the task launched first time, and the resources
+// will be reserved on the agent it's placed on.
+func (s *Scheduler) loadReservation(task *Task) error {
+	task.reserve = false
+
+	roles := s.roles()
+	if len(roles) == 0 {
+		return errNoFrameworkRole
+	}
+
+	name := task.GetName()
+
+	r, err := s.db.GetReservation(name)
+	if err != nil {
+		if !strings.Contains(err.Error(), "not exists") {
+			return err
+		}
+
+		r = types.NewReservation(name, appIdOf(name), task.cfg)
+		r.Role = roles[0]
+		r.Principal = s.framework.GetPrincipal()
+	}
+
+	if r.Status == types.ReservationReleasing {
+		return fmt.Errorf("reservation %s is being released", name)
+	}
+
+	task.cfg.Reservation = r
+
+	return nil
+}
+
+// reservedAgents returns the agent holding the reservation.
+func (s *Scheduler) reservedAgents(r *types.Reservation) []*Agent {
+	if a := s.getAgent(r.AgentID); a != nil {
+		return []*Agent{a}
+	}
+
+	return []*Agent{}
+}
+
+// reserve records the new reservation placed on the agent, the resources are
+// reserved for the role of the offers, and the volumes are created right before
+// the task launched.
+func (s *Scheduler) reserve(task *Task, agent *Agent, offers []*Offer) error {
+	r := task.cfg.Reservation
+	if r == nil || r.AgentID != "" {
+		return nil
+	}
+
+	r.AgentID = agent.ID()
+	r.Hostname = agent.Hostname()
+
+	if role := offers[0].GetRole(); role != "" {
+		r.Role = role
+	}
+
+	if err := s.db.CreateReservation(r); err != nil {
+		r.AgentID, r.Hostname = "", ""
+		return err
+	}
+
+	task.reserve = true
+
+	return nil
+}
+
+// ReleaseReservation destroys the persistent volumes and unreserves the resources
+// of the reservation, which should not be used by any active task. The record is
+// removed directly if forced or the agent has gone.
+func (s *Scheduler) ReleaseReservation(id string, force bool) error {
+	r, err := s.db.GetReservation(id)
+	if err != nil {
+		return err
+	}
+
+	if task := s.activeTaskOf(r); task != nil {
+		return fmt.Errorf("reservation %s is in use by task %s", id, task.ID)
+	}
+
+	r.Status = types.ReservationReleasing
+	r.UpdatedAt = time.Now()
+
+	if err := s.db.UpdateReservation(r); err != nil {
+		return err
+	}
+
+	agent := s.getAgent(r.AgentID)
+	if agent == nil || force {
+		log.Warnf("Removing reservation %s without releasing its resources on agent %s", id, r.Hostname)
+		return s.db.DeleteReservation(id)
+	}
+
+	timeout := time.After(releaseTimeout)
+
+	for {
+		offers := agent.reservedOffers(id)
+		if len(offers) > 0 {
+			if err := s.release(r, offers); err != nil {
+				return err
+			}
+
+			return s.db.DeleteReservation(id)
+		}
+
+		select {
+		case <-timeout:
+			return fmt.Errorf("no offer of reservation %s received from agent %s", id, r.Hostname)
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+func (s *Scheduler) release(r *types.Reservation, offers []*Offer) error {
+	offerIds := make([]*mesosproto.OfferID, 0, len(offers))
+	for _, offer := range offers {
+		s.removeOffer(offer)
+
+		offerIds = append(offerIds, &mesosproto.OfferID{
+			Value: proto.String(offer.GetId()),
+		})
+	}
+
+	operations := releaseOperations(r)
+	allocate(offers[0].GetRole(), operations)
+
+	call := &mesosproto.Call{
+		FrameworkId: s.FrameworkId(),
+		Type:        mesosproto.Call_ACCEPT.Enum(),
+		Accept: &mesosproto.Call_Accept{
+			OfferIds:   offerIds,
+			Operations: operations,
+			Filters:    &mesosproto.Filters{RefuseSeconds: proto.Float64(1)},
+		},
+	}
+
+	log.Printf("Releasing reservation %s on agent %s", r.ID, r.Hostname)
+
+	resp, err := s.Send(call)
+	if err != nil {
+		return fmt.Errorf("send release call got error: %v", err)
+	}
+
+	if code := resp.StatusCode; code != http.StatusAccepted {
+		return fmt.Errorf("release call send but the status code not 202 got %d", code)
+	}
+
+	return nil
+}
+
+// activeTaskOf returns the active db task running on the reservation.
+func (s *Scheduler) activeTaskOf(r *types.Reservation) *types.Task {
+	tasks, err := s.db.ListTasks(r.AppID)
+	if err != nil {
+		return nil
+	}
+
+	for _, task := range tasks {
+		if task.Name == r.ID && isActiveStatus(task.Status) {
+			return task
+		}
+	}
+
+	return nil
+}
+
+// reservedResources builds the reserved resources of the reservation.
+func reservedResources(r *types.Reservation, disk float64) []*mesosproto.Resource {
+	rs := make([]*mesosproto.Resource, 0, 3)
+
+	for _, res := range []struct {
+		name  string
+		value float64
+	}{{"cpus", r.CPUs}, {"mem", r.Mem}, {"disk", disk}} {
+		if res.value <= 0 {
+			continue
+		}
+
+		rs = append(rs, reservedScalar(r, res.name, res.value))
+	}
+
+	return rs
+}
+
+// volumeResources builds the persistent volumes of the reservation.
+func volumeResources(r *types.Reservation) []*mesosproto.Resource {
+	rs := make([]*mesosproto.Resource, 0, len(r.Volumes))
+
+	for _, v := range r.Volumes {
+		res := reservedScalar(r, "disk", v.Size)
+		res.Disk = &mesosproto.Resource_DiskInfo{
+			Persistence: &mesosproto.Resource_DiskInfo_Persistence{
+				Id:        proto.String(v.ID),
+				Principal: proto.String(r.Principal),
+			},
+			Volume: &mesosproto.Volume{
+				ContainerPath: proto.String(v.ContainerPath),
+				Mode:          mesosproto.Volume_RW.Enum(),
+			},
+		}
+
+		rs = append(rs, res)
+	}
+
+	return rs
+}
+
+func reservedScalar(r *types.Reservation, name string, value float64) *mesosproto.Resource {
+	return &mesosproto.Resource{
+		Name: proto.String(name),
+		Type: mesosproto.Value_SCALAR.Enum(),
+		Scalar: &mesosproto.Value_Scalar{
+			Value: proto.Float64(value),
+		},
+		Role: proto.String(r.Role),
+		Reservation: &mesosproto.Resource_ReservationInfo{
+			Principal: proto.String(r.Principal),
+			Labels: &mesosproto.Labels{
+				Labels: []*mesosproto.Label{
+					{
+						Key:   proto.String(types.ReservationLabel),
+						Value: proto.String(r.ID),
+					},
+				},
+			},
+		},
+	}
+}
+
+// volumesSize returns the disk occupied by the persistent volumes.
+func volumesSize(r *types.Reservation) float64 {
+	var size float64
+	for _, v := range r.Volumes {
+		size += v.Size
+	}
+
+	return size
+}
+
+// reserveOperations reserves the resources and creates the volumes in them.
+func reserveOperations(r *types.Reservation) []*mesosproto.Offer_Operation {
+	return []*mesosproto.Offer_Operation{
+		{
+			Type: mesosproto.Offer_Operation_RESERVE.Enum(),
+			Reserve: &mesosproto.Offer_Operation_Reserve{
+				Resources: reservedResources(r, r.Disk+volumesSize(r)),
+			},
+		},
+		{
+			Type: mesosproto.Offer_Operation_CREATE.Enum(),
+			Create: &mesosproto.Offer_Operation_Create{
+				Volumes: volumeResources(r),
+			},
+		},
+	}
+}
+
+// releaseOperations destroys the volumes and unreserves the resources.
+func releaseOperations(r *types.Reservation) []*mesosproto.Offer_Operation {
+	return []*mesosproto.Offer_Operation{
+		{
+			Type: mesosproto.Offer_Operation_DESTROY.Enum(),
+			Destroy: &mesosproto.Offer_Operation_Destroy{
+				Volumes: volumeResources(r),
+			},
+		},
+		{
+			Type: mesosproto.Offer_Operation_UNRESERVE.Enum(),
+			Unreserve: &mesosproto.Offer_Operation_Unreserve{
+				Resources: reservedResources(r, r.Disk+volumesSize(r)),
+			},
+		},
+	}
+}
+
+// taskResources returns the resources of the task launched on the reservation,
+// the reserved scalars and volumes besides the unreserved ports.
+func taskResources(r *types.Reservation, resources []*mesosproto.Resource) []*mesosproto.Resource {
+	rs := append(reservedResources(r, r.Disk), volumeResources(r)...)
+
+	for _, res := range resources {
+		if res.GetType() != mesosproto.Value_SCALAR {
+			rs = append(rs, res)
+		}
+	}
+
+	return rs
+}
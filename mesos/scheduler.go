@@ -40,11 +40,25 @@ type SchedulerConfig struct {
 	ZKHost []string
 	ZKPath string
 
+	Masters []string // static master addresses, used instead of zk if provided
+
 	ReconciliationInterval  float64
 	ReconciliationStep      int64
 	ReconciliationStepDelay float64
 
 	HeartbeatTimeout float64
+
+	EnablePreemption bool
+
+	UsageRetention int // days the usage records are kept, 0 for ever
+
+	// of the framework info
+	User       string
+	Name       string
+	Principal  string
+	Secret     string `json:"-"` // of the principal, to authenticate with the master
+	Roles      []string
+	Checkpoint bool
 }
 
 // Scheduler represents a client interacting with mesos master via x-protobuf
@@ -71,18 +85,31 @@ type Scheduler struct {
 	watcher        *time.Timer
 	reconcileTimer *time.Ticker
 
-	strategy Strategy
-	filters  []Filter
+	strategy        Strategy
+	strategyBuilder StrategyBuilder
+	filters         []Filter
 
 	eventmgr *eventManager
 
 	clusterMaster *mole.Master
 
-	sem    chan struct{}
-	status string
+	queue      *launchQueue  // pending launches
+	meter      *usageMeter   // resource usage of running tasks
+	daemonSync chan struct{} // trigger of daemon apps sync
+	status     string
+
+	watchLock sync.Mutex
+	watchers  map[string]chan *mesosproto.TaskStatus // task id -> terminal status
+	jobs      map[string]chan struct{}               // running job id -> stop
+
+	adoptLock  sync.Mutex
+	adoptAddrs map[string]*taskAddr // task id -> address in the mesos state, while adopting
 
 	connection *http.Response //TODO(nmg)
 
+	subLock      sync.Mutex
+	subscription chan struct{} // closed once unsubscribed, stops the loops of the subscription
+
 	events      chan *mesosproto.Event // status update events.
 	offers      chan *mesosproto.Event // offer events
 	failedTasks chan *Task             // hold on all failed tasks(TODO)
@@ -92,7 +119,7 @@ type Scheduler struct {
 func NewScheduler(cfg *SchedulerConfig, db store.Store, strategy Strategy, clusterMaster *mole.Master) (*Scheduler, error) {
 	s := &Scheduler{
 		cfg:           cfg,
-		framework:     defaultFramework(),
+		framework:     newFramework(cfg),
 		quit:          make(chan struct{}),
 		agents:        make(map[string]*Agent),
 		offerTimeout:  time.Duration(10 * time.Second),
@@ -104,13 +131,22 @@ func NewScheduler(cfg *SchedulerConfig, db store.Store, strategy Strategy, clust
 		events:        make(chan *mesosproto.Event, 4096),
 		offers:        make(chan *mesosproto.Event, 4096),
 		failedTasks:   make(chan *Task, 4096),
-		sem:           make(chan struct{}, 1),
+		queue:         newLaunchQueue(),
+		meter:         newUsageMeter(),
+		daemonSync:    make(chan struct{}, 1),
+		watchers:      make(map[string]chan *mesosproto.TaskStatus),
+		jobs:          make(map[string]chan struct{}),
 	}
 
 	if err := s.init(); err != nil {
 		return nil, err
 	}
 
+	go s.dispatchLaunches()
+	go s.handleUpdates()
+	go s.handleOffers()
+	go s.handleFailedTasks()
+
 	return s, nil
 }
 
@@ -121,13 +157,14 @@ func (s *Scheduler) init() error {
 		return err
 	}
 
-	l := state.Leader
+	l := leaderAddr(state.Leader)
 	if l == "" {
 		return fmt.Errorf("no mesos leader found.")
 	}
 
 	s.http = NewHTTPClient(l)
-	s.leader = state.Leader
+	s.http.setCredential(s.framework.GetPrincipal(), s.cfg.Secret)
+	s.leader = l
 
 	s.cluster = state.Cluster
 	if s.cluster == "" {
@@ -164,6 +201,10 @@ func (s *Scheduler) InitFilters(filters []Filter) {
 	s.filters = filters
 }
 
+func (s *Scheduler) InitStrategyBuilder(builder StrategyBuilder) {
+	s.strategyBuilder = builder
+}
+
 // Cluster return current mesos cluster's name
 func (s *Scheduler) ClusterName() string {
 	return s.cluster
@@ -181,7 +222,7 @@ func (s *Scheduler) Send(call *mesosproto.Call) (*http.Response, error) {
 		return nil, err
 	}
 
-	return s.http.send(payload)
+	return s.http.send(payload, call.GetType() == mesosproto.Call_SUBSCRIBE)
 }
 
 func (s *Scheduler) connect() error {
@@ -203,6 +244,9 @@ func (s *Scheduler) connect() error {
 		return fmt.Errorf("subscribe to mesos leader [%s] error [%v]", s.leader, err)
 	}
 
+	// redirected to the new leader.
+	s.leader = s.http.getLeader()
+
 	if code := resp.StatusCode; code != 200 {
 		bs, _ := ioutil.ReadAll(resp.Body)
 		resp.Body.Close()
@@ -216,8 +260,17 @@ func (s *Scheduler) connect() error {
 	return nil
 }
 
-// Subscribe ...
+// Subscribe subscribes to the mesos leader and starts the loops of the subscription,
+// which keep running until Unsubscribe. It does nothing if subscribed already.
 func (s *Scheduler) Subscribe() error {
+	s.subLock.Lock()
+	defer s.subLock.Unlock()
+
+	if s.subscription != nil {
+		log.Warnln("Subscribed to mesos leader already")
+		return nil
+	}
+
 	log.Printf("Subscribing to mesos leader: %s", s.leader)
 
 	s.status = statusConnecting
@@ -227,36 +280,85 @@ func (s *Scheduler) Subscribe() error {
 		return err
 	}
 
-	go s.watchEvents()
-	go s.handleUpdates()
-	go s.handleOffers()
-	go s.handleFailedTasks()
+	stop := make(chan struct{})
+	s.subscription = stop
+
+	go s.watchEvents(stop)
+	go s.meterUsage(stop)
+	go s.syncDaemons(stop)
+	go s.scheduleCronJobs(stop)
+	go s.resumeJobs()
 
 	return nil
 }
 
+// Unsubscribe closes the connection with the mesos leader and stops the loops of
+// the subscription, eg: once the manager is no longer the leader.
 func (s *Scheduler) Unsubscribe() error {
-	log.Println("Unscribing from mesos leader:", s.leader)
+	s.subLock.Lock()
+	defer s.subLock.Unlock()
+
+	if s.subscription == nil {
+		return nil
+	}
+
+	log.Println("Unsubscribing from mesos leader:", s.leader)
+
+	close(s.subscription)
+	s.subscription = nil
+
 	s.stop()
+
 	return nil
 }
 
-func (s *Scheduler) reconnect() {
+// subscribed returns the stop channel of the current subscription, nil if unsubscribed.
+func (s *Scheduler) subscribed() chan struct{} {
+	s.subLock.Lock()
+	defer s.subLock.Unlock()
+
+	return s.subscription
+}
+
+// reconnect resubscribes to the mesos leader until succeeded or the subscription
+// is stopped by Unsubscribe.
+func (s *Scheduler) reconnect(stop chan struct{}) {
+	select {
+	case <-stop:
+		log.Println("Unsubscribed, not reconnecting to mesos leader")
+		return
+	default:
+	}
+
 	// Empty Mesos-Stream-Id for new connect.
 	s.http.Reset()
 
 	s.status = statusConnecting
 
+	// the offers are rescinded as the framework resubscribes.
+	s.clearOffers()
+
 	var (
 		err error
 	)
 
 	for {
+		select {
+		case <-stop:
+			log.Println("Unsubscribed, stop reconnecting to mesos leader")
+			return
+		default:
+		}
+
+		if err = s.detectLeader(); err != nil {
+			log.Errorf("detect mesos leader got error: %v", err)
+		}
+
 		log.Printf("Reconnecting to mesos leader: %s", s.leader)
 
 		err = s.connect()
 		if err == nil {
-			go s.watchEvents()
+			go s.watchEvents(stop)
 
 			return
 		}
@@ -265,33 +367,71 @@ func (s *Scheduler) reconnect() {
 	}
 }
 
+// detectLeader looks for the current leader from zk or the static masters, the
+// subscription is sent to the new leader after mesos master failover.
+func (s *Scheduler) detectLeader() error {
+	state, err := s.MesosState()
+	if err != nil {
+		return err
+	}
+
+	l := leaderAddr(state.Leader)
+	if l == "" {
+		return errors.New("no mesos leader found")
+	}
+
+	if l != s.leader {
+		log.Printf("Mesos leader changed from %s to %s", s.leader, l)
+
+		s.leader = l
+		s.http.setLeader(l)
+	}
+
+	return nil
+}
+
+// leaderAddr returns the address of the leader pid, eg: `master@10.0.0.1:5050`.
+func leaderAddr(pid string) string {
+	if i := strings.Index(pid, "@"); i >= 0 {
+		return pid[i+1:]
+	}
+
+	return pid
+}
+
+// clearOffers drops all of the offers held, which are invalid after resubscribing.
+func (s *Scheduler) clearOffers() {
+	for _, agent := range s.getAgents() {
+		for _, offer := range agent.getOffers() {
+			agent.removeOffer(offer.GetId())
+		}
+	}
+}
+
 func (s *Scheduler) stop() {
 	log.Debugln("Close connection with mesos leader.")
 	s.connection.Body.Close()
 }
 
-func (s *Scheduler) watchEvents() {
+func (s *Scheduler) watchEvents(stop chan struct{}) {
 	defer s.stopWatcher()
 
-	r := NewReader(s.connection.Body)
-	dec := json.NewDecoder(r)
-
-	var (
-		ev  *mesosproto.Event
-		err error
-	)
+	dec := NewEventDecoder(s.connection.Body)
 
 	sem := make(chan struct{}, 1)
 	defer close(sem)
 
 	for {
-		if err = dec.Decode(&ev); err != nil {
+		// the event is handed over to the handlers, never reused.
+		ev := new(mesosproto.Event)
+
+		if err := dec.Decode(ev); err != nil {
 			log.Errorf("mesos events subscriber decode events error: %v", err)
 			if !strings.Contains(err.Error(), "use of closed network connection") {
 				s.stop()
 			}
 
-			go s.reconnect()
+			go s.reconnect(stop)
 
 			return
 		}
@@ -318,6 +458,7 @@ func (s *Scheduler) handleEvent(ev *mesosproto.Event, sem chan struct{}) {
 			taskId  = status.TaskId.GetValue()
 			agentId = status.AgentId.GetValue()
 			state   = status.GetState()
+			id      = runtimeTaskID(taskId)
 		)
 
 		// ack firstly
@@ -329,16 +470,18 @@ func (s *Scheduler) handleEvent(ev *mesosproto.Event, sem chan struct{}) {
 
 		if state == mesosproto.TaskState_TASK_FAILED {
 			if a := s.getAgent(agentId); a != nil {
-				if task := a.getTask(taskId); task != nil {
+				if task := a.getTask(id); task != nil && !task.oneOff {
 					s.failedTasks <- task
 				}
 			}
 		}
 
+		s.notifyWatchers(status)
+
 		// emit event status to ongoing task
 		a := s.getAgent(agentId)
 		if a != nil {
-			if task := a.getTask(taskId); task != nil {
+			if task := a.getTask(id); task != nil {
 				task.SendStatus(status)
 			}
 		}
@@ -391,7 +534,7 @@ func (s *Scheduler) addOffer(offer *mesosproto.Offer) {
 		return
 	}
 
-	f := newOffer(offer)
+	f := newOffer(offer, s.roles())
 
 	log.Debugf("Received offer %s with resource cpus:[%.2f] mem:[%.2fG] disk:[%.2fG] ports:%v from agent %s",
 		f.GetId(), f.GetCpus(), f.GetMem()/1024, f.GetDisk()/1024, f.GetPortRange(), f.GetHostname())
@@ -407,12 +550,9 @@ func (s *Scheduler) removeOffer(offer *Offer) bool {
 		return false
 	}
 
-	found := a.removeOffer(offer.GetId())
-	if a.empty() {
-		s.removeAgent(offer.GetAgentId())
-	}
-
-	return found
+	// NOTE: the agent is kept even without any offers, so the agents fully
+	// occupied are still known for preemption. it's removed on agent lost.
+	return a.removeOffer(offer.GetId())
 }
 
 func (s *Scheduler) declineOffers(offers []*Offer) error {
@@ -505,7 +645,7 @@ func (s *Scheduler) KillTask(taskId, agentId string, sync bool) error {
 		Type:        mesosproto.Call_KILL.Enum(),
 		Kill: &mesosproto.Call_Kill{
 			TaskId: &mesosproto.TaskID{
-				Value: proto.String(taskId),
+				Value: proto.String(s.killTarget(taskId)),
 			},
 			AgentId: &mesosproto.AgentID{
 				Value: proto.String(agentId),
@@ -537,16 +677,44 @@ func (s *Scheduler) KillTask(taskId, agentId string, sync bool) error {
 	return nil
 }
 
-func (s *Scheduler) applyFilters(config *types.TaskConfig) ([]*Agent, error) {
-	filtered := make([]*Agent, 0)
+// applyFilters waits for the agents fit the placement, until timeout or the launch
+// stopped by the cancellation.
+func (s *Scheduler) applyFilters(ctx *PlacementContext, stop <-chan struct{}) ([]*Agent, error) {
+	var (
+		config   = ctx.Config
+		filtered = make([]*Agent, 0)
+		timeout  = time.After(resourceTimeout)
+		preempt  <-chan time.Time // nil channel blocks forever if preemption disabled
+	)
+
+	if s.cfg.EnablePreemption && config.Priority > 0 && config.Reservation == nil {
+		preempt = time.After(preemptionDelay)
+	}
 
-	timeout := time.After(resourceTimeout)
 	for {
 		select {
+		case <-stop:
+			return nil, errLaunchCancelled
 		case <-timeout:
 			return nil, errResourceNotEnough
+		case <-preempt:
+			ctx.refresh()
+			if err := s.preempt(ctx); err != nil {
+				log.Warnf("preempt for priority %d launch got error: %v", config.Priority, err)
+			}
+			preempt = time.After(preemptionDelay)
 		default:
-			filtered = ApplyFilters(s.filters, config, s.getAgents())
+			ctx.refresh()
+			ctx.Agents = s.getAgents()
+
+			filters, agents := s.filters, ctx.Agents
+
+			// the task is pinned to the agent holding its reservation.
+			if r := config.Reservation; r != nil && r.AgentID != "" {
+				filters, agents = resourceFilters(s.filters), s.reservedAgents(r)
+			}
+
+			filtered = ApplyFilters(filters, ctx, agents)
 			if len(filtered) > 0 {
 				return filtered, nil
 			}
@@ -688,7 +856,9 @@ func (s *Scheduler) reconcile() {
 			continue
 		}
 		for _, task := range tasks {
-			m[&mesosproto.TaskID{Value: proto.String(task.ID)}] = &mesosproto.AgentID{Value: proto.String(task.AgentId)}
+			for _, id := range mesosTaskIDs(task) {
+				m[&mesosproto.TaskID{Value: proto.String(id)}] = &mesosproto.AgentID{Value: proto.String(task.AgentId)}
+			}
 		}
 	}
 	if err := s.reconcileTasks(m); err != nil {
@@ -765,6 +935,7 @@ func (s *Scheduler) Dump() interface{} {
 		"config":       s.cfg,
 		"cluster":      s.cluster,
 		"mesos_leader": s.leader,
+		"queue":        s.queue.list(),
 	}
 }
 
@@ -775,7 +946,7 @@ func (s *Scheduler) launch(offers []*Offer, tasks []*Task) (map[string]error, er
 	}
 
 	for i, task := range tasks {
-		if i < len(ports) {
+		if i < len(ports) && !task.isPod() {
 			task.cfg.Port = ports[i]
 		}
 
@@ -784,34 +955,64 @@ func (s *Scheduler) launch(offers []*Offer, tasks []*Task) (map[string]error, er
 		}
 
 		task.Build()
+
+		// taken from the static reservations for the framework's roles if any.
+		if task.isPod() {
+			task.executor.Resources = roleResources(offers, task.executor.Resources)
+			for _, t := range task.group.Tasks {
+				t.Resources = roleResources(offers, t.Resources)
+			}
+			continue
+		}
+
+		task.Resources = roleResources(offers, task.Resources)
 	}
 
 	appId := strings.SplitN(tasks[0].GetName(), ".", 2)[1]
 
 	for _, t := range tasks {
+		if t.oneOff {
+			continue
+		}
+
 		task, err := s.db.GetTask(appId, t.GetTaskId().GetValue())
 		if err != nil {
 			return nil, fmt.Errorf("find task from zk got error: %v", err)
 		}
 
-		task.AgentId = t.AgentId.GetValue()
-		task.IP = t.cfg.IP
+		err = store.UpdateTaskWith(s.db, appId, task, func(task *types.Task) {
+			task.AgentId = t.AgentId.GetValue()
+			task.IP = t.cfg.IP
 
-		if t.cfg.Network == "host" || t.cfg.Network == "bridge" {
-			task.IP = offers[0].GetHostname()
-		}
+			if t.cfg.Network == "host" || t.cfg.Network == "bridge" {
+				task.IP = offers[0].GetIP()
+			}
 
-		task.Port = t.cfg.Port
+			task.Port = t.cfg.Port
 
-		if err := s.db.UpdateTask(appId, task); err != nil {
+			// the task on cni networks is reached by the container ip, which is
+			// learned from the running status.
+			if t.cfg.Network == types.NetworkCNI {
+				task.Port = 0
+				if len(t.cfg.PortMappings) > 0 {
+					task.Port = uint64(t.cfg.PortMappings[0].ContainerPort)
+				}
+			}
+
+			if t.isPod() {
+				task.Containers = newTaskContainers(task.ID, t.cfg.Pod)
+			}
+		})
+		if err != nil {
 			return nil, fmt.Errorf("update task status error: %v", err)
 		}
 
 	}
 
 	var (
-		offerIds  = []*mesosproto.OfferID{}
-		taskInfos = []*mesosproto.TaskInfo{}
+		offerIds   = []*mesosproto.OfferID{}
+		taskInfos  = []*mesosproto.TaskInfo{}
+		operations = []*mesosproto.Offer_Operation{}
 	)
 
 	for _, offer := range offers {
@@ -821,23 +1022,47 @@ func (s *Scheduler) launch(offers []*Offer, tasks []*Task) (map[string]error, er
 	}
 
 	for _, task := range tasks {
+		if task.reserve {
+			operations = append(operations, reserveOperations(task.cfg.Reservation)...)
+		}
+	}
+
+	for _, task := range tasks {
+		// each pod is launched atomically by the default executor.
+		if task.isPod() {
+			task.executor.FrameworkId = s.FrameworkId()
+
+			operations = append(operations, &mesosproto.Offer_Operation{
+				Type: mesosproto.Offer_Operation_LAUNCH_GROUP.Enum(),
+				LaunchGroup: &mesosproto.Offer_Operation_LaunchGroup{
+					Executor:  task.executor,
+					TaskGroup: task.group,
+				},
+			})
+			continue
+		}
+
 		taskInfos = append(taskInfos, &task.TaskInfo)
 	}
 
+	if len(taskInfos) > 0 {
+		operations = append(operations, &mesosproto.Offer_Operation{
+			Type: mesosproto.Offer_Operation_LAUNCH.Enum(),
+			Launch: &mesosproto.Offer_Operation_Launch{
+				TaskInfos: taskInfos,
+			},
+		})
+	}
+
+	allocate(offers[0].GetRole(), operations)
+
 	call := &mesosproto.Call{
 		FrameworkId: s.FrameworkId(),
 		Type:        mesosproto.Call_ACCEPT.Enum(),
 		Accept: &mesosproto.Call_Accept{
-			OfferIds: offerIds,
-			Operations: []*mesosproto.Offer_Operation{
-				&mesosproto.Offer_Operation{
-					Type: mesosproto.Offer_Operation_LAUNCH.Enum(),
-					Launch: &mesosproto.Offer_Operation_Launch{
-						TaskInfos: taskInfos,
-					},
-				},
-			},
-			Filters: &mesosproto.Filters{RefuseSeconds: proto.Float64(1)},
+			OfferIds:   offerIds,
+			Operations: operations,
+			Filters:    &mesosproto.Filters{RefuseSeconds: proto.Float64(1)},
 		},
 	}
 
@@ -867,6 +1092,22 @@ func (s *Scheduler) launch(offers []*Offer, tasks []*Task) (map[string]error, er
 			for {
 				select {
 				case status := <-task.GetStatus():
+					if task.isPod() {
+						done, err := task.podDone(status)
+						if !done {
+							continue
+						}
+
+						l.Lock()
+						rets[task.ID()] = err
+						l.Unlock()
+
+						if a := s.getAgent(task.AgentId.GetValue()); a != nil {
+							a.removeTask(task.ID())
+						}
+						return
+					}
+
 					if task.IsDone(status) {
 						l.Lock()
 						rets[task.ID()] = task.DetectError(status)
@@ -887,25 +1128,75 @@ func (s *Scheduler) launch(offers []*Offer, tasks []*Task) (map[string]error, er
 	return rets, nil
 }
 
+// LaunchTasks puts the tasks into the launch queue and waits until they are
+// placed and done, or the launch is cancelled.
 func (s *Scheduler) LaunchTasks(tasks []*Task) (map[string]error, error) {
-	s.lock()
-	defer s.unlock()
+	if len(tasks) == 0 {
+		return map[string]error{}, nil
+	}
+
+	p := newPendingLaunch(tasks)
+
+	s.queue.push(p)
 
+	res := <-p.done
+
+	return res.rets, res.err
+}
+
+// launchTasks places the tasks of the pending launch, those cancelled meanwhile
+// are dropped before launched.
+func (s *Scheduler) launchTasks(p *pendingLaunch, tasks []*Task) (map[string]error, error) {
 	var (
-		agent  *Agent
-		offers []*Offer
+		agent    *Agent
+		offers   []*Offer
+		config   = tasks[0].cfg
+		strategy = s.strategyFor(config)
 	)
 
+	// tasks of one launch are placed on the same agent, so place them
+	// one by one to keep the spread, or on their own reservations.
+	if (config.TopologySpread != nil || config.IsStateful()) && len(tasks) > 1 {
+		rets := make(map[string]error)
+		for _, task := range tasks {
+			ret, err := s.launchTasks(p, []*Task{task})
+			if err != nil {
+				rets[task.ID()] = err
+				continue
+			}
+
+			for id, err := range ret {
+				rets[id] = err
+			}
+		}
+
+		return rets, nil
+	}
+
+	if config.IsStateful() {
+		if err := s.loadReservation(tasks[0]); err != nil {
+			return nil, err
+		}
+	}
+
 	for {
-		filtered, err := s.applyFilters(tasks[0].cfg)
+		ctx := s.newPlacementContext(appIdOf(tasks[0].GetName()), config)
+
+		filtered, err := s.applyFilters(ctx, p.stop)
 		if err != nil {
 			return nil, err
 		}
 
-		candidates := s.strategy.RankAndSort(filtered)
+		candidates := strategy.RankAndSort(filtered, ctx)
 
 		for _, a := range candidates {
-			offers = a.getOffers()
+			offers = a.allocatedOffers()
+
+			// the reserved resources are offered to the role of the reservation.
+			if r := config.Reservation; r != nil && r.AgentID != "" {
+				offers = a.offersOf(r.Role)
+			}
+
 			if len(offers) > 0 {
 				agent = a
 				break
@@ -916,7 +1207,22 @@ func (s *Scheduler) LaunchTasks(tasks []*Task) (map[string]error, error) {
 			break
 		}
 
-		time.Sleep(100 * time.Millisecond)
+		select {
+		case <-p.stop:
+			return nil, errLaunchCancelled
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+
+	// cancelled while placing, eg: the app deleted or scaled down.
+	if tasks = p.live(tasks); len(tasks) == 0 {
+		return nil, errLaunchCancelled
+	}
+
+	if config.IsStateful() {
+		if err := s.reserve(tasks[0], agent, offers); err != nil {
+			return nil, err
+		}
 	}
 
 	for _, task := range tasks {
@@ -930,20 +1236,19 @@ func (s *Scheduler) LaunchTasks(tasks []*Task) (map[string]error, error) {
 	rets, err := s.launch(offers, tasks)
 	if err != nil {
 		log.Errorf("[launch] %v", err)
+
+		// nothing reserved, the task is free to be placed anywhere next time.
+		if tasks[0].reserve {
+			if err := s.db.DeleteReservation(tasks[0].GetName()); err != nil {
+				log.Errorf("delete reservation %s got error: %v", tasks[0].GetName(), err)
+			}
+		}
 		return nil, err
 	}
 
 	return rets, nil
 }
 
-func (s *Scheduler) lock() {
-	s.sem <- struct{}{}
-}
-
-func (s *Scheduler) unlock() {
-	<-s.sem
-}
-
 func (s *Scheduler) Load() map[string]interface{} {
 	s.RLock()
 	defer s.RUnlock()
@@ -960,5 +1265,6 @@ func (s *Scheduler) Load() map[string]interface{} {
 		"events": len(s.events),
 		"offers": len(s.offers),
 		"failed": len(s.failedTasks),
+		"queued": s.queue.size(),
 	}
 }
@@ -1,7 +1,89 @@
 package mesos
 
-import ()
+import (
+	log "github.com/Sirupsen/logrus"
+
+	"github.com/Dataman-Cloud/swan/types"
+)
 
 type Strategy interface {
-	RankAndSort(agents []*Agent) []*Agent
+	RankAndSort(agents []*Agent, ctx *PlacementContext) []*Agent
+}
+
+// StrategyBuilder builds the strategy specified by the app version.
+type StrategyBuilder func(spec *types.Strategy) (Strategy, error)
+
+// PlacementContext is the task being placed and the cluster status strategies
+// may need. The running tasks are loaded lazily, at most once per refresh.
+type PlacementContext struct {
+	AppID  string
+	Config *types.TaskConfig
+	Agents []*Agent // all of the known agents, not only the candidates
+
+	load   func() (map[string][]*PlacedTask, error)
+	placed map[string][]*PlacedTask
+}
+
+func (s *Scheduler) newPlacementContext(appId string, config *types.TaskConfig) *PlacementContext {
+	return &PlacementContext{
+		AppID:  appId,
+		Config: config,
+		Agents: s.getAgents(),
+		load:   s.tasksByAgent,
+	}
+}
+
+// Placed returns the running tasks grouped by agent id.
+func (c *PlacementContext) Placed() map[string][]*PlacedTask {
+	if c.placed != nil {
+		return c.placed
+	}
+
+	c.placed = make(map[string][]*PlacedTask)
+
+	if c.load != nil {
+		placed, err := c.load()
+		if err != nil {
+			log.Errorf("load running tasks for placement got error: %v", err)
+			return c.placed
+		}
+		c.placed = placed
+	}
+
+	return c.placed
+}
+
+// refresh drops the running tasks loaded, they are loaded again on the next Placed.
+func (c *PlacementContext) refresh() {
+	c.placed = nil
+}
+
+// strategyFor returns the strategy specified by the config, or the global one.
+func (s *Scheduler) strategyFor(config *types.TaskConfig) Strategy {
+	if config.Strategy == nil || s.strategyBuilder == nil {
+		return s.strategy
+	}
+
+	strategy, err := s.strategyBuilder(config.Strategy)
+	if err != nil {
+		log.Errorf("build strategy %s got error: %v, fallback to the global one", config.Strategy.Name, err)
+		return s.strategy
+	}
+
+	return strategy
+}
+
+// Satisfies reports whether placing on the agent satisfies the affinity rule, that
+// is the agent hosts (or doesn't host, if anti) tasks of the selected apps.
+func (c *PlacementContext) Satisfies(agentId string, rule *types.AppAffinity) bool {
+	hosted := false
+
+	for _, p := range c.Placed()[agentId] {
+		if rule.Matches(p.Version.Labels) {
+			hosted = true
+			break
+		}
+	}
+
+	return hosted != rule.Anti
 }
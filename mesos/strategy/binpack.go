@@ -12,7 +12,7 @@ func NewBinPackStrategy() *binpackStrategy {
 	return &binpackStrategy{}
 }
 
-func (b *binpackStrategy) RankAndSort(agents []*mesos.Agent) []*mesos.Agent {
+func (b *binpackStrategy) RankAndSort(agents []*mesos.Agent, ctx *mesos.PlacementContext) []*mesos.Agent {
 	weightedList := weight(agents)
 
 	sort.Sort(weightedList)
@@ -17,7 +17,7 @@ func NewRandomStrategy() *randomStrategy {
 	}
 }
 
-func (m *randomStrategy) RankAndSort(agents []*mesos.Agent) []*mesos.Agent {
+func (m *randomStrategy) RankAndSort(agents []*mesos.Agent, ctx *mesos.PlacementContext) []*mesos.Agent {
 	for i := 0; i < len(agents); i++ {
 		j := m.r.Intn(i + 1)
 		agents[i], agents[j] = agents[j], agents[i]
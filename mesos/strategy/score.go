@@ -0,0 +1,103 @@
+package strategy
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/Dataman-Cloud/swan/mesos"
+	"github.com/Dataman-Cloud/swan/types"
+)
+
+// DefaultScorers is used by the score strategy if no scorers specified.
+var DefaultScorers = []*types.Scorer{
+	{Name: types.ScorerLeastAllocated, Weight: 1},
+	{Name: types.ScorerAppTaskCount, Weight: 1},
+	{Name: types.ScorerImageLocality, Weight: 0.5},
+	{Name: types.ScorerAppAffinity, Weight: 1},
+}
+
+type weightedScorer struct {
+	scorer
+	weight float64
+}
+
+// scoreStrategy ranks the agents by the weighted average of all its scorers.
+type scoreStrategy struct {
+	scorers []*weightedScorer
+}
+
+func NewScoreStrategy(specs []*types.Scorer) (*scoreStrategy, error) {
+	if len(specs) == 0 {
+		specs = DefaultScorers
+	}
+
+	s := &scoreStrategy{
+		scorers: make([]*weightedScorer, 0, len(specs)),
+	}
+
+	for _, spec := range specs {
+		sc := newScorer(spec)
+		if sc == nil {
+			return nil, fmt.Errorf("scorer %s not supported", spec.Name)
+		}
+
+		s.scorers = append(s.scorers, &weightedScorer{sc, spec.Weight})
+	}
+
+	return s, nil
+}
+
+func (s *scoreStrategy) RankAndSort(agents []*mesos.Agent, ctx *mesos.PlacementContext) []*mesos.Agent {
+	var (
+		scores = make([]float64, len(agents))
+		total  float64
+	)
+
+	for _, sc := range s.scorers {
+		if sc.weight <= 0 {
+			continue
+		}
+
+		for i, v := range sc.score(agents, ctx) {
+			scores[i] += v * sc.weight
+		}
+
+		total += sc.weight
+	}
+
+	weightedList := make(weightedAgents, 0, len(agents))
+	for i, agent := range agents {
+		w := &weightedAgent{agent: agent}
+		if total > 0 {
+			w.weight = scores[i] / total
+		}
+
+		weightedList = append(weightedList, w)
+	}
+
+	sort.Stable(sort.Reverse(weightedList))
+
+	candidates := make([]*mesos.Agent, 0)
+
+	for _, weighted := range weightedList {
+		candidates = append(candidates, weighted.agent)
+	}
+
+	return candidates
+}
+
+// New builds the strategy by spec.
+func New(spec *types.Strategy) (mesos.Strategy, error) {
+	switch spec.Name {
+	case types.StrategyRandom:
+		return NewRandomStrategy(), nil
+	case types.StrategyBinpack, "binpacking":
+		return NewBinPackStrategy(), nil
+	case types.StrategySpread:
+		return NewSpreadStrategy(), nil
+	case types.StrategyScore:
+		return NewScoreStrategy(spec.Scorers)
+	}
+
+	return nil, fmt.Errorf("strategy %s not supported", spec.Name)
+}
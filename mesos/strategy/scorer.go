@@ -0,0 +1,193 @@
+package strategy
+
+import (
+	"github.com/Dataman-Cloud/swan/mesos"
+	"github.com/Dataman-Cloud/swan/types"
+)
+
+// scorer rates each of the agents in range [0, 1], higher is better.
+type scorer interface {
+	score(agents []*mesos.Agent, ctx *mesos.PlacementContext) []float64
+}
+
+func newScorer(spec *types.Scorer) scorer {
+	switch spec.Name {
+	case types.ScorerLeastAllocated:
+		return &leastAllocatedScorer{}
+	case types.ScorerMostAllocated:
+		return &mostAllocatedScorer{}
+	case types.ScorerSpreadByAttribute:
+		return &spreadByAttributeScorer{attribute: spec.Attribute}
+	case types.ScorerAppTaskCount:
+		return &appTaskCountScorer{}
+	case types.ScorerImageLocality:
+		return &imageLocalityScorer{}
+	case types.ScorerAppAffinity:
+		return &appAffinityScorer{}
+	}
+
+	return nil
+}
+
+// leastAllocatedScorer prefers the agents with more free resources.
+type leastAllocatedScorer struct{}
+
+func (s *leastAllocatedScorer) score(agents []*mesos.Agent, ctx *mesos.PlacementContext) []float64 {
+	return freeRatios(agents)
+}
+
+// mostAllocatedScorer prefers the agents with less free resources.
+type mostAllocatedScorer struct{}
+
+func (s *mostAllocatedScorer) score(agents []*mesos.Agent, ctx *mesos.PlacementContext) []float64 {
+	ret := freeRatios(agents)
+	for i, v := range ret {
+		ret[i] = 1 - v
+	}
+
+	return ret
+}
+
+// spreadByAttributeScorer prefers the agents whose attribute value holds fewer
+// tasks of the app. Agents without the attribute get 0.
+type spreadByAttributeScorer struct {
+	attribute string
+}
+
+func (s *spreadByAttributeScorer) score(agents []*mesos.Agent, ctx *mesos.PlacementContext) []float64 {
+	var (
+		counts = make(map[string]int)
+		max    int
+	)
+
+	// the agents holding app tasks may not be candidates now.
+	attrs := make(map[string]string)
+	for _, agent := range ctx.Agents {
+		attrs[agent.ID()] = agent.Attributes()[s.attribute]
+	}
+
+	for agentId, placed := range ctx.Placed() {
+		for _, p := range placed {
+			if p.AppID != ctx.AppID {
+				continue
+			}
+
+			if v := attrs[agentId]; v != "" {
+				counts[v]++
+				if counts[v] > max {
+					max = counts[v]
+				}
+			}
+		}
+	}
+
+	ret := make([]float64, len(agents))
+	for i, agent := range agents {
+		v := agent.Attributes()[s.attribute]
+		if v == "" {
+			continue
+		}
+
+		ret[i] = 1
+		if max > 0 {
+			ret[i] = 1 - float64(counts[v])/float64(max+1)
+		}
+	}
+
+	return ret
+}
+
+// appTaskCountScorer prefers the agents running fewer tasks of the app.
+type appTaskCountScorer struct{}
+
+func (s *appTaskCountScorer) score(agents []*mesos.Agent, ctx *mesos.PlacementContext) []float64 {
+	var (
+		counts = make([]int, len(agents))
+		max    int
+		placed = ctx.Placed()
+	)
+
+	for i, agent := range agents {
+		for _, p := range placed[agent.ID()] {
+			if p.AppID == ctx.AppID {
+				counts[i]++
+			}
+		}
+
+		if counts[i] > max {
+			max = counts[i]
+		}
+	}
+
+	ret := make([]float64, len(agents))
+	for i := range agents {
+		ret[i] = 1
+		if max > 0 {
+			ret[i] = 1 - float64(counts[i])/float64(max+1)
+		}
+	}
+
+	return ret
+}
+
+// imageLocalityScorer prefers the agents already running tasks of the same image,
+// the image is likely pulled there.
+type imageLocalityScorer struct{}
+
+func (s *imageLocalityScorer) score(agents []*mesos.Agent, ctx *mesos.PlacementContext) []float64 {
+	var (
+		ret    = make([]float64, len(agents))
+		placed = ctx.Placed()
+	)
+
+	for i, agent := range agents {
+		for _, p := range placed[agent.ID()] {
+			if c := p.Version.Container; c != nil && c.Docker != nil && c.Docker.Image == ctx.Config.Image {
+				ret[i] = 1
+				break
+			}
+		}
+	}
+
+	return ret
+}
+
+// appAffinityScorer rates the agents by the weighted preferred affinity rules
+// those satisfied. All of the agents get 1 if the app has no preferred rules.
+type appAffinityScorer struct{}
+
+func (s *appAffinityScorer) score(agents []*mesos.Agent, ctx *mesos.PlacementContext) []float64 {
+	var (
+		ret   = make([]float64, len(agents))
+		total float64
+	)
+
+	for _, rule := range ctx.Config.Affinity {
+		if rule.Required {
+			continue
+		}
+
+		weight := rule.Weight
+		if weight == 0 {
+			weight = 1
+		}
+
+		for i, agent := range agents {
+			if ctx.Satisfies(agent.ID(), rule) {
+				ret[i] += weight
+			}
+		}
+
+		total += weight
+	}
+
+	for i := range ret {
+		if total == 0 {
+			ret[i] = 1
+			continue
+		}
+		ret[i] /= total
+	}
+
+	return ret
+}
@@ -12,7 +12,7 @@ func NewSpreadStrategy() *spreadStrategy {
 	return &spreadStrategy{}
 }
 
-func (s *spreadStrategy) RankAndSort(agents []*mesos.Agent) []*mesos.Agent {
+func (s *spreadStrategy) RankAndSort(agents []*mesos.Agent, ctx *mesos.PlacementContext) []*mesos.Agent {
 	weightedList := weight(agents)
 
 	sort.Sort(sort.Reverse(weightedList))
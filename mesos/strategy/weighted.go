@@ -23,14 +23,15 @@ func (w weightedAgents) Less(i, j int) bool {
 	return w[i].weight < w[j].weight
 }
 
+// weight rates the agents by their free resources, each kind of resource is
+// normalized to [0, 1] against the most free one so mem in MB doesn't dominate.
 func weight(agents []*mesos.Agent) weightedAgents {
 	weightedList := make([]*weightedAgent, 0)
 
-	for _, agent := range agents {
-		cpus, mem, disk, ports := agent.Resources()
+	for i, free := range freeRatios(agents) {
 		weighted := &weightedAgent{
-			agent:  agent,
-			weight: cpus + mem + disk + float64(len(ports)),
+			agent:  agents[i],
+			weight: free,
 		}
 
 		weightedList = append(weightedList, weighted)
@@ -38,3 +39,42 @@ func weight(agents []*mesos.Agent) weightedAgents {
 
 	return weightedList
 }
+
+// freeRatios returns the free resources of each agent in range [0, 1], the
+// average of cpus, mem, disk and ports normalized against the max among agents.
+func freeRatios(agents []*mesos.Agent) []float64 {
+	var (
+		res = make([][4]float64, len(agents))
+		max [4]float64
+	)
+
+	for i, agent := range agents {
+		cpus, mem, disk, ports := agent.Resources()
+		res[i] = [4]float64{cpus, mem, disk, float64(len(ports))}
+
+		for j, v := range res[i] {
+			if v > max[j] {
+				max[j] = v
+			}
+		}
+	}
+
+	ret := make([]float64, len(agents))
+
+	for i := range agents {
+		var sum, n float64
+		for j, v := range res[i] {
+			if max[j] <= 0 {
+				continue
+			}
+			sum += v / max[j]
+			n++
+		}
+
+		if n > 0 {
+			ret[i] = sum / n
+		}
+	}
+
+	return ret
+}
@@ -15,6 +15,15 @@ type Task struct {
 	updates chan *mesosproto.TaskStatus
 
 	cfg *types.TaskConfig
+
+	oneOff bool // task of a job, without db task record and retried by the job instead of rescheduled
+
+	// of the pod task, launched as a task group by the default executor.
+	executor *mesosproto.ExecutorInfo
+	group    *mesosproto.TaskGroupInfo
+	states   map[string]*mesosproto.TaskStatus // latest status of each container
+
+	reserve bool // reserve the resources and create the volumes before launching
 }
 
 func NewTask(cfg *types.TaskConfig, id, name string) *Task {
@@ -34,7 +43,17 @@ func (t *Task) ID() string {
 }
 
 func (t *Task) Build() {
+	if t.isPod() {
+		t.executor = t.cfg.BuildExecutor(t.ID())
+		t.group = t.cfg.BuildTaskGroup(t.ID(), t.GetName(), t.AgentId)
+		t.states = make(map[string]*mesosproto.TaskStatus)
+		return
+	}
+
 	t.Resources = t.cfg.BuildResources()
+	if r := t.cfg.Reservation; r != nil {
+		t.Resources = taskResources(r, t.Resources)
+	}
 	t.Command = t.cfg.BuildCommand()
 	t.Container = t.cfg.BuildContainer()
 	if t.cfg.HealthCheck != nil {
@@ -79,6 +98,7 @@ func (t *Task) IsKilled(status *mesosproto.TaskStatus) bool {
 	switch state {
 	case mesosproto.TaskState_TASK_FINISHED,
 		mesosproto.TaskState_TASK_FAILED,
+		mesosproto.TaskState_TASK_KILLED,
 		mesosproto.TaskState_TASK_UNKNOWN,
 		mesosproto.TaskState_TASK_UNREACHABLE:
 
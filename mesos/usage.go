@@ -0,0 +1,227 @@
+package mesos
+
+import (
+	"sync"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+
+	"github.com/Dataman-Cloud/swan/mesosproto"
+	"github.com/Dataman-Cloud/swan/types"
+)
+
+const (
+	usageBucket        = time.Duration(time.Hour)       // rollup granularity
+	usageFlushInterval = time.Duration(5 * time.Minute) // how often running tasks are rolled up
+)
+
+// meteredTask is a running task whose resource-time hasn't been rolled up yet.
+type meteredTask struct {
+	appId string
+	runAs string
+	cpus  float64
+	mem   float64
+	disk  float64
+	since time.Time
+}
+
+// usageMeter tracks the running tasks seen in status updates.
+type usageMeter struct {
+	sync.Mutex
+	tasks map[string]*meteredTask
+}
+
+func newUsageMeter() *usageMeter {
+	return &usageMeter{
+		tasks: make(map[string]*meteredTask),
+	}
+}
+
+func (m *usageMeter) start(taskId string, t *meteredTask) {
+	m.Lock()
+	defer m.Unlock()
+
+	if _, ok := m.tasks[taskId]; !ok {
+		m.tasks[taskId] = t
+	}
+}
+
+// stop removes the task and returns its records since last rollup.
+func (m *usageMeter) stop(taskId string, now time.Time) []*types.UsageRecord {
+	m.Lock()
+	defer m.Unlock()
+
+	t, ok := m.tasks[taskId]
+	if !ok {
+		return nil
+	}
+
+	delete(m.tasks, taskId)
+
+	return t.rollup(now)
+}
+
+// reset removes all of the tasks and returns their records since last rollup.
+func (m *usageMeter) reset(now time.Time) []*types.UsageRecord {
+	records := m.rollup(now)
+
+	m.Lock()
+	m.tasks = make(map[string]*meteredTask)
+	m.Unlock()
+
+	return records
+}
+
+// rollup returns the records of all of the running tasks since last rollup,
+// merged by bucket and app.
+func (m *usageMeter) rollup(now time.Time) []*types.UsageRecord {
+	m.Lock()
+	defer m.Unlock()
+
+	merged := make(map[string]*types.UsageRecord)
+	for _, t := range m.tasks {
+		for _, r := range t.rollup(now) {
+			if prev, ok := merged[r.Key()]; ok {
+				prev.Add(r)
+				continue
+			}
+			merged[r.Key()] = r
+		}
+	}
+
+	ret := make([]*types.UsageRecord, 0, len(merged))
+	for _, r := range merged {
+		ret = append(ret, r)
+	}
+
+	return ret
+}
+
+// rollup splits the resource-time since last rollup into buckets and moves
+// the task's start point to now.
+func (t *meteredTask) rollup(now time.Time) []*types.UsageRecord {
+	ret := make([]*types.UsageRecord, 0)
+
+	for from := t.since; from.Before(now); {
+		var (
+			bucket = from.Truncate(usageBucket)
+			to     = bucket.Add(usageBucket)
+		)
+
+		if to.After(now) {
+			to = now
+		}
+
+		secs := to.Sub(from).Seconds()
+
+		ret = append(ret, &types.UsageRecord{
+			Bucket:          bucket,
+			AppID:           t.appId,
+			RunAs:           t.runAs,
+			CPUSeconds:      t.cpus * secs,
+			MemMBSeconds:    t.mem * secs,
+			DiskMBSeconds:   t.disk * secs,
+			InstanceSeconds: secs,
+		})
+
+		from = to
+	}
+
+	t.since = now
+
+	return ret
+}
+
+func isTerminalState(state mesosproto.TaskState) bool {
+	switch state {
+	case mesosproto.TaskState_TASK_FINISHED,
+		mesosproto.TaskState_TASK_FAILED,
+		mesosproto.TaskState_TASK_KILLED,
+		mesosproto.TaskState_TASK_ERROR,
+		mesosproto.TaskState_TASK_LOST,
+		mesosproto.TaskState_TASK_DROPPED,
+		mesosproto.TaskState_TASK_GONE,
+		mesosproto.TaskState_TASK_GONE_BY_OPERATOR:
+		return true
+	}
+
+	return false
+}
+
+// meterTask starts metering the task when it becomes running.
+func (s *Scheduler) meterTask(appId, taskId string, ver *types.Version) {
+	s.meter.start(taskId, &meteredTask{
+		appId: appId,
+		runAs: ver.RunAs,
+		cpus:  ver.CPUs,
+		mem:   ver.Mem,
+		disk:  ver.Disk,
+		since: time.Now(),
+	})
+}
+
+// unmeterTask stops metering the task and saves its last records.
+func (s *Scheduler) unmeterTask(taskId string) {
+	s.saveUsage(s.meter.stop(taskId, time.Now()))
+}
+
+// purgeUsage removes the usage records older than the retention.
+func (s *Scheduler) purgeUsage() {
+	days := s.cfg.UsageRetention
+	if days <= 0 {
+		return
+	}
+
+	before := time.Now().Add(-time.Duration(days) * 24 * time.Hour).Truncate(usageBucket)
+	if err := s.db.PurgeUsage(before); err != nil {
+		log.Errorf("purge usage before %s got error: %v", before.Format(time.RFC3339), err)
+	}
+}
+
+func (s *Scheduler) saveUsage(records []*types.UsageRecord) {
+	for _, r := range records {
+		if err := s.db.AddUsage(r); err != nil {
+			log.Errorf("save usage of app %s got error: %v", r.AppID, err)
+		}
+	}
+}
+
+// meterUsage picks up the running tasks from db and rolls up their usage periodically.
+// Tasks those have been running before are metered since now, the gap while there is
+// no leader manager is not accounted. Once the subscription stopped, the usage so far
+// is saved and the tasks are left to the next leader.
+func (s *Scheduler) meterUsage(stop chan struct{}) {
+	placed, err := s.tasksByAgent()
+	if err != nil {
+		log.Errorf("load running tasks for usage metering got error: %v", err)
+	}
+
+	for _, tasks := range placed {
+		for _, p := range tasks {
+			if p.Task.Status != "TASK_RUNNING" {
+				continue
+			}
+			s.meterTask(p.AppID, p.Task.ID, p.Version)
+		}
+	}
+
+	ticker := time.NewTicker(usageFlushInterval)
+	defer ticker.Stop()
+
+	purge := time.NewTicker(usageBucket)
+	defer purge.Stop()
+
+	s.purgeUsage()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.saveUsage(s.meter.rollup(time.Now()))
+		case <-purge.C:
+			s.purgeUsage()
+		case <-stop:
+			s.saveUsage(s.meter.reset(time.Now()))
+			return
+		}
+	}
+}
@@ -1,85 +1,86 @@
-// Code generated by protoc-gen-gogo.
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
 // source: mesos.proto
-// DO NOT EDIT!
 
 /*
-	Package mesosproto is a generated protocol buffer package.
-
-	It is generated from these files:
-		mesos.proto
-		scheduler.proto
-
-	It has these top-level messages:
-		FrameworkID
-		OfferID
-		AgentID
-		TaskID
-		ExecutorID
-		ContainerID
-		TimeInfo
-		DurationInfo
-		Address
-		URL
-		Unavailability
-		MachineID
-		MachineInfo
-		FrameworkInfo
-		HealthCheck
-		KillPolicy
-		CommandInfo
-		ExecutorInfo
-		MasterInfo
-		AgentInfo
-		Value
-		Attribute
-		Resource
-		TrafficControlStatistics
-		IpStatistics
-		IcmpStatistics
-		TcpStatistics
-		UdpStatistics
-		SNMPStatistics
-		ResourceStatistics
-		ResourceUsage
-		PerfStatistics
-		Request
-		Offer
-		InverseOffer
-		TaskInfo
-		TaskGroupInfo
-		Task
-		TaskStatus
-		Filters
-		Environment
-		Parameter
-		Parameters
-		Credential
-		Credentials
-		RateLimit
-		RateLimits
-		Image
-		Volume
-		NetworkInfo
-		CapabilityInfo
-		LinuxInfo
-		RLimitInfo
-		TTYInfo
-		ContainerInfo
-		ContainerStatus
-		CgroupInfo
-		Labels
-		Label
-		Port
-		Ports
-		DiscoveryInfo
-		WeightInfo
-		VersionInfo
-		Flag
-		Role
-		Metric
-		FileInfo
-		Event
-		Call
+Package mesosproto is a generated protocol buffer package.
+
+It is generated from these files:
+
+	mesos.proto
+	scheduler.proto
+
+It has these top-level messages:
+
+	FrameworkID
+	OfferID
+	AgentID
+	TaskID
+	ExecutorID
+	ContainerID
+	TimeInfo
+	DurationInfo
+	Address
+	URL
+	Unavailability
+	MachineID
+	MachineInfo
+	FrameworkInfo
+	HealthCheck
+	KillPolicy
+	CommandInfo
+	ExecutorInfo
+	MasterInfo
+	AgentInfo
+	Value
+	Attribute
+	Resource
+	TrafficControlStatistics
+	IpStatistics
+	IcmpStatistics
+	TcpStatistics
+	UdpStatistics
+	SNMPStatistics
+	ResourceStatistics
+	ResourceUsage
+	PerfStatistics
+	Request
+	Offer
+	InverseOffer
+	TaskInfo
+	TaskGroupInfo
+	Task
+	TaskStatus
+	Filters
+	Environment
+	Parameter
+	Parameters
+	Credential
+	Credentials
+	RateLimit
+	RateLimits
+	Image
+	Volume
+	NetworkInfo
+	CapabilityInfo
+	LinuxInfo
+	RLimitInfo
+	TTYInfo
+	ContainerInfo
+	ContainerStatus
+	CgroupInfo
+	Labels
+	Label
+	Port
+	Ports
+	DiscoveryInfo
+	WeightInfo
+	VersionInfo
+	Flag
+	Role
+	Metric
+	FileInfo
+	Event
+	Call
 */
 package mesosproto
 
@@ -87,7 +88,7 @@ import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
 
-import github_com_golang_protobuf_proto "github.com/golang/protobuf/proto"
+import binary "encoding/binary"
 
 import io "io"
 
@@ -346,6 +347,11 @@ const (
 	// Mesos when the agent reregisters (unless the master has
 	// failed over).
 	FrameworkInfo_Capability_PARTITION_AWARE FrameworkInfo_Capability_Type = 5
+	// This expresses the ability for the framework to be
+	// "multi-tenant" via using the newly introduced `roles`
+	// field, and examining `Offer.allocation_info` to determine
+	// which role the offers are being made to.
+	FrameworkInfo_Capability_MULTI_ROLE FrameworkInfo_Capability_Type = 6
 )
 
 var FrameworkInfo_Capability_Type_name = map[int32]string{
@@ -355,6 +361,7 @@ var FrameworkInfo_Capability_Type_name = map[int32]string{
 	3: "GPU_RESOURCES",
 	4: "SHARED_RESOURCES",
 	5: "PARTITION_AWARE",
+	6: "MULTI_ROLE",
 }
 var FrameworkInfo_Capability_Type_value = map[string]int32{
 	"UNKNOWN":             0,
@@ -363,6 +370,7 @@ var FrameworkInfo_Capability_Type_value = map[string]int32{
 	"GPU_RESOURCES":       3,
 	"SHARED_RESOURCES":    4,
 	"PARTITION_AWARE":     5,
+	"MULTI_ROLE":          6,
 }
 
 func (x FrameworkInfo_Capability_Type) Enum() *FrameworkInfo_Capability_Type {
@@ -938,7 +946,9 @@ func (x *NetworkInfo_Protocol) UnmarshalJSON(data []byte) error {
 	*x = NetworkInfo_Protocol(value)
 	return nil
 }
-func (NetworkInfo_Protocol) EnumDescriptor() ([]byte, []int) { return fileDescriptorMesos, []int{49, 0} }
+func (NetworkInfo_Protocol) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptorMesos, []int{49, 0}
+}
 
 // We start the actual values at an offset(1000) because Protobuf 2
 // uses the first value as the default one. Separating the default
@@ -1692,6 +1702,15 @@ type FrameworkInfo struct {
 	// Used to group frameworks for allocation decisions, depending on
 	// the allocation policy being used.
 	Role *string `protobuf:"bytes,6,opt,name=role,def=*" json:"role,omitempty"`
+	// Roles are the entities to which allocations are made.
+	// The framework must have at least one role in order to
+	// be offered resources. Note that `role` is deprecated
+	// in favor of `roles` and only one of these fields must
+	// be used. Since we cannot distinguish between empty
+	// `roles` and the default unset `role`, we require that
+	// frameworks set the `MULTI_ROLE` capability if
+	// setting the `roles` field.
+	Roles []string `protobuf:"bytes,12,rep,name=roles" json:"roles,omitempty"`
 	// Used to indicate the current host from which the scheduler is
 	// registered in the Mesos Web UI. If set to an empty string Mesos
 	// will automagically set it to the current hostname if one is
@@ -1769,6 +1788,13 @@ func (m *FrameworkInfo) GetRole() string {
 	return Default_FrameworkInfo_Role
 }
 
+func (m *FrameworkInfo) GetRoles() []string {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
 func (m *FrameworkInfo) GetHostname() string {
 	if m != nil && m.Hostname != nil {
 		return *m.Hostname
@@ -2008,8 +2034,8 @@ func (m *HealthCheck_TCPCheckInfo) GetPort() uint32 {
 // different policies (e.g. hitting HTTP endpoints), only controls
 // how long to wait between graceful and forcible task kill:
 //
-//     graceful kill --------------> forcible kill
-//                    grace_period
+//	graceful kill --------------> forcible kill
+//	               grace_period
 //
 // Kill policies are best-effort, because machine failures / forcible
 // terminations may occur.
@@ -2737,8 +2763,11 @@ type Resource struct {
 	// can be launched using this resource and all of them shall refer
 	// to the same physical resource on the cluster. Note that only
 	// persistent volumes can be shared currently.
-	Shared           *Resource_SharedInfo `protobuf:"bytes,10,opt,name=shared" json:"shared,omitempty"`
-	XXX_unrecognized []byte               `json:"-"`
+	Shared *Resource_SharedInfo `protobuf:"bytes,10,opt,name=shared" json:"shared,omitempty"`
+	// The role this resource is allocated to, set in the offers made
+	// to the MULTI_ROLE frameworks.
+	AllocationInfo   *Resource_AllocationInfo `protobuf:"bytes,11,opt,name=allocation_info,json=allocationInfo" json:"allocation_info,omitempty"`
+	XXX_unrecognized []byte                   `json:"-"`
 }
 
 func (m *Resource) Reset()                    { *m = Resource{} }
@@ -2818,6 +2847,13 @@ func (m *Resource) GetShared() *Resource_SharedInfo {
 	return nil
 }
 
+func (m *Resource) GetAllocationInfo() *Resource_AllocationInfo {
+	if m != nil {
+		return m.AllocationInfo
+	}
+	return nil
+}
+
 type Resource_ReservationInfo struct {
 	// Indicates the principal, if any, of the framework or operator
 	// that reserved this resource. If reserved by a framework, the
@@ -3052,6 +3088,26 @@ func (m *Resource_SharedInfo) String() string            { return proto.CompactT
 func (*Resource_SharedInfo) ProtoMessage()               {}
 func (*Resource_SharedInfo) Descriptor() ([]byte, []int) { return fileDescriptorMesos, []int{22, 3} }
 
+// Describes the role the resources or offers are allocated to.
+type Resource_AllocationInfo struct {
+	Role             *string `protobuf:"bytes,1,opt,name=role" json:"role,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Resource_AllocationInfo) Reset()         { *m = Resource_AllocationInfo{} }
+func (m *Resource_AllocationInfo) String() string { return proto.CompactTextString(m) }
+func (*Resource_AllocationInfo) ProtoMessage()    {}
+func (*Resource_AllocationInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptorMesos, []int{22, 4}
+}
+
+func (m *Resource_AllocationInfo) GetRole() string {
+	if m != nil && m.Role != nil {
+		return *m.Role
+	}
+	return ""
+}
+
 // *
 // When the network bandwidth caps are enabled and the container
 // is over its limit, outbound packets may be either delayed or
@@ -3072,7 +3128,8 @@ func (*Resource_SharedInfo) Descriptor() ([]byte, []int) { return fileDescriptor
 // rate_bps   : throughput in bytes/sec
 // rate_pps   : throughput in packets/sec
 // requeues   : number of times a packet has been delayed due to
-//              locking or device contention issues
+//
+//	locking or device contention issues
 //
 // More information on the operation of Linux Traffic Control can be
 // found at http://www.lartc.org/lartc.html.
@@ -4307,11 +4364,11 @@ func (m *ResourceUsage_Executor_Task) GetLabels() *Labels {
 //
 // NOTE: Each optional field matches the name of a perf event (see
 // "perf list") with the following changes:
-// 1. Names are downcased.
-// 2. Hyphens ('-') are replaced with underscores ('_').
-// 3. Events with alternate names use the name "perf stat" returns,
-//    e.g., for the event "cycles OR cpu-cycles" perf always returns
-//    cycles.
+//  1. Names are downcased.
+//  2. Hyphens ('-') are replaced with underscores ('_').
+//  3. Events with alternate names use the name "perf stat" returns,
+//     e.g., for the event "cycles OR cpu-cycles" perf always returns
+//     cycles.
 type PerfStatistics struct {
 	Timestamp *float64 `protobuf:"fixed64,1,req,name=timestamp" json:"timestamp,omitempty"`
 	Duration  *float64 `protobuf:"fixed64,2,req,name=duration" json:"duration,omitempty"`
@@ -4801,8 +4858,10 @@ type Offer struct {
 	// interval, nor last for exactly the duration of this interval.
 	// The unavailability may also be forever!  See comments in
 	// `Unavailability` for more details.
-	Unavailability   *Unavailability `protobuf:"bytes,9,opt,name=unavailability" json:"unavailability,omitempty"`
-	XXX_unrecognized []byte          `json:"-"`
+	Unavailability *Unavailability `protobuf:"bytes,9,opt,name=unavailability" json:"unavailability,omitempty"`
+	// The role the offer is allocated to, for the MULTI_ROLE frameworks.
+	AllocationInfo   *Resource_AllocationInfo `protobuf:"bytes,10,opt,name=allocation_info,json=allocationInfo" json:"allocation_info,omitempty"`
+	XXX_unrecognized []byte                   `json:"-"`
 }
 
 func (m *Offer) Reset()                    { *m = Offer{} }
@@ -4873,6 +4932,13 @@ func (m *Offer) GetUnavailability() *Unavailability {
 	return nil
 }
 
+func (m *Offer) GetAllocationInfo() *Resource_AllocationInfo {
+	if m != nil {
+		return m.AllocationInfo
+	}
+	return nil
+}
+
 // Defines an operation that can be performed against offers.
 type Offer_Operation struct {
 	Type             *Offer_Operation_Type        `protobuf:"varint,1,opt,name=type,enum=mesos.Offer_Operation_Type" json:"type,omitempty"`
@@ -5295,9 +5361,9 @@ func (m *TaskInfo) GetDiscovery() *DiscoveryInfo {
 // allow the group to be launched "atomically".
 //
 // NOTES:
-// 1) `NetworkInfo` must not be set inside task's `ContainerInfo`.
-// 2) `TaskInfo.executor` doesn't need to set. If set, it should match
-//    `LaunchGroup.executor`.
+//  1. `NetworkInfo` must not be set inside task's `ContainerInfo`.
+//  2. `TaskInfo.executor` doesn't need to set. If set, it should match
+//     `LaunchGroup.executor`.
 type TaskGroupInfo struct {
 	Tasks            []*TaskInfo `protobuf:"bytes,1,rep,name=tasks" json:"tasks,omitempty"`
 	XXX_unrecognized []byte      `json:"-"`
@@ -5320,11 +5386,11 @@ func (m *TaskGroupInfo) GetTasks() []*TaskInfo {
 //
 // `Task` is used in some of the Mesos messages found below.
 // `Task` is used instead of `TaskInfo` if:
-//   1) we need additional IDs, such as a specific
-//      framework, executor, or agent; or
-//   2) we do not need the additional data, such as the command run by the
-//      task or the health checks.  These additional fields may be large and
-//      unnecessary for some Mesos messages.
+//  1. we need additional IDs, such as a specific
+//     framework, executor, or agent; or
+//  2. we do not need the additional data, such as the command run by the
+//     task or the health checks.  These additional fields may be large and
+//     unnecessary for some Mesos messages.
 //
 // `Task` is generally constructed from a `TaskInfo`.  See protobuf::createTask.
 type Task struct {
@@ -6297,10 +6363,12 @@ type NetworkInfo_PortMapping struct {
 	XXX_unrecognized []byte  `json:"-"`
 }
 
-func (m *NetworkInfo_PortMapping) Reset()                    { *m = NetworkInfo_PortMapping{} }
-func (m *NetworkInfo_PortMapping) String() string            { return proto.CompactTextString(m) }
-func (*NetworkInfo_PortMapping) ProtoMessage()               {}
-func (*NetworkInfo_PortMapping) Descriptor() ([]byte, []int) { return fileDescriptorMesos, []int{49, 1} }
+func (m *NetworkInfo_PortMapping) Reset()         { *m = NetworkInfo_PortMapping{} }
+func (m *NetworkInfo_PortMapping) String() string { return proto.CompactTextString(m) }
+func (*NetworkInfo_PortMapping) ProtoMessage()    {}
+func (*NetworkInfo_PortMapping) Descriptor() ([]byte, []int) {
+	return fileDescriptorMesos, []int{49, 1}
+}
 
 func (m *NetworkInfo_PortMapping) GetHostPort() uint32 {
 	if m != nil && m.HostPort != nil {
@@ -6679,10 +6747,12 @@ type ContainerInfo_MesosInfo struct {
 	XXX_unrecognized []byte `json:"-"`
 }
 
-func (m *ContainerInfo_MesosInfo) Reset()                    { *m = ContainerInfo_MesosInfo{} }
-func (m *ContainerInfo_MesosInfo) String() string            { return proto.CompactTextString(m) }
-func (*ContainerInfo_MesosInfo) ProtoMessage()               {}
-func (*ContainerInfo_MesosInfo) Descriptor() ([]byte, []int) { return fileDescriptorMesos, []int{54, 1} }
+func (m *ContainerInfo_MesosInfo) Reset()         { *m = ContainerInfo_MesosInfo{} }
+func (m *ContainerInfo_MesosInfo) String() string { return proto.CompactTextString(m) }
+func (*ContainerInfo_MesosInfo) ProtoMessage()    {}
+func (*ContainerInfo_MesosInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptorMesos, []int{54, 1}
+}
 
 func (m *ContainerInfo_MesosInfo) GetImage() *Image {
 	if m != nil {
@@ -7294,6 +7364,7 @@ func init() {
 	proto.RegisterType((*Resource_DiskInfo_Source_Mount)(nil), "mesos.Resource.DiskInfo.Source.Mount")
 	proto.RegisterType((*Resource_RevocableInfo)(nil), "mesos.Resource.RevocableInfo")
 	proto.RegisterType((*Resource_SharedInfo)(nil), "mesos.Resource.SharedInfo")
+	proto.RegisterType((*Resource_AllocationInfo)(nil), "mesos.Resource.AllocationInfo")
 	proto.RegisterType((*TrafficControlStatistics)(nil), "mesos.TrafficControlStatistics")
 	proto.RegisterType((*IpStatistics)(nil), "mesos.IpStatistics")
 	proto.RegisterType((*IcmpStatistics)(nil), "mesos.IcmpStatistics")
@@ -7400,7 +7471,7 @@ func (m *FrameworkID) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Value == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -7429,7 +7500,7 @@ func (m *OfferID) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Value == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -7458,7 +7529,7 @@ func (m *AgentID) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Value == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -7487,7 +7558,7 @@ func (m *TaskID) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Value == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -7516,7 +7587,7 @@ func (m *ExecutorID) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Value == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -7545,7 +7616,7 @@ func (m *ContainerID) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Value == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -7584,7 +7655,7 @@ func (m *TimeInfo) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Nanoseconds == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x8
 		i++
@@ -7612,7 +7683,7 @@ func (m *DurationInfo) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Nanoseconds == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x8
 		i++
@@ -7652,7 +7723,7 @@ func (m *Address) MarshalTo(dAtA []byte) (int, error) {
 		i += copy(dAtA[i:], *m.Ip)
 	}
 	if m.Port == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x18
 		i++
@@ -7680,7 +7751,7 @@ func (m *URL) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Scheme == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -7688,7 +7759,7 @@ func (m *URL) MarshalTo(dAtA []byte) (int, error) {
 		i += copy(dAtA[i:], *m.Scheme)
 	}
 	if m.Address == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x12
 		i++
@@ -7745,7 +7816,7 @@ func (m *Unavailability) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Start == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -7821,7 +7892,7 @@ func (m *MachineInfo) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Id == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -7869,7 +7940,7 @@ func (m *FrameworkInfo) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.User == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -7877,7 +7948,7 @@ func (m *FrameworkInfo) MarshalTo(dAtA []byte) (int, error) {
 		i += copy(dAtA[i:], *m.User)
 	}
 	if m.Name == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x12
 		i++
@@ -7897,7 +7968,8 @@ func (m *FrameworkInfo) MarshalTo(dAtA []byte) (int, error) {
 	if m.FailoverTimeout != nil {
 		dAtA[i] = 0x21
 		i++
-		i = encodeFixed64Mesos(dAtA, i, uint64(math.Float64bits(float64(*m.FailoverTimeout))))
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.FailoverTimeout))))
+		i += 8
 	}
 	if m.Checkpoint != nil {
 		dAtA[i] = 0x28
@@ -7955,6 +8027,21 @@ func (m *FrameworkInfo) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n8
 	}
+	if len(m.Roles) > 0 {
+		for _, s := range m.Roles {
+			dAtA[i] = 0x62
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -8015,17 +8102,20 @@ func (m *HealthCheck) MarshalTo(dAtA []byte) (int, error) {
 	if m.DelaySeconds != nil {
 		dAtA[i] = 0x11
 		i++
-		i = encodeFixed64Mesos(dAtA, i, uint64(math.Float64bits(float64(*m.DelaySeconds))))
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.DelaySeconds))))
+		i += 8
 	}
 	if m.IntervalSeconds != nil {
 		dAtA[i] = 0x19
 		i++
-		i = encodeFixed64Mesos(dAtA, i, uint64(math.Float64bits(float64(*m.IntervalSeconds))))
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.IntervalSeconds))))
+		i += 8
 	}
 	if m.TimeoutSeconds != nil {
 		dAtA[i] = 0x21
 		i++
-		i = encodeFixed64Mesos(dAtA, i, uint64(math.Float64bits(float64(*m.TimeoutSeconds))))
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.TimeoutSeconds))))
+		i += 8
 	}
 	if m.ConsecutiveFailures != nil {
 		dAtA[i] = 0x28
@@ -8035,7 +8125,8 @@ func (m *HealthCheck) MarshalTo(dAtA []byte) (int, error) {
 	if m.GracePeriodSeconds != nil {
 		dAtA[i] = 0x31
 		i++
-		i = encodeFixed64Mesos(dAtA, i, uint64(math.Float64bits(float64(*m.GracePeriodSeconds))))
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.GracePeriodSeconds))))
+		i += 8
 	}
 	if m.Command != nil {
 		dAtA[i] = 0x3a
@@ -8084,7 +8175,7 @@ func (m *HealthCheck_HTTPCheckInfo) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Port == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x8
 		i++
@@ -8131,7 +8222,7 @@ func (m *HealthCheck_TCPCheckInfo) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Port == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x8
 		i++
@@ -8270,7 +8361,7 @@ func (m *CommandInfo_URI) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Value == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -8335,7 +8426,7 @@ func (m *ExecutorInfo) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.ExecutorId == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -8463,7 +8554,7 @@ func (m *MasterInfo) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Id == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -8471,14 +8562,14 @@ func (m *MasterInfo) MarshalTo(dAtA []byte) (int, error) {
 		i += copy(dAtA[i:], *m.Id)
 	}
 	if m.Ip == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x10
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(*m.Ip))
 	}
 	if m.Port == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x18
 		i++
@@ -8534,7 +8625,7 @@ func (m *AgentInfo) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Hostname == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -8602,7 +8693,7 @@ func (m *Value) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Type == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x8
 		i++
@@ -8670,11 +8761,12 @@ func (m *Value_Scalar) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Value == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x9
 		i++
-		i = encodeFixed64Mesos(dAtA, i, uint64(math.Float64bits(float64(*m.Value))))
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.Value))))
+		i += 8
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -8698,14 +8790,14 @@ func (m *Value_Range) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Begin == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x8
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(*m.Begin))
 	}
 	if m.End == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x10
 		i++
@@ -8802,7 +8894,7 @@ func (m *Value_Text) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Value == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -8831,7 +8923,7 @@ func (m *Attribute) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Name == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -8839,7 +8931,7 @@ func (m *Attribute) MarshalTo(dAtA []byte) (int, error) {
 		i += copy(dAtA[i:], *m.Name)
 	}
 	if m.Type == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x10
 		i++
@@ -8907,7 +8999,7 @@ func (m *Resource) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Name == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -8915,7 +9007,7 @@ func (m *Resource) MarshalTo(dAtA []byte) (int, error) {
 		i += copy(dAtA[i:], *m.Name)
 	}
 	if m.Type == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x10
 		i++
@@ -8997,6 +9089,16 @@ func (m *Resource) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n37
 	}
+	if m.AllocationInfo != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintMesos(dAtA, i, uint64(m.AllocationInfo.Size()))
+		n38, err := m.AllocationInfo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n38
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -9028,11 +9130,11 @@ func (m *Resource_ReservationInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.Labels.Size()))
-		n38, err := m.Labels.MarshalTo(dAtA[i:])
+		n39, err := m.Labels.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n38
+		i += n39
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -9059,31 +9161,31 @@ func (m *Resource_DiskInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.Persistence.Size()))
-		n39, err := m.Persistence.MarshalTo(dAtA[i:])
+		n40, err := m.Persistence.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n39
+		i += n40
 	}
 	if m.Volume != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.Volume.Size()))
-		n40, err := m.Volume.MarshalTo(dAtA[i:])
+		n41, err := m.Volume.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n40
+		i += n41
 	}
 	if m.Source != nil {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.Source.Size()))
-		n41, err := m.Source.MarshalTo(dAtA[i:])
+		n42, err := m.Source.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n41
+		i += n42
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -9107,7 +9209,7 @@ func (m *Resource_DiskInfo_Persistence) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Id == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -9142,7 +9244,7 @@ func (m *Resource_DiskInfo_Source) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Type == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x8
 		i++
@@ -9152,21 +9254,21 @@ func (m *Resource_DiskInfo_Source) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.Path.Size()))
-		n42, err := m.Path.MarshalTo(dAtA[i:])
+		n43, err := m.Path.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n42
+		i += n43
 	}
 	if m.Mount != nil {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.Mount.Size()))
-		n43, err := m.Mount.MarshalTo(dAtA[i:])
+		n44, err := m.Mount.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n43
+		i += n44
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -9190,7 +9292,7 @@ func (m *Resource_DiskInfo_Source_Path) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Root == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -9219,7 +9321,7 @@ func (m *Resource_DiskInfo_Source_Mount) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Root == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -9274,6 +9376,33 @@ func (m *Resource_SharedInfo) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *Resource_AllocationInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Resource_AllocationInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Role != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMesos(dAtA, i, uint64(len(*m.Role)))
+		i += copy(dAtA[i:], *m.Role)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func (m *TrafficControlStatistics) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9290,7 +9419,7 @@ func (m *TrafficControlStatistics) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Id == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -9828,41 +9957,41 @@ func (m *SNMPStatistics) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.IpStats.Size()))
-		n44, err := m.IpStats.MarshalTo(dAtA[i:])
+		n45, err := m.IpStats.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n44
+		i += n45
 	}
 	if m.IcmpStats != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.IcmpStats.Size()))
-		n45, err := m.IcmpStats.MarshalTo(dAtA[i:])
+		n46, err := m.IcmpStats.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n45
+		i += n46
 	}
 	if m.TcpStats != nil {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.TcpStats.Size()))
-		n46, err := m.TcpStats.MarshalTo(dAtA[i:])
+		n47, err := m.TcpStats.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n46
+		i += n47
 	}
 	if m.UdpStats != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.UdpStats.Size()))
-		n47, err := m.UdpStats.MarshalTo(dAtA[i:])
+		n48, err := m.UdpStats.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n47
+		i += n48
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -9886,26 +10015,30 @@ func (m *ResourceStatistics) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Timestamp == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x9
 		i++
-		i = encodeFixed64Mesos(dAtA, i, uint64(math.Float64bits(float64(*m.Timestamp))))
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.Timestamp))))
+		i += 8
 	}
 	if m.CpusUserTimeSecs != nil {
 		dAtA[i] = 0x11
 		i++
-		i = encodeFixed64Mesos(dAtA, i, uint64(math.Float64bits(float64(*m.CpusUserTimeSecs))))
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.CpusUserTimeSecs))))
+		i += 8
 	}
 	if m.CpusSystemTimeSecs != nil {
 		dAtA[i] = 0x19
 		i++
-		i = encodeFixed64Mesos(dAtA, i, uint64(math.Float64bits(float64(*m.CpusSystemTimeSecs))))
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.CpusSystemTimeSecs))))
+		i += 8
 	}
 	if m.CpusLimit != nil {
 		dAtA[i] = 0x21
 		i++
-		i = encodeFixed64Mesos(dAtA, i, uint64(math.Float64bits(float64(*m.CpusLimit))))
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.CpusLimit))))
+		i += 8
 	}
 	if m.MemRssBytes != nil {
 		dAtA[i] = 0x28
@@ -9930,7 +10063,8 @@ func (m *ResourceStatistics) MarshalTo(dAtA []byte) (int, error) {
 	if m.CpusThrottledTimeSecs != nil {
 		dAtA[i] = 0x49
 		i++
-		i = encodeFixed64Mesos(dAtA, i, uint64(math.Float64bits(float64(*m.CpusThrottledTimeSecs))))
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.CpusThrottledTimeSecs))))
+		i += 8
 	}
 	if m.MemFileBytes != nil {
 		dAtA[i] = 0x50
@@ -9951,11 +10085,11 @@ func (m *ResourceStatistics) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6a
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.Perf.Size()))
-		n48, err := m.Perf.MarshalTo(dAtA[i:])
+		n49, err := m.Perf.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n48
+		i += n49
 	}
 	if m.NetRxPackets != nil {
 		dAtA[i] = 0x70
@@ -10014,28 +10148,32 @@ func (m *ResourceStatistics) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		dAtA[i] = 0x1
 		i++
-		i = encodeFixed64Mesos(dAtA, i, uint64(math.Float64bits(float64(*m.NetTcpRttMicrosecsP50))))
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.NetTcpRttMicrosecsP50))))
+		i += 8
 	}
 	if m.NetTcpRttMicrosecsP90 != nil {
 		dAtA[i] = 0xb9
 		i++
 		dAtA[i] = 0x1
 		i++
-		i = encodeFixed64Mesos(dAtA, i, uint64(math.Float64bits(float64(*m.NetTcpRttMicrosecsP90))))
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.NetTcpRttMicrosecsP90))))
+		i += 8
 	}
 	if m.NetTcpRttMicrosecsP95 != nil {
 		dAtA[i] = 0xc1
 		i++
 		dAtA[i] = 0x1
 		i++
-		i = encodeFixed64Mesos(dAtA, i, uint64(math.Float64bits(float64(*m.NetTcpRttMicrosecsP95))))
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.NetTcpRttMicrosecsP95))))
+		i += 8
 	}
 	if m.NetTcpRttMicrosecsP99 != nil {
 		dAtA[i] = 0xc9
 		i++
 		dAtA[i] = 0x1
 		i++
-		i = encodeFixed64Mesos(dAtA, i, uint64(math.Float64bits(float64(*m.NetTcpRttMicrosecsP99))))
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.NetTcpRttMicrosecsP99))))
+		i += 8
 	}
 	if m.DiskLimitBytes != nil {
 		dAtA[i] = 0xd0
@@ -10056,14 +10194,16 @@ func (m *ResourceStatistics) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		dAtA[i] = 0x1
 		i++
-		i = encodeFixed64Mesos(dAtA, i, uint64(math.Float64bits(float64(*m.NetTcpActiveConnections))))
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.NetTcpActiveConnections))))
+		i += 8
 	}
 	if m.NetTcpTimeWaitConnections != nil {
 		dAtA[i] = 0xe9
 		i++
 		dAtA[i] = 0x1
 		i++
-		i = encodeFixed64Mesos(dAtA, i, uint64(math.Float64bits(float64(*m.NetTcpTimeWaitConnections))))
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.NetTcpTimeWaitConnections))))
+		i += 8
 	}
 	if m.Processes != nil {
 		dAtA[i] = 0xf0
@@ -10162,11 +10302,11 @@ func (m *ResourceStatistics) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.NetSnmpStatistics.Size()))
-		n49, err := m.NetSnmpStatistics.MarshalTo(dAtA[i:])
+		n50, err := m.NetSnmpStatistics.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n49
+		i += n50
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -10235,16 +10375,16 @@ func (m *ResourceUsage_Executor) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.ExecutorInfo == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.ExecutorInfo.Size()))
-		n50, err := m.ExecutorInfo.MarshalTo(dAtA[i:])
+		n51, err := m.ExecutorInfo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n50
+		i += n51
 	}
 	if len(m.Allocated) > 0 {
 		for _, msg := range m.Allocated {
@@ -10262,23 +10402,23 @@ func (m *ResourceUsage_Executor) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.Statistics.Size()))
-		n51, err := m.Statistics.MarshalTo(dAtA[i:])
+		n52, err := m.Statistics.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n51
+		i += n52
 	}
 	if m.ContainerId == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.ContainerId.Size()))
-		n52, err := m.ContainerId.MarshalTo(dAtA[i:])
+		n53, err := m.ContainerId.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n52
+		i += n53
 	}
 	if len(m.Tasks) > 0 {
 		for _, msg := range m.Tasks {
@@ -10314,7 +10454,7 @@ func (m *ResourceUsage_Executor_Task) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Name == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -10322,16 +10462,16 @@ func (m *ResourceUsage_Executor_Task) MarshalTo(dAtA []byte) (int, error) {
 		i += copy(dAtA[i:], *m.Name)
 	}
 	if m.Id == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.Id.Size()))
-		n53, err := m.Id.MarshalTo(dAtA[i:])
+		n54, err := m.Id.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n53
+		i += n54
 	}
 	if len(m.Resources) > 0 {
 		for _, msg := range m.Resources {
@@ -10349,11 +10489,11 @@ func (m *ResourceUsage_Executor_Task) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.Labels.Size()))
-		n54, err := m.Labels.MarshalTo(dAtA[i:])
+		n55, err := m.Labels.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n54
+		i += n55
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -10377,18 +10517,20 @@ func (m *PerfStatistics) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Timestamp == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x9
 		i++
-		i = encodeFixed64Mesos(dAtA, i, uint64(math.Float64bits(float64(*m.Timestamp))))
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.Timestamp))))
+		i += 8
 	}
 	if m.Duration == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x11
 		i++
-		i = encodeFixed64Mesos(dAtA, i, uint64(math.Float64bits(float64(*m.Duration))))
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.Duration))))
+		i += 8
 	}
 	if m.Cycles != nil {
 		dAtA[i] = 0x18
@@ -10443,12 +10585,14 @@ func (m *PerfStatistics) MarshalTo(dAtA []byte) (int, error) {
 	if m.CpuClock != nil {
 		dAtA[i] = 0x69
 		i++
-		i = encodeFixed64Mesos(dAtA, i, uint64(math.Float64bits(float64(*m.CpuClock))))
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.CpuClock))))
+		i += 8
 	}
 	if m.TaskClock != nil {
 		dAtA[i] = 0x71
 		i++
-		i = encodeFixed64Mesos(dAtA, i, uint64(math.Float64bits(float64(*m.TaskClock))))
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.TaskClock))))
+		i += 8
 	}
 	if m.PageFaults != nil {
 		dAtA[i] = 0x78
@@ -10746,11 +10890,11 @@ func (m *Request) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.AgentId.Size()))
-		n55, err := m.AgentId.MarshalTo(dAtA[i:])
+		n56, err := m.AgentId.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n55
+		i += n56
 	}
 	if len(m.Resources) > 0 {
 		for _, msg := range m.Resources {
@@ -10786,43 +10930,43 @@ func (m *Offer) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Id == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.Id.Size()))
-		n56, err := m.Id.MarshalTo(dAtA[i:])
+		n57, err := m.Id.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n56
+		i += n57
 	}
 	if m.FrameworkId == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.FrameworkId.Size()))
-		n57, err := m.FrameworkId.MarshalTo(dAtA[i:])
+		n58, err := m.FrameworkId.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n57
+		i += n58
 	}
 	if m.AgentId == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.AgentId.Size()))
-		n58, err := m.AgentId.MarshalTo(dAtA[i:])
+		n59, err := m.AgentId.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n58
+		i += n59
 	}
 	if m.Hostname == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x22
 		i++
@@ -10869,21 +11013,31 @@ func (m *Offer) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x42
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.Url.Size()))
-		n59, err := m.Url.MarshalTo(dAtA[i:])
+		n60, err := m.Url.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n59
+		i += n60
 	}
 	if m.Unavailability != nil {
 		dAtA[i] = 0x4a
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.Unavailability.Size()))
-		n60, err := m.Unavailability.MarshalTo(dAtA[i:])
+		n61, err := m.Unavailability.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n60
+		i += n61
+	}
+	if m.AllocationInfo != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintMesos(dAtA, i, uint64(m.AllocationInfo.Size()))
+		n62, err := m.AllocationInfo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n62
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -10915,61 +11069,61 @@ func (m *Offer_Operation) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.Launch.Size()))
-		n61, err := m.Launch.MarshalTo(dAtA[i:])
+		n63, err := m.Launch.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n61
+		i += n63
 	}
 	if m.Reserve != nil {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.Reserve.Size()))
-		n62, err := m.Reserve.MarshalTo(dAtA[i:])
+		n64, err := m.Reserve.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n62
+		i += n64
 	}
 	if m.Unreserve != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.Unreserve.Size()))
-		n63, err := m.Unreserve.MarshalTo(dAtA[i:])
+		n65, err := m.Unreserve.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n63
+		i += n65
 	}
 	if m.Create != nil {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.Create.Size()))
-		n64, err := m.Create.MarshalTo(dAtA[i:])
+		n66, err := m.Create.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n64
+		i += n66
 	}
 	if m.Destroy != nil {
 		dAtA[i] = 0x32
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.Destroy.Size()))
-		n65, err := m.Destroy.MarshalTo(dAtA[i:])
+		n67, err := m.Destroy.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n65
+		i += n67
 	}
 	if m.LaunchGroup != nil {
 		dAtA[i] = 0x3a
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.LaunchGroup.Size()))
-		n66, err := m.LaunchGroup.MarshalTo(dAtA[i:])
+		n68, err := m.LaunchGroup.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n66
+		i += n68
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -11026,28 +11180,28 @@ func (m *Offer_Operation_LaunchGroup) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Executor == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.Executor.Size()))
-		n67, err := m.Executor.MarshalTo(dAtA[i:])
+		n69, err := m.Executor.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n67
+		i += n69
 	}
 	if m.TaskGroup == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.TaskGroup.Size()))
-		n68, err := m.TaskGroup.MarshalTo(dAtA[i:])
+		n70, err := m.TaskGroup.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n68
+		i += n70
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -11203,60 +11357,60 @@ func (m *InverseOffer) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Id == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.Id.Size()))
-		n69, err := m.Id.MarshalTo(dAtA[i:])
+		n71, err := m.Id.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n69
+		i += n71
 	}
 	if m.Url != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.Url.Size()))
-		n70, err := m.Url.MarshalTo(dAtA[i:])
+		n72, err := m.Url.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n70
+		i += n72
 	}
 	if m.FrameworkId == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.FrameworkId.Size()))
-		n71, err := m.FrameworkId.MarshalTo(dAtA[i:])
+		n73, err := m.FrameworkId.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n71
+		i += n73
 	}
 	if m.AgentId != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.AgentId.Size()))
-		n72, err := m.AgentId.MarshalTo(dAtA[i:])
+		n74, err := m.AgentId.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n72
+		i += n74
 	}
 	if m.Unavailability == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.Unavailability.Size()))
-		n73, err := m.Unavailability.MarshalTo(dAtA[i:])
+		n75, err := m.Unavailability.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n73
+		i += n75
 	}
 	if len(m.Resources) > 0 {
 		for _, msg := range m.Resources {
@@ -11292,7 +11446,7 @@ func (m *TaskInfo) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Name == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -11300,28 +11454,28 @@ func (m *TaskInfo) MarshalTo(dAtA []byte) (int, error) {
 		i += copy(dAtA[i:], *m.Name)
 	}
 	if m.TaskId == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.TaskId.Size()))
-		n74, err := m.TaskId.MarshalTo(dAtA[i:])
+		n76, err := m.TaskId.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n74
+		i += n76
 	}
 	if m.AgentId == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.AgentId.Size()))
-		n75, err := m.AgentId.MarshalTo(dAtA[i:])
+		n77, err := m.AgentId.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n75
+		i += n77
 	}
 	if len(m.Resources) > 0 {
 		for _, msg := range m.Resources {
@@ -11339,11 +11493,11 @@ func (m *TaskInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.Executor.Size()))
-		n76, err := m.Executor.MarshalTo(dAtA[i:])
+		n78, err := m.Executor.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n76
+		i += n78
 	}
 	if m.Data != nil {
 		dAtA[i] = 0x32
@@ -11355,61 +11509,61 @@ func (m *TaskInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3a
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.Command.Size()))
-		n77, err := m.Command.MarshalTo(dAtA[i:])
+		n79, err := m.Command.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n77
+		i += n79
 	}
 	if m.HealthCheck != nil {
 		dAtA[i] = 0x42
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.HealthCheck.Size()))
-		n78, err := m.HealthCheck.MarshalTo(dAtA[i:])
+		n80, err := m.HealthCheck.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n78
+		i += n80
 	}
 	if m.Container != nil {
 		dAtA[i] = 0x4a
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.Container.Size()))
-		n79, err := m.Container.MarshalTo(dAtA[i:])
+		n81, err := m.Container.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n79
+		i += n81
 	}
 	if m.Labels != nil {
 		dAtA[i] = 0x52
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.Labels.Size()))
-		n80, err := m.Labels.MarshalTo(dAtA[i:])
+		n82, err := m.Labels.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n80
+		i += n82
 	}
 	if m.Discovery != nil {
 		dAtA[i] = 0x5a
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.Discovery.Size()))
-		n81, err := m.Discovery.MarshalTo(dAtA[i:])
+		n83, err := m.Discovery.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n81
+		i += n83
 	}
 	if m.KillPolicy != nil {
 		dAtA[i] = 0x62
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.KillPolicy.Size()))
-		n82, err := m.KillPolicy.MarshalTo(dAtA[i:])
+		n84, err := m.KillPolicy.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n82
+		i += n84
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -11466,7 +11620,7 @@ func (m *Task) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Name == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -11474,53 +11628,53 @@ func (m *Task) MarshalTo(dAtA []byte) (int, error) {
 		i += copy(dAtA[i:], *m.Name)
 	}
 	if m.TaskId == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.TaskId.Size()))
-		n83, err := m.TaskId.MarshalTo(dAtA[i:])
+		n85, err := m.TaskId.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n83
+		i += n85
 	}
 	if m.FrameworkId == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.FrameworkId.Size()))
-		n84, err := m.FrameworkId.MarshalTo(dAtA[i:])
+		n86, err := m.FrameworkId.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n84
+		i += n86
 	}
 	if m.ExecutorId != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.ExecutorId.Size()))
-		n85, err := m.ExecutorId.MarshalTo(dAtA[i:])
+		n87, err := m.ExecutorId.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n85
+		i += n87
 	}
 	if m.AgentId == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.AgentId.Size()))
-		n86, err := m.AgentId.MarshalTo(dAtA[i:])
+		n88, err := m.AgentId.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n86
+		i += n88
 	}
 	if m.State == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x30
 		i++
@@ -11565,31 +11719,31 @@ func (m *Task) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x5a
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.Labels.Size()))
-		n87, err := m.Labels.MarshalTo(dAtA[i:])
+		n89, err := m.Labels.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n87
+		i += n89
 	}
 	if m.Discovery != nil {
 		dAtA[i] = 0x62
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.Discovery.Size()))
-		n88, err := m.Discovery.MarshalTo(dAtA[i:])
+		n90, err := m.Discovery.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n88
+		i += n90
 	}
 	if m.Container != nil {
 		dAtA[i] = 0x6a
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.Container.Size()))
-		n89, err := m.Container.MarshalTo(dAtA[i:])
+		n91, err := m.Container.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n89
+		i += n91
 	}
 	if m.User != nil {
 		dAtA[i] = 0x72
@@ -11619,19 +11773,19 @@ func (m *TaskStatus) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.TaskId == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.TaskId.Size()))
-		n90, err := m.TaskId.MarshalTo(dAtA[i:])
+		n92, err := m.TaskId.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n90
+		i += n92
 	}
 	if m.State == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x10
 		i++
@@ -11653,26 +11807,27 @@ func (m *TaskStatus) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.AgentId.Size()))
-		n91, err := m.AgentId.MarshalTo(dAtA[i:])
+		n93, err := m.AgentId.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n91
+		i += n93
 	}
 	if m.Timestamp != nil {
 		dAtA[i] = 0x31
 		i++
-		i = encodeFixed64Mesos(dAtA, i, uint64(math.Float64bits(float64(*m.Timestamp))))
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.Timestamp))))
+		i += 8
 	}
 	if m.ExecutorId != nil {
 		dAtA[i] = 0x3a
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.ExecutorId.Size()))
-		n92, err := m.ExecutorId.MarshalTo(dAtA[i:])
+		n94, err := m.ExecutorId.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n92
+		i += n94
 	}
 	if m.Healthy != nil {
 		dAtA[i] = 0x40
@@ -11704,31 +11859,31 @@ func (m *TaskStatus) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x62
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.Labels.Size()))
-		n93, err := m.Labels.MarshalTo(dAtA[i:])
+		n95, err := m.Labels.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n93
+		i += n95
 	}
 	if m.ContainerStatus != nil {
 		dAtA[i] = 0x6a
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.ContainerStatus.Size()))
-		n94, err := m.ContainerStatus.MarshalTo(dAtA[i:])
+		n96, err := m.ContainerStatus.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n94
+		i += n96
 	}
 	if m.UnreachableTime != nil {
 		dAtA[i] = 0x72
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.UnreachableTime.Size()))
-		n95, err := m.UnreachableTime.MarshalTo(dAtA[i:])
+		n97, err := m.UnreachableTime.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n95
+		i += n97
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -11754,7 +11909,8 @@ func (m *Filters) MarshalTo(dAtA []byte) (int, error) {
 	if m.RefuseSeconds != nil {
 		dAtA[i] = 0x9
 		i++
-		i = encodeFixed64Mesos(dAtA, i, uint64(math.Float64bits(float64(*m.RefuseSeconds))))
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.RefuseSeconds))))
+		i += 8
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -11811,7 +11967,7 @@ func (m *Environment_Variable) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Name == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -11819,7 +11975,7 @@ func (m *Environment_Variable) MarshalTo(dAtA []byte) (int, error) {
 		i += copy(dAtA[i:], *m.Name)
 	}
 	if m.Value == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x12
 		i++
@@ -11848,7 +12004,7 @@ func (m *Parameter) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Key == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -11856,7 +12012,7 @@ func (m *Parameter) MarshalTo(dAtA []byte) (int, error) {
 		i += copy(dAtA[i:], *m.Key)
 	}
 	if m.Value == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x12
 		i++
@@ -11918,7 +12074,7 @@ func (m *Credential) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Principal == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -11988,10 +12144,11 @@ func (m *RateLimit) MarshalTo(dAtA []byte) (int, error) {
 	if m.Qps != nil {
 		dAtA[i] = 0x9
 		i++
-		i = encodeFixed64Mesos(dAtA, i, uint64(math.Float64bits(float64(*m.Qps))))
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.Qps))))
+		i += 8
 	}
 	if m.Principal == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x12
 		i++
@@ -12039,7 +12196,8 @@ func (m *RateLimits) MarshalTo(dAtA []byte) (int, error) {
 	if m.AggregateDefaultQps != nil {
 		dAtA[i] = 0x11
 		i++
-		i = encodeFixed64Mesos(dAtA, i, uint64(math.Float64bits(float64(*m.AggregateDefaultQps))))
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.AggregateDefaultQps))))
+		i += 8
 	}
 	if m.AggregateDefaultCapacity != nil {
 		dAtA[i] = 0x18
@@ -12068,7 +12226,7 @@ func (m *Image) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Type == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x8
 		i++
@@ -12078,21 +12236,21 @@ func (m *Image) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.Appc.Size()))
-		n96, err := m.Appc.MarshalTo(dAtA[i:])
+		n98, err := m.Appc.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n96
+		i += n98
 	}
 	if m.Docker != nil {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.Docker.Size()))
-		n97, err := m.Docker.MarshalTo(dAtA[i:])
+		n99, err := m.Docker.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n97
+		i += n99
 	}
 	if m.Cached != nil {
 		dAtA[i] = 0x20
@@ -12126,7 +12284,7 @@ func (m *Image_Appc) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Name == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -12143,11 +12301,11 @@ func (m *Image_Appc) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.Labels.Size()))
-		n98, err := m.Labels.MarshalTo(dAtA[i:])
+		n100, err := m.Labels.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n98
+		i += n100
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -12171,7 +12329,7 @@ func (m *Image_Docker) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Name == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -12182,11 +12340,11 @@ func (m *Image_Docker) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.Credential.Size()))
-		n99, err := m.Credential.MarshalTo(dAtA[i:])
+		n101, err := m.Credential.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n99
+		i += n101
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -12210,7 +12368,7 @@ func (m *Volume) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.ContainerPath == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -12224,7 +12382,7 @@ func (m *Volume) MarshalTo(dAtA []byte) (int, error) {
 		i += copy(dAtA[i:], *m.HostPath)
 	}
 	if m.Mode == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x18
 		i++
@@ -12234,21 +12392,21 @@ func (m *Volume) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.Image.Size()))
-		n100, err := m.Image.MarshalTo(dAtA[i:])
+		n102, err := m.Image.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n100
+		i += n102
 	}
 	if m.Source != nil {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.Source.Size()))
-		n101, err := m.Source.MarshalTo(dAtA[i:])
+		n103, err := m.Source.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n101
+		i += n103
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -12280,21 +12438,21 @@ func (m *Volume_Source) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.DockerVolume.Size()))
-		n102, err := m.DockerVolume.MarshalTo(dAtA[i:])
+		n104, err := m.DockerVolume.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n102
+		i += n104
 	}
 	if m.SandboxPath != nil {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.SandboxPath.Size()))
-		n103, err := m.SandboxPath.MarshalTo(dAtA[i:])
+		n105, err := m.SandboxPath.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n103
+		i += n105
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -12324,7 +12482,7 @@ func (m *Volume_Source_DockerVolume) MarshalTo(dAtA []byte) (int, error) {
 		i += copy(dAtA[i:], *m.Driver)
 	}
 	if m.Name == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x12
 		i++
@@ -12335,11 +12493,11 @@ func (m *Volume_Source_DockerVolume) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.DriverOptions.Size()))
-		n104, err := m.DriverOptions.MarshalTo(dAtA[i:])
+		n106, err := m.DriverOptions.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n104
+		i += n106
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -12368,7 +12526,7 @@ func (m *Volume_Source_SandboxPath) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintMesos(dAtA, i, uint64(*m.Type))
 	}
 	if m.Path == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x12
 		i++
@@ -12415,11 +12573,11 @@ func (m *NetworkInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.Labels.Size()))
-		n105, err := m.Labels.MarshalTo(dAtA[i:])
+		n107, err := m.Labels.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n105
+		i += n107
 	}
 	if len(m.IpAddresses) > 0 {
 		for _, msg := range m.IpAddresses {
@@ -12505,14 +12663,14 @@ func (m *NetworkInfo_PortMapping) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.HostPort == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x8
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(*m.HostPort))
 	}
 	if m.ContainerPort == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x10
 		i++
@@ -12577,11 +12735,11 @@ func (m *LinuxInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.CapabilityInfo.Size()))
-		n106, err := m.CapabilityInfo.MarshalTo(dAtA[i:])
+		n108, err := m.CapabilityInfo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n106
+		i += n108
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -12677,11 +12835,11 @@ func (m *TTYInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.WindowSize.Size()))
-		n107, err := m.WindowSize.MarshalTo(dAtA[i:])
+		n109, err := m.WindowSize.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n107
+		i += n109
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -12705,14 +12863,14 @@ func (m *TTYInfo_WindowSize) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Rows == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x8
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(*m.Rows))
 	}
 	if m.Columns == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x10
 		i++
@@ -12740,7 +12898,7 @@ func (m *ContainerInfo) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Type == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x8
 		i++
@@ -12762,11 +12920,11 @@ func (m *ContainerInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.Docker.Size()))
-		n108, err := m.Docker.MarshalTo(dAtA[i:])
+		n110, err := m.Docker.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n108
+		i += n110
 	}
 	if m.Hostname != nil {
 		dAtA[i] = 0x22
@@ -12778,11 +12936,11 @@ func (m *ContainerInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.Mesos.Size()))
-		n109, err := m.Mesos.MarshalTo(dAtA[i:])
+		n111, err := m.Mesos.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n109
+		i += n111
 	}
 	if len(m.NetworkInfos) > 0 {
 		for _, msg := range m.NetworkInfos {
@@ -12800,31 +12958,31 @@ func (m *ContainerInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x42
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.LinuxInfo.Size()))
-		n110, err := m.LinuxInfo.MarshalTo(dAtA[i:])
+		n112, err := m.LinuxInfo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n110
+		i += n112
 	}
 	if m.RlimitInfo != nil {
 		dAtA[i] = 0x4a
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.RlimitInfo.Size()))
-		n111, err := m.RlimitInfo.MarshalTo(dAtA[i:])
+		n113, err := m.RlimitInfo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n111
+		i += n113
 	}
 	if m.TtyInfo != nil {
 		dAtA[i] = 0x52
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.TtyInfo.Size()))
-		n112, err := m.TtyInfo.MarshalTo(dAtA[i:])
+		n114, err := m.TtyInfo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n112
+		i += n114
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -12848,7 +13006,7 @@ func (m *ContainerInfo_DockerInfo) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Image == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -12932,14 +13090,14 @@ func (m *ContainerInfo_DockerInfo_PortMapping) MarshalTo(dAtA []byte) (int, erro
 	var l int
 	_ = l
 	if m.HostPort == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x8
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(*m.HostPort))
 	}
 	if m.ContainerPort == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x10
 		i++
@@ -12976,11 +13134,11 @@ func (m *ContainerInfo_MesosInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.Image.Size()))
-		n113, err := m.Image.MarshalTo(dAtA[i:])
+		n115, err := m.Image.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n113
+		i += n115
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -13019,11 +13177,11 @@ func (m *ContainerStatus) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.CgroupInfo.Size()))
-		n114, err := m.CgroupInfo.MarshalTo(dAtA[i:])
+		n116, err := m.CgroupInfo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n114
+		i += n116
 	}
 	if m.ExecutorPid != nil {
 		dAtA[i] = 0x18
@@ -13034,11 +13192,11 @@ func (m *ContainerStatus) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.ContainerId.Size()))
-		n115, err := m.ContainerId.MarshalTo(dAtA[i:])
+		n117, err := m.ContainerId.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n115
+		i += n117
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -13065,11 +13223,11 @@ func (m *CgroupInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.NetCls.Size()))
-		n116, err := m.NetCls.MarshalTo(dAtA[i:])
+		n118, err := m.NetCls.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n116
+		i += n118
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -13152,7 +13310,7 @@ func (m *Label) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Key == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -13187,7 +13345,7 @@ func (m *Port) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Number == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x8
 		i++
@@ -13214,11 +13372,11 @@ func (m *Port) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.Labels.Size()))
-		n117, err := m.Labels.MarshalTo(dAtA[i:])
+		n119, err := m.Labels.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n117
+		i += n119
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -13275,7 +13433,7 @@ func (m *DiscoveryInfo) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Visibility == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x8
 		i++
@@ -13309,21 +13467,21 @@ func (m *DiscoveryInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x32
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.Ports.Size()))
-		n118, err := m.Ports.MarshalTo(dAtA[i:])
+		n120, err := m.Ports.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n118
+		i += n120
 	}
 	if m.Labels != nil {
 		dAtA[i] = 0x3a
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.Labels.Size()))
-		n119, err := m.Labels.MarshalTo(dAtA[i:])
+		n121, err := m.Labels.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n119
+		i += n121
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -13347,11 +13505,12 @@ func (m *WeightInfo) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Weight == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x9
 		i++
-		i = encodeFixed64Mesos(dAtA, i, uint64(math.Float64bits(float64(*m.Weight))))
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.Weight))))
+		i += 8
 	}
 	if m.Role != nil {
 		dAtA[i] = 0x12
@@ -13381,7 +13540,7 @@ func (m *VersionInfo) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Version == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -13397,7 +13556,8 @@ func (m *VersionInfo) MarshalTo(dAtA []byte) (int, error) {
 	if m.BuildTime != nil {
 		dAtA[i] = 0x19
 		i++
-		i = encodeFixed64Mesos(dAtA, i, uint64(math.Float64bits(float64(*m.BuildTime))))
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.BuildTime))))
+		i += 8
 	}
 	if m.BuildUser != nil {
 		dAtA[i] = 0x22
@@ -13445,7 +13605,7 @@ func (m *Flag) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Name == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -13480,7 +13640,7 @@ func (m *Role) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Name == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -13488,11 +13648,12 @@ func (m *Role) MarshalTo(dAtA []byte) (int, error) {
 		i += copy(dAtA[i:], *m.Name)
 	}
 	if m.Weight == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x11
 		i++
-		i = encodeFixed64Mesos(dAtA, i, uint64(math.Float64bits(float64(*m.Weight))))
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.Weight))))
+		i += 8
 	}
 	if len(m.Frameworks) > 0 {
 		for _, msg := range m.Frameworks {
@@ -13540,7 +13701,7 @@ func (m *Metric) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Name == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -13550,7 +13711,8 @@ func (m *Metric) MarshalTo(dAtA []byte) (int, error) {
 	if m.Value != nil {
 		dAtA[i] = 0x11
 		i++
-		i = encodeFixed64Mesos(dAtA, i, uint64(math.Float64bits(float64(*m.Value))))
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.Value))))
+		i += 8
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -13574,7 +13736,7 @@ func (m *FileInfo) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Path == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -13595,11 +13757,11 @@ func (m *FileInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintMesos(dAtA, i, uint64(m.Mtime.Size()))
-		n120, err := m.Mtime.MarshalTo(dAtA[i:])
+		n122, err := m.Mtime.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n120
+		i += n122
 	}
 	if m.Mode != nil {
 		dAtA[i] = 0x28
@@ -13624,24 +13786,6 @@ func (m *FileInfo) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeFixed64Mesos(dAtA []byte, offset int, v uint64) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	dAtA[offset+4] = uint8(v >> 32)
-	dAtA[offset+5] = uint8(v >> 40)
-	dAtA[offset+6] = uint8(v >> 48)
-	dAtA[offset+7] = uint8(v >> 56)
-	return offset + 8
-}
-func encodeFixed32Mesos(dAtA []byte, offset int, v uint32) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	return offset + 4
-}
 func encodeVarintMesos(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -13909,6 +14053,12 @@ func (m *FrameworkInfo) Size() (n int) {
 		l = m.Labels.Size()
 		n += 1 + l + sovMesos(uint64(l))
 	}
+	if len(m.Roles) > 0 {
+		for _, s := range m.Roles {
+			l = len(s)
+			n += 1 + l + sovMesos(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -14375,6 +14525,10 @@ func (m *Resource) Size() (n int) {
 		l = m.Shared.Size()
 		n += 1 + l + sovMesos(uint64(l))
 	}
+	if m.AllocationInfo != nil {
+		l = m.AllocationInfo.Size()
+		n += 1 + l + sovMesos(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -14500,6 +14654,19 @@ func (m *Resource_SharedInfo) Size() (n int) {
 	return n
 }
 
+func (m *Resource_AllocationInfo) Size() (n int) {
+	var l int
+	_ = l
+	if m.Role != nil {
+		l = len(*m.Role)
+		n += 1 + l + sovMesos(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *TrafficControlStatistics) Size() (n int) {
 	var l int
 	_ = l
@@ -15261,6 +15428,10 @@ func (m *Offer) Size() (n int) {
 		l = m.Unavailability.Size()
 		n += 1 + l + sovMesos(uint64(l))
 	}
+	if m.AllocationInfo != nil {
+		l = m.AllocationInfo.Size()
+		n += 1 + l + sovMesos(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -16600,7 +16771,7 @@ func (m *FrameworkID) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -16686,7 +16857,7 @@ func (m *OfferID) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -16772,7 +16943,7 @@ func (m *AgentID) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -16858,7 +17029,7 @@ func (m *TaskID) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -16944,7 +17115,7 @@ func (m *ExecutorID) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -17063,7 +17234,7 @@ func (m *ContainerID) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -17139,7 +17310,7 @@ func (m *TimeInfo) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -17215,7 +17386,7 @@ func (m *DurationInfo) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -17351,7 +17522,7 @@ func (m *Address) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -17562,10 +17733,10 @@ func (m *URL) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 	if hasFields[0]&uint64(0x00000002) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -17687,7 +17858,7 @@ func (m *Unavailability) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -17940,7 +18111,7 @@ func (m *MachineInfo) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -18081,15 +18252,8 @@ func (m *FrameworkInfo) Unmarshal(dAtA []byte) error {
 			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			v = uint64(dAtA[iNdEx-8])
-			v |= uint64(dAtA[iNdEx-7]) << 8
-			v |= uint64(dAtA[iNdEx-6]) << 16
-			v |= uint64(dAtA[iNdEx-5]) << 24
-			v |= uint64(dAtA[iNdEx-4]) << 32
-			v |= uint64(dAtA[iNdEx-3]) << 40
-			v |= uint64(dAtA[iNdEx-2]) << 48
-			v |= uint64(dAtA[iNdEx-1]) << 56
 			v2 := float64(math.Float64frombits(v))
 			m.FailoverTimeout = &v2
 		case 5:
@@ -18297,6 +18461,35 @@ func (m *FrameworkInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMesos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMesos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMesos(dAtA[iNdEx:])
@@ -18314,10 +18507,10 @@ func (m *FrameworkInfo) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 	if hasFields[0]&uint64(0x00000002) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -18466,15 +18659,8 @@ func (m *HealthCheck) Unmarshal(dAtA []byte) error {
 			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			v = uint64(dAtA[iNdEx-8])
-			v |= uint64(dAtA[iNdEx-7]) << 8
-			v |= uint64(dAtA[iNdEx-6]) << 16
-			v |= uint64(dAtA[iNdEx-5]) << 24
-			v |= uint64(dAtA[iNdEx-4]) << 32
-			v |= uint64(dAtA[iNdEx-3]) << 40
-			v |= uint64(dAtA[iNdEx-2]) << 48
-			v |= uint64(dAtA[iNdEx-1]) << 56
 			v2 := float64(math.Float64frombits(v))
 			m.DelaySeconds = &v2
 		case 3:
@@ -18485,15 +18671,8 @@ func (m *HealthCheck) Unmarshal(dAtA []byte) error {
 			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			v = uint64(dAtA[iNdEx-8])
-			v |= uint64(dAtA[iNdEx-7]) << 8
-			v |= uint64(dAtA[iNdEx-6]) << 16
-			v |= uint64(dAtA[iNdEx-5]) << 24
-			v |= uint64(dAtA[iNdEx-4]) << 32
-			v |= uint64(dAtA[iNdEx-3]) << 40
-			v |= uint64(dAtA[iNdEx-2]) << 48
-			v |= uint64(dAtA[iNdEx-1]) << 56
 			v2 := float64(math.Float64frombits(v))
 			m.IntervalSeconds = &v2
 		case 4:
@@ -18504,15 +18683,8 @@ func (m *HealthCheck) Unmarshal(dAtA []byte) error {
 			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			v = uint64(dAtA[iNdEx-8])
-			v |= uint64(dAtA[iNdEx-7]) << 8
-			v |= uint64(dAtA[iNdEx-6]) << 16
-			v |= uint64(dAtA[iNdEx-5]) << 24
-			v |= uint64(dAtA[iNdEx-4]) << 32
-			v |= uint64(dAtA[iNdEx-3]) << 40
-			v |= uint64(dAtA[iNdEx-2]) << 48
-			v |= uint64(dAtA[iNdEx-1]) << 56
 			v2 := float64(math.Float64frombits(v))
 			m.TimeoutSeconds = &v2
 		case 5:
@@ -18543,15 +18715,8 @@ func (m *HealthCheck) Unmarshal(dAtA []byte) error {
 			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			v = uint64(dAtA[iNdEx-8])
-			v |= uint64(dAtA[iNdEx-7]) << 8
-			v |= uint64(dAtA[iNdEx-6]) << 16
-			v |= uint64(dAtA[iNdEx-5]) << 24
-			v |= uint64(dAtA[iNdEx-4]) << 32
-			v |= uint64(dAtA[iNdEx-3]) << 40
-			v |= uint64(dAtA[iNdEx-2]) << 48
-			v |= uint64(dAtA[iNdEx-1]) << 56
 			v2 := float64(math.Float64frombits(v))
 			m.GracePeriodSeconds = &v2
 		case 7:
@@ -18852,7 +19017,7 @@ func (m *HealthCheck_HTTPCheckInfo) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -18928,7 +19093,7 @@ func (m *HealthCheck_TCPCheckInfo) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -19416,7 +19581,7 @@ func (m *CommandInfo_URI) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -19845,7 +20010,7 @@ func (m *ExecutorInfo) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -20096,13 +20261,13 @@ func (m *MasterInfo) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 	if hasFields[0]&uint64(0x00000002) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 	if hasFields[0]&uint64(0x00000004) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -20303,7 +20468,7 @@ func (m *AgentInfo) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -20511,7 +20676,7 @@ func (m *Value) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -20557,15 +20722,8 @@ func (m *Value_Scalar) Unmarshal(dAtA []byte) error {
 			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			v = uint64(dAtA[iNdEx-8])
-			v |= uint64(dAtA[iNdEx-7]) << 8
-			v |= uint64(dAtA[iNdEx-6]) << 16
-			v |= uint64(dAtA[iNdEx-5]) << 24
-			v |= uint64(dAtA[iNdEx-4]) << 32
-			v |= uint64(dAtA[iNdEx-3]) << 40
-			v |= uint64(dAtA[iNdEx-2]) << 48
-			v |= uint64(dAtA[iNdEx-1]) << 56
 			v2 := float64(math.Float64frombits(v))
 			m.Value = &v2
 			hasFields[0] |= uint64(0x00000001)
@@ -20586,7 +20744,7 @@ func (m *Value_Scalar) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -20683,10 +20841,10 @@ func (m *Value_Range) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 	if hasFields[0]&uint64(0x00000002) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -20934,7 +21092,7 @@ func (m *Value_Text) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -21173,10 +21331,10 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 	if hasFields[0]&uint64(0x00000002) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -21527,6 +21685,39 @@ func (m *Resource) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocationInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMesos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMesos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AllocationInfo == nil {
+				m.AllocationInfo = &Resource_AllocationInfo{}
+			}
+			if err := m.AllocationInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMesos(dAtA[iNdEx:])
@@ -21544,10 +21735,10 @@ func (m *Resource) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 	if hasFields[0]&uint64(0x00000002) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -21927,7 +22118,7 @@ func (m *Resource_DiskInfo_Persistence) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -22069,7 +22260,7 @@ func (m *Resource_DiskInfo_Source) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -22155,7 +22346,7 @@ func (m *Resource_DiskInfo_Source_Path) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -22241,7 +22432,7 @@ func (m *Resource_DiskInfo_Source_Mount) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -22351,6 +22542,87 @@ func (m *Resource_SharedInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Resource_AllocationInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMesos
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllocationInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllocationInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMesos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMesos
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Role = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMesos(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMesos
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TrafficControlStatistics) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
@@ -22609,7 +22881,7 @@ func (m *TrafficControlStatistics) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -24422,15 +24694,8 @@ func (m *ResourceStatistics) Unmarshal(dAtA []byte) error {
 			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			v = uint64(dAtA[iNdEx-8])
-			v |= uint64(dAtA[iNdEx-7]) << 8
-			v |= uint64(dAtA[iNdEx-6]) << 16
-			v |= uint64(dAtA[iNdEx-5]) << 24
-			v |= uint64(dAtA[iNdEx-4]) << 32
-			v |= uint64(dAtA[iNdEx-3]) << 40
-			v |= uint64(dAtA[iNdEx-2]) << 48
-			v |= uint64(dAtA[iNdEx-1]) << 56
 			v2 := float64(math.Float64frombits(v))
 			m.Timestamp = &v2
 			hasFields[0] |= uint64(0x00000001)
@@ -24442,15 +24707,8 @@ func (m *ResourceStatistics) Unmarshal(dAtA []byte) error {
 			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			v = uint64(dAtA[iNdEx-8])
-			v |= uint64(dAtA[iNdEx-7]) << 8
-			v |= uint64(dAtA[iNdEx-6]) << 16
-			v |= uint64(dAtA[iNdEx-5]) << 24
-			v |= uint64(dAtA[iNdEx-4]) << 32
-			v |= uint64(dAtA[iNdEx-3]) << 40
-			v |= uint64(dAtA[iNdEx-2]) << 48
-			v |= uint64(dAtA[iNdEx-1]) << 56
 			v2 := float64(math.Float64frombits(v))
 			m.CpusUserTimeSecs = &v2
 		case 3:
@@ -24461,15 +24719,8 @@ func (m *ResourceStatistics) Unmarshal(dAtA []byte) error {
 			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			v = uint64(dAtA[iNdEx-8])
-			v |= uint64(dAtA[iNdEx-7]) << 8
-			v |= uint64(dAtA[iNdEx-6]) << 16
-			v |= uint64(dAtA[iNdEx-5]) << 24
-			v |= uint64(dAtA[iNdEx-4]) << 32
-			v |= uint64(dAtA[iNdEx-3]) << 40
-			v |= uint64(dAtA[iNdEx-2]) << 48
-			v |= uint64(dAtA[iNdEx-1]) << 56
 			v2 := float64(math.Float64frombits(v))
 			m.CpusSystemTimeSecs = &v2
 		case 4:
@@ -24480,15 +24731,8 @@ func (m *ResourceStatistics) Unmarshal(dAtA []byte) error {
 			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			v = uint64(dAtA[iNdEx-8])
-			v |= uint64(dAtA[iNdEx-7]) << 8
-			v |= uint64(dAtA[iNdEx-6]) << 16
-			v |= uint64(dAtA[iNdEx-5]) << 24
-			v |= uint64(dAtA[iNdEx-4]) << 32
-			v |= uint64(dAtA[iNdEx-3]) << 40
-			v |= uint64(dAtA[iNdEx-2]) << 48
-			v |= uint64(dAtA[iNdEx-1]) << 56
 			v2 := float64(math.Float64frombits(v))
 			m.CpusLimit = &v2
 		case 5:
@@ -24579,15 +24823,8 @@ func (m *ResourceStatistics) Unmarshal(dAtA []byte) error {
 			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			v = uint64(dAtA[iNdEx-8])
-			v |= uint64(dAtA[iNdEx-7]) << 8
-			v |= uint64(dAtA[iNdEx-6]) << 16
-			v |= uint64(dAtA[iNdEx-5]) << 24
-			v |= uint64(dAtA[iNdEx-4]) << 32
-			v |= uint64(dAtA[iNdEx-3]) << 40
-			v |= uint64(dAtA[iNdEx-2]) << 48
-			v |= uint64(dAtA[iNdEx-1]) << 56
 			v2 := float64(math.Float64frombits(v))
 			m.CpusThrottledTimeSecs = &v2
 		case 10:
@@ -24851,15 +25088,8 @@ func (m *ResourceStatistics) Unmarshal(dAtA []byte) error {
 			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			v = uint64(dAtA[iNdEx-8])
-			v |= uint64(dAtA[iNdEx-7]) << 8
-			v |= uint64(dAtA[iNdEx-6]) << 16
-			v |= uint64(dAtA[iNdEx-5]) << 24
-			v |= uint64(dAtA[iNdEx-4]) << 32
-			v |= uint64(dAtA[iNdEx-3]) << 40
-			v |= uint64(dAtA[iNdEx-2]) << 48
-			v |= uint64(dAtA[iNdEx-1]) << 56
 			v2 := float64(math.Float64frombits(v))
 			m.NetTcpRttMicrosecsP50 = &v2
 		case 23:
@@ -24870,15 +25100,8 @@ func (m *ResourceStatistics) Unmarshal(dAtA []byte) error {
 			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			v = uint64(dAtA[iNdEx-8])
-			v |= uint64(dAtA[iNdEx-7]) << 8
-			v |= uint64(dAtA[iNdEx-6]) << 16
-			v |= uint64(dAtA[iNdEx-5]) << 24
-			v |= uint64(dAtA[iNdEx-4]) << 32
-			v |= uint64(dAtA[iNdEx-3]) << 40
-			v |= uint64(dAtA[iNdEx-2]) << 48
-			v |= uint64(dAtA[iNdEx-1]) << 56
 			v2 := float64(math.Float64frombits(v))
 			m.NetTcpRttMicrosecsP90 = &v2
 		case 24:
@@ -24889,15 +25112,8 @@ func (m *ResourceStatistics) Unmarshal(dAtA []byte) error {
 			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			v = uint64(dAtA[iNdEx-8])
-			v |= uint64(dAtA[iNdEx-7]) << 8
-			v |= uint64(dAtA[iNdEx-6]) << 16
-			v |= uint64(dAtA[iNdEx-5]) << 24
-			v |= uint64(dAtA[iNdEx-4]) << 32
-			v |= uint64(dAtA[iNdEx-3]) << 40
-			v |= uint64(dAtA[iNdEx-2]) << 48
-			v |= uint64(dAtA[iNdEx-1]) << 56
 			v2 := float64(math.Float64frombits(v))
 			m.NetTcpRttMicrosecsP95 = &v2
 		case 25:
@@ -24908,15 +25124,8 @@ func (m *ResourceStatistics) Unmarshal(dAtA []byte) error {
 			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			v = uint64(dAtA[iNdEx-8])
-			v |= uint64(dAtA[iNdEx-7]) << 8
-			v |= uint64(dAtA[iNdEx-6]) << 16
-			v |= uint64(dAtA[iNdEx-5]) << 24
-			v |= uint64(dAtA[iNdEx-4]) << 32
-			v |= uint64(dAtA[iNdEx-3]) << 40
-			v |= uint64(dAtA[iNdEx-2]) << 48
-			v |= uint64(dAtA[iNdEx-1]) << 56
 			v2 := float64(math.Float64frombits(v))
 			m.NetTcpRttMicrosecsP99 = &v2
 		case 26:
@@ -24967,15 +25176,8 @@ func (m *ResourceStatistics) Unmarshal(dAtA []byte) error {
 			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			v = uint64(dAtA[iNdEx-8])
-			v |= uint64(dAtA[iNdEx-7]) << 8
-			v |= uint64(dAtA[iNdEx-6]) << 16
-			v |= uint64(dAtA[iNdEx-5]) << 24
-			v |= uint64(dAtA[iNdEx-4]) << 32
-			v |= uint64(dAtA[iNdEx-3]) << 40
-			v |= uint64(dAtA[iNdEx-2]) << 48
-			v |= uint64(dAtA[iNdEx-1]) << 56
 			v2 := float64(math.Float64frombits(v))
 			m.NetTcpActiveConnections = &v2
 		case 29:
@@ -24986,15 +25188,8 @@ func (m *ResourceStatistics) Unmarshal(dAtA []byte) error {
 			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			v = uint64(dAtA[iNdEx-8])
-			v |= uint64(dAtA[iNdEx-7]) << 8
-			v |= uint64(dAtA[iNdEx-6]) << 16
-			v |= uint64(dAtA[iNdEx-5]) << 24
-			v |= uint64(dAtA[iNdEx-4]) << 32
-			v |= uint64(dAtA[iNdEx-3]) << 40
-			v |= uint64(dAtA[iNdEx-2]) << 48
-			v |= uint64(dAtA[iNdEx-1]) << 56
 			v2 := float64(math.Float64frombits(v))
 			m.NetTcpTimeWaitConnections = &v2
 		case 30:
@@ -25298,7 +25493,7 @@ func (m *ResourceStatistics) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -25629,10 +25824,10 @@ func (m *ResourceUsage_Executor) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 	if hasFields[0]&uint64(0x00000002) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -25816,10 +26011,10 @@ func (m *ResourceUsage_Executor_Task) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 	if hasFields[0]&uint64(0x00000002) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -25865,15 +26060,8 @@ func (m *PerfStatistics) Unmarshal(dAtA []byte) error {
 			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			v = uint64(dAtA[iNdEx-8])
-			v |= uint64(dAtA[iNdEx-7]) << 8
-			v |= uint64(dAtA[iNdEx-6]) << 16
-			v |= uint64(dAtA[iNdEx-5]) << 24
-			v |= uint64(dAtA[iNdEx-4]) << 32
-			v |= uint64(dAtA[iNdEx-3]) << 40
-			v |= uint64(dAtA[iNdEx-2]) << 48
-			v |= uint64(dAtA[iNdEx-1]) << 56
 			v2 := float64(math.Float64frombits(v))
 			m.Timestamp = &v2
 			hasFields[0] |= uint64(0x00000001)
@@ -25885,15 +26073,8 @@ func (m *PerfStatistics) Unmarshal(dAtA []byte) error {
 			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			v = uint64(dAtA[iNdEx-8])
-			v |= uint64(dAtA[iNdEx-7]) << 8
-			v |= uint64(dAtA[iNdEx-6]) << 16
-			v |= uint64(dAtA[iNdEx-5]) << 24
-			v |= uint64(dAtA[iNdEx-4]) << 32
-			v |= uint64(dAtA[iNdEx-3]) << 40
-			v |= uint64(dAtA[iNdEx-2]) << 48
-			v |= uint64(dAtA[iNdEx-1]) << 56
 			v2 := float64(math.Float64frombits(v))
 			m.Duration = &v2
 			hasFields[0] |= uint64(0x00000002)
@@ -26105,15 +26286,8 @@ func (m *PerfStatistics) Unmarshal(dAtA []byte) error {
 			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			v = uint64(dAtA[iNdEx-8])
-			v |= uint64(dAtA[iNdEx-7]) << 8
-			v |= uint64(dAtA[iNdEx-6]) << 16
-			v |= uint64(dAtA[iNdEx-5]) << 24
-			v |= uint64(dAtA[iNdEx-4]) << 32
-			v |= uint64(dAtA[iNdEx-3]) << 40
-			v |= uint64(dAtA[iNdEx-2]) << 48
-			v |= uint64(dAtA[iNdEx-1]) << 56
 			v2 := float64(math.Float64frombits(v))
 			m.CpuClock = &v2
 		case 14:
@@ -26124,15 +26298,8 @@ func (m *PerfStatistics) Unmarshal(dAtA []byte) error {
 			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			v = uint64(dAtA[iNdEx-8])
-			v |= uint64(dAtA[iNdEx-7]) << 8
-			v |= uint64(dAtA[iNdEx-6]) << 16
-			v |= uint64(dAtA[iNdEx-5]) << 24
-			v |= uint64(dAtA[iNdEx-4]) << 32
-			v |= uint64(dAtA[iNdEx-3]) << 40
-			v |= uint64(dAtA[iNdEx-2]) << 48
-			v |= uint64(dAtA[iNdEx-1]) << 56
 			v2 := float64(math.Float64frombits(v))
 			m.TaskClock = &v2
 		case 15:
@@ -26932,10 +27099,10 @@ func (m *PerfStatistics) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 	if hasFields[0]&uint64(0x00000002) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -27380,6 +27547,39 @@ func (m *Offer) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocationInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMesos
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMesos
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AllocationInfo == nil {
+				m.AllocationInfo = &Resource_AllocationInfo{}
+			}
+			if err := m.AllocationInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMesos(dAtA[iNdEx:])
@@ -27397,16 +27597,16 @@ func (m *Offer) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 	if hasFields[0]&uint64(0x00000002) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 	if hasFields[0]&uint64(0x00000004) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 	if hasFields[0]&uint64(0x00000008) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -27880,10 +28080,10 @@ func (m *Offer_Operation_LaunchGroup) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 	if hasFields[0]&uint64(0x00000002) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -28465,13 +28665,13 @@ func (m *InverseOffer) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 	if hasFields[0]&uint64(0x00000002) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 	if hasFields[0]&uint64(0x00000004) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -28918,13 +29118,13 @@ func (m *TaskInfo) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 	if hasFields[0]&uint64(0x00000002) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 	if hasFields[0]&uint64(0x00000004) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -29490,19 +29690,19 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 	if hasFields[0]&uint64(0x00000002) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 	if hasFields[0]&uint64(0x00000004) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 	if hasFields[0]&uint64(0x00000008) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 	if hasFields[0]&uint64(0x00000010) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -29697,15 +29897,8 @@ func (m *TaskStatus) Unmarshal(dAtA []byte) error {
 			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			v = uint64(dAtA[iNdEx-8])
-			v |= uint64(dAtA[iNdEx-7]) << 8
-			v |= uint64(dAtA[iNdEx-6]) << 16
-			v |= uint64(dAtA[iNdEx-5]) << 24
-			v |= uint64(dAtA[iNdEx-4]) << 32
-			v |= uint64(dAtA[iNdEx-3]) << 40
-			v |= uint64(dAtA[iNdEx-2]) << 48
-			v |= uint64(dAtA[iNdEx-1]) << 56
 			v2 := float64(math.Float64frombits(v))
 			m.Timestamp = &v2
 		case 7:
@@ -29949,10 +30142,10 @@ func (m *TaskStatus) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 	if hasFields[0]&uint64(0x00000002) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -29997,15 +30190,8 @@ func (m *Filters) Unmarshal(dAtA []byte) error {
 			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			v = uint64(dAtA[iNdEx-8])
-			v |= uint64(dAtA[iNdEx-7]) << 8
-			v |= uint64(dAtA[iNdEx-6]) << 16
-			v |= uint64(dAtA[iNdEx-5]) << 24
-			v |= uint64(dAtA[iNdEx-4]) << 32
-			v |= uint64(dAtA[iNdEx-3]) << 40
-			v |= uint64(dAtA[iNdEx-2]) << 48
-			v |= uint64(dAtA[iNdEx-1]) << 56
 			v2 := float64(math.Float64frombits(v))
 			m.RefuseSeconds = &v2
 		default:
@@ -30221,10 +30407,10 @@ func (m *Environment_Variable) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 	if hasFields[0]&uint64(0x00000002) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -30341,10 +30527,10 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 	if hasFields[0]&uint64(0x00000002) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -30542,7 +30728,7 @@ func (m *Credential) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -30670,15 +30856,8 @@ func (m *RateLimit) Unmarshal(dAtA []byte) error {
 			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			v = uint64(dAtA[iNdEx-8])
-			v |= uint64(dAtA[iNdEx-7]) << 8
-			v |= uint64(dAtA[iNdEx-6]) << 16
-			v |= uint64(dAtA[iNdEx-5]) << 24
-			v |= uint64(dAtA[iNdEx-4]) << 32
-			v |= uint64(dAtA[iNdEx-3]) << 40
-			v |= uint64(dAtA[iNdEx-2]) << 48
-			v |= uint64(dAtA[iNdEx-1]) << 56
 			v2 := float64(math.Float64frombits(v))
 			m.Qps = &v2
 		case 2:
@@ -30749,7 +30928,7 @@ func (m *RateLimit) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -30825,15 +31004,8 @@ func (m *RateLimits) Unmarshal(dAtA []byte) error {
 			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			v = uint64(dAtA[iNdEx-8])
-			v |= uint64(dAtA[iNdEx-7]) << 8
-			v |= uint64(dAtA[iNdEx-6]) << 16
-			v |= uint64(dAtA[iNdEx-5]) << 24
-			v |= uint64(dAtA[iNdEx-4]) << 32
-			v |= uint64(dAtA[iNdEx-3]) << 40
-			v |= uint64(dAtA[iNdEx-2]) << 48
-			v |= uint64(dAtA[iNdEx-1]) << 56
 			v2 := float64(math.Float64frombits(v))
 			m.AggregateDefaultQps = &v2
 		case 3:
@@ -31033,7 +31205,7 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -31182,7 +31354,7 @@ func (m *Image_Appc) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -31301,7 +31473,7 @@ func (m *Image_Docker) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -31504,10 +31676,10 @@ func (m *Volume) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 	if hasFields[0]&uint64(0x00000002) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -31793,7 +31965,7 @@ func (m *Volume_Source_DockerVolume) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -31899,7 +32071,7 @@ func (m *Volume_Source_SandboxPath) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -32332,10 +32504,10 @@ func (m *NetworkInfo_PortMapping) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 	if hasFields[0]&uint64(0x00000002) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -32906,10 +33078,10 @@ func (m *TTYInfo_WindowSize) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 	if hasFields[0]&uint64(0x00000002) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -33242,7 +33414,7 @@ func (m *ContainerInfo) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -33482,7 +33654,7 @@ func (m *ContainerInfo_DockerInfo) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -33609,10 +33781,10 @@ func (m *ContainerInfo_DockerInfo_PortMapping) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 	if hasFields[0]&uint64(0x00000002) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -34217,7 +34389,7 @@ func (m *Label) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -34406,7 +34578,7 @@ func (m *Port) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -34750,7 +34922,7 @@ func (m *DiscoveryInfo) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -34796,15 +34968,8 @@ func (m *WeightInfo) Unmarshal(dAtA []byte) error {
 			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			v = uint64(dAtA[iNdEx-8])
-			v |= uint64(dAtA[iNdEx-7]) << 8
-			v |= uint64(dAtA[iNdEx-6]) << 16
-			v |= uint64(dAtA[iNdEx-5]) << 24
-			v |= uint64(dAtA[iNdEx-4]) << 32
-			v |= uint64(dAtA[iNdEx-3]) << 40
-			v |= uint64(dAtA[iNdEx-2]) << 48
-			v |= uint64(dAtA[iNdEx-1]) << 56
 			v2 := float64(math.Float64frombits(v))
 			m.Weight = &v2
 			hasFields[0] |= uint64(0x00000001)
@@ -34855,7 +35020,7 @@ func (m *WeightInfo) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -34962,15 +35127,8 @@ func (m *VersionInfo) Unmarshal(dAtA []byte) error {
 			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			v = uint64(dAtA[iNdEx-8])
-			v |= uint64(dAtA[iNdEx-7]) << 8
-			v |= uint64(dAtA[iNdEx-6]) << 16
-			v |= uint64(dAtA[iNdEx-5]) << 24
-			v |= uint64(dAtA[iNdEx-4]) << 32
-			v |= uint64(dAtA[iNdEx-3]) << 40
-			v |= uint64(dAtA[iNdEx-2]) << 48
-			v |= uint64(dAtA[iNdEx-1]) << 56
 			v2 := float64(math.Float64frombits(v))
 			m.BuildTime = &v2
 		case 4:
@@ -35110,7 +35268,7 @@ func (m *VersionInfo) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -35226,7 +35384,7 @@ func (m *Flag) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -35303,15 +35461,8 @@ func (m *Role) Unmarshal(dAtA []byte) error {
 			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			v = uint64(dAtA[iNdEx-8])
-			v |= uint64(dAtA[iNdEx-7]) << 8
-			v |= uint64(dAtA[iNdEx-6]) << 16
-			v |= uint64(dAtA[iNdEx-5]) << 24
-			v |= uint64(dAtA[iNdEx-4]) << 32
-			v |= uint64(dAtA[iNdEx-3]) << 40
-			v |= uint64(dAtA[iNdEx-2]) << 48
-			v |= uint64(dAtA[iNdEx-1]) << 56
 			v2 := float64(math.Float64frombits(v))
 			m.Weight = &v2
 			hasFields[0] |= uint64(0x00000002)
@@ -35394,10 +35545,10 @@ func (m *Role) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 	if hasFields[0]&uint64(0x00000002) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -35474,15 +35625,8 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			v = uint64(dAtA[iNdEx-8])
-			v |= uint64(dAtA[iNdEx-7]) << 8
-			v |= uint64(dAtA[iNdEx-6]) << 16
-			v |= uint64(dAtA[iNdEx-5]) << 24
-			v |= uint64(dAtA[iNdEx-4]) << 32
-			v |= uint64(dAtA[iNdEx-3]) << 40
-			v |= uint64(dAtA[iNdEx-2]) << 48
-			v |= uint64(dAtA[iNdEx-1]) << 56
 			v2 := float64(math.Float64frombits(v))
 			m.Value = &v2
 		default:
@@ -35502,7 +35646,7 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -35741,7 +35885,7 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -35857,545 +36001,545 @@ var (
 func init() { proto.RegisterFile("mesos.proto", fileDescriptorMesos) }
 
 var fileDescriptorMesos = []byte{
-	// 8628 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x7c, 0x49, 0x8c, 0x1b, 0x49,
-	0x97, 0x5e, 0x73, 0x27, 0x1f, 0xc9, 0xaa, 0x54, 0x68, 0x69, 0x8a, 0x92, 0x4a, 0xea, 0xfc, 0x25,
-	0xb5, 0x5a, 0xdd, 0x5d, 0x2d, 0x95, 0xa4, 0x5e, 0xd4, 0xb3, 0x51, 0x64, 0x56, 0x89, 0x50, 0x71,
-	0xf9, 0x83, 0xa4, 0xd4, 0xfd, 0xc3, 0x00, 0x91, 0x4a, 0x46, 0x55, 0xa5, 0x95, 0xcc, 0x64, 0x67,
-	0x26, 0x4b, 0xd2, 0x1c, 0x0c, 0xff, 0x33, 0x63, 0xcf, 0xd8, 0x9e, 0xdf, 0x03, 0x18, 0xb0, 0x61,
-	0x60, 0x6c, 0xf8, 0x38, 0xbe, 0xfa, 0xe2, 0xe5, 0xe2, 0x81, 0x97, 0x83, 0x01, 0x03, 0x86, 0x7d,
-	0x30, 0x30, 0x30, 0x7e, 0xc0, 0xc6, 0x6f, 0x1f, 0xc6, 0x33, 0xde, 0xc6, 0xfb, 0x32, 0x1e, 0x1b,
-	0xb1, 0x66, 0x24, 0x6b, 0x91, 0x34, 0x07, 0xc3, 0x27, 0x32, 0xde, 0xfb, 0x5e, 0xc4, 0x8b, 0x17,
-	0x2f, 0x22, 0x5e, 0x44, 0x46, 0x04, 0x54, 0xe7, 0x24, 0x0a, 0xa2, 0xcd, 0x45, 0x18, 0xc4, 0x01,
-	0x2a, 0xb0, 0x84, 0xf9, 0x3d, 0xa8, 0x6e, 0x87, 0xf6, 0x9c, 0xbc, 0x0c, 0xc2, 0x17, 0xdd, 0x0e,
-	0x3a, 0x07, 0x85, 0x43, 0xdb, 0x5b, 0x92, 0x46, 0xe6, 0x5a, 0xf6, 0x56, 0x05, 0xf3, 0x84, 0x79,
-	0x15, 0x4a, 0x83, 0xbd, 0x3d, 0x12, 0x9e, 0x06, 0x68, 0xed, 0x13, 0x3f, 0x3e, 0x11, 0xb0, 0x01,
-	0xc5, 0xb1, 0x1d, 0x9d, 0x5c, 0x82, 0x09, 0x60, 0xbd, 0x22, 0xce, 0x32, 0x0e, 0x4e, 0x2e, 0x64,
-	0x00, 0xd5, 0x76, 0xe0, 0xc7, 0xb6, 0xeb, 0x9f, 0xac, 0x09, 0xba, 0x0d, 0xc5, 0x85, 0x1d, 0x12,
-	0x3f, 0x6e, 0x64, 0xaf, 0x65, 0x6e, 0x55, 0xb7, 0xd0, 0x26, 0xaf, 0xb4, 0x26, 0x89, 0x05, 0xc2,
-	0xfc, 0x04, 0xca, 0x63, 0x77, 0x4e, 0xba, 0xfe, 0x5e, 0x80, 0xae, 0x41, 0xd5, 0xb7, 0xfd, 0x20,
-	0x22, 0x4e, 0xe0, 0xcf, 0x22, 0x96, 0x67, 0x0e, 0xeb, 0x24, 0xf3, 0x0e, 0xd4, 0x3a, 0xcb, 0xd0,
-	0x8e, 0xdd, 0xc0, 0x7f, 0x4b, 0x89, 0x2e, 0x94, 0x5a, 0xb3, 0x59, 0x48, 0xa2, 0x08, 0x35, 0xa1,
-	0x7c, 0x10, 0x44, 0xb1, 0x6f, 0xcf, 0xa9, 0xbe, 0x99, 0x5b, 0x15, 0xac, 0xd2, 0x68, 0x0d, 0xb2,
-	0xee, 0x82, 0xa9, 0x5b, 0xc1, 0x59, 0x77, 0x81, 0x10, 0xe4, 0x17, 0x41, 0x18, 0x37, 0x72, 0xd7,
-	0xb2, 0xb7, 0x0a, 0x98, 0xfd, 0x37, 0xff, 0x4a, 0x06, 0x72, 0x13, 0xbc, 0x8b, 0x2e, 0x40, 0x31,
-	0x72, 0x0e, 0xc8, 0x5c, 0xd6, 0x5a, 0xa4, 0xd0, 0x2d, 0x28, 0xd9, 0xbc, 0xa8, 0x46, 0xf6, 0x5a,
-	0xf6, 0x56, 0x75, 0x6b, 0x4d, 0xd4, 0x5b, 0x28, 0x80, 0x25, 0x9b, 0xe5, 0x6e, 0xc7, 0x07, 0x8d,
-	0x1c, 0x2b, 0x8f, 0xfd, 0x47, 0x37, 0xa1, 0xf0, 0xdd, 0x92, 0x84, 0xaf, 0x1b, 0xf9, 0x6b, 0xb9,
-	0x5b, 0xd5, 0x2d, 0x43, 0xc8, 0x0e, 0x6d, 0xea, 0x19, 0x31, 0x09, 0x31, 0x67, 0xd3, 0x5a, 0xec,
-	0x85, 0xf6, 0xfe, 0x9c, 0x9a, 0xb7, 0xc0, 0x6b, 0x21, 0xd3, 0xe6, 0x01, 0xac, 0x4d, 0x7c, 0xfb,
-	0xd0, 0x76, 0x3d, 0xfb, 0xb9, 0xeb, 0xb9, 0xf1, 0x6b, 0x74, 0x03, 0x0a, 0x51, 0x6c, 0x87, 0x31,
-	0x53, 0xb5, 0xba, 0xb5, 0x2e, 0x72, 0x95, 0x26, 0xc7, 0x9c, 0x8b, 0x3e, 0x83, 0xf2, 0x4c, 0xd8,
-	0x55, 0xb4, 0xd9, 0x59, 0x81, 0xd4, 0xcd, 0x8d, 0x15, 0xc8, 0xfc, 0x02, 0x2a, 0x3d, 0xdb, 0x39,
-	0x70, 0x7d, 0xd2, 0xed, 0xbc, 0x8b, 0x61, 0xcd, 0xbf, 0x97, 0x81, 0xaa, 0x94, 0xe4, 0x2d, 0x98,
-	0x75, 0x67, 0x42, 0x3b, 0x59, 0x67, 0x95, 0x33, 0xce, 0xba, 0x33, 0xf4, 0x31, 0xe4, 0xe7, 0xc1,
-	0x8c, 0xb0, 0x3c, 0xd6, 0xb6, 0xde, 0x5f, 0xc1, 0xf8, 0x7b, 0xc1, 0x66, 0x2f, 0x98, 0x11, 0xcc,
-	0x40, 0xe8, 0xa7, 0x61, 0x6d, 0x99, 0xb2, 0x00, 0xb3, 0x71, 0x75, 0xeb, 0xbc, 0x10, 0x4b, 0x9b,
-	0x07, 0xaf, 0x80, 0xcd, 0x9b, 0x90, 0xa7, 0x99, 0xa1, 0x22, 0x64, 0x27, 0x43, 0x23, 0x83, 0x6a,
-	0x50, 0xee, 0xe0, 0x56, 0xb7, 0xdf, 0xed, 0xef, 0x18, 0x59, 0x54, 0x86, 0x7c, 0x67, 0xf0, 0xac,
-	0x6f, 0xe4, 0xcc, 0xdf, 0xca, 0x43, 0x3d, 0xe9, 0xb2, 0xb4, 0x1e, 0x08, 0xf2, 0xcb, 0x88, 0x84,
-	0xc2, 0x25, 0xd8, 0x7f, 0x4a, 0x63, 0x36, 0xc9, 0x72, 0x1a, 0xb3, 0x87, 0xc9, 0xea, 0x9b, 0x4b,
-	0xf5, 0x0b, 0xad, 0xf3, 0xb3, 0x1a, 0x7f, 0x02, 0xc6, 0x9e, 0xed, 0x7a, 0xc1, 0x21, 0x09, 0xa7,
-	0xb1, 0x3b, 0x27, 0xc1, 0x32, 0x6e, 0xe4, 0xaf, 0x65, 0x6e, 0x65, 0x1e, 0x66, 0xee, 0xe0, 0x75,
-	0xc9, 0x1a, 0x73, 0x0e, 0xba, 0x01, 0xe0, 0x1c, 0x10, 0xe7, 0xc5, 0x22, 0x70, 0x85, 0x4b, 0x94,
-	0x1f, 0x16, 0xf6, 0x6c, 0x2f, 0x22, 0x58, 0x63, 0xa0, 0xf3, 0x90, 0x0f, 0x03, 0x8f, 0x34, 0x8a,
-	0xb4, 0x29, 0x1e, 0x66, 0x6e, 0x63, 0x96, 0x4c, 0xb5, 0x5d, 0x69, 0xa5, 0xed, 0x2e, 0x43, 0x65,
-	0x11, 0xba, 0xbe, 0xe3, 0x2e, 0x6c, 0xaf, 0x51, 0x66, 0xcc, 0x84, 0x80, 0x2e, 0x41, 0xe5, 0x25,
-	0x79, 0xbe, 0x74, 0xa7, 0xcb, 0xd0, 0x6b, 0x54, 0xb8, 0x28, 0x23, 0x4c, 0x42, 0x0f, 0xb5, 0xa1,
-	0xe6, 0xd8, 0x0b, 0x6e, 0x56, 0x97, 0x44, 0x0d, 0x60, 0x4e, 0x7d, 0xf5, 0x48, 0x85, 0x69, 0xf3,
-	0xb5, 0x25, 0xf0, 0x35, 0x4e, 0x09, 0xa1, 0x1b, 0x50, 0xf4, 0xec, 0xe7, 0xc4, 0x8b, 0x1a, 0x55,
-	0x66, 0xaf, 0xba, 0x10, 0xdf, 0x65, 0x44, 0x2c, 0x98, 0xcd, 0x7f, 0x98, 0x01, 0x48, 0xf2, 0x40,
-	0x5f, 0x42, 0x3e, 0x7e, 0xbd, 0xe0, 0x9e, 0xb8, 0xb6, 0x75, 0xfd, 0x0d, 0x45, 0x6e, 0x8e, 0x5f,
-	0x2f, 0x08, 0x66, 0x12, 0xe6, 0x2f, 0x64, 0x20, 0x4f, 0x93, 0xa8, 0x0a, 0xa5, 0x49, 0xff, 0x49,
-	0x9f, 0xb6, 0xf5, 0x7b, 0xe8, 0x7d, 0x38, 0x8b, 0xad, 0xa7, 0x83, 0x76, 0xeb, 0xd1, 0xae, 0x35,
-	0xc5, 0xd6, 0x68, 0x30, 0xc1, 0x6d, 0x6b, 0x64, 0x64, 0xd0, 0x05, 0x40, 0xe3, 0xd6, 0xe8, 0xc9,
-	0xf4, 0x49, 0x77, 0x77, 0xb7, 0xdb, 0xdf, 0x99, 0x8e, 0xc6, 0xad, 0xb1, 0x65, 0x64, 0xd1, 0x19,
-	0xa8, 0xef, 0x0c, 0x27, 0x1a, 0x34, 0x87, 0xce, 0x81, 0x31, 0x7a, 0xdc, 0xc2, 0x56, 0x47, 0xa3,
-	0xe6, 0xd1, 0x59, 0x58, 0x1f, 0xb6, 0xf0, 0xb8, 0x3b, 0xee, 0x0e, 0xfa, 0xd3, 0xd6, 0xb3, 0x16,
-	0xb6, 0x8c, 0x82, 0xf9, 0x2b, 0x05, 0xa8, 0x3e, 0x26, 0xb6, 0x17, 0x1f, 0xb4, 0x69, 0xe3, 0xa1,
-	0xfb, 0x90, 0x3f, 0x88, 0xe3, 0x05, 0xab, 0x4e, 0x75, 0xeb, 0x9a, 0xa8, 0x8e, 0x86, 0xd8, 0x7c,
-	0x3c, 0x1e, 0x0f, 0xd9, 0x3f, 0xd6, 0x47, 0x19, 0x1a, 0x7d, 0x08, 0xf5, 0x19, 0xf1, 0xec, 0xd7,
-	0x53, 0x39, 0x34, 0x66, 0x99, 0xff, 0x64, 0xef, 0x3e, 0xc0, 0x35, 0xc6, 0x18, 0x71, 0x3a, 0xfa,
-	0x14, 0x0c, 0xd7, 0x8f, 0x49, 0x78, 0x68, 0x7b, 0x0a, 0x9b, 0x13, 0xd8, 0x3b, 0x78, 0x5d, 0xf2,
-	0x24, 0xfc, 0x63, 0x58, 0x17, 0x1e, 0xa9, 0xd0, 0xdc, 0x33, 0xb3, 0x5b, 0x77, 0xf0, 0x9a, 0x60,
-	0x49, 0xf0, 0x7d, 0x38, 0xe7, 0x04, 0x7e, 0x44, 0xa7, 0x14, 0xf7, 0x90, 0x4c, 0xa9, 0xe3, 0x2e,
-	0x43, 0x12, 0x31, 0x1f, 0xad, 0x3f, 0xcc, 0xdc, 0xc3, 0x67, 0x35, 0xf6, 0xb6, 0xe0, 0x52, 0xa9,
-	0xfd, 0xd0, 0x76, 0xc8, 0x74, 0x41, 0x42, 0x37, 0x98, 0xa9, 0x72, 0x8a, 0x4a, 0x2b, 0xc4, 0xf8,
-	0x43, 0xc6, 0x96, 0x65, 0x7d, 0x02, 0x25, 0x27, 0x98, 0xcf, 0x6d, 0x7f, 0xc6, 0xdc, 0x58, 0x9f,
-	0x74, 0x18, 0x95, 0xd9, 0x46, 0x42, 0xe8, 0x98, 0xc2, 0x7c, 0xa4, 0x9c, 0x1a, 0x53, 0x74, 0xa3,
-	0x26, 0x6e, 0x81, 0xee, 0x42, 0x2e, 0x76, 0x16, 0xcc, 0xc5, 0x13, 0x17, 0x4e, 0x61, 0xdb, 0x9a,
-	0xfd, 0x29, 0xb6, 0xf9, 0x02, 0xea, 0xa9, 0x56, 0x51, 0xf3, 0x09, 0x1d, 0x1e, 0xea, 0x7c, 0x3e,
-	0x51, 0xb3, 0x40, 0x56, 0x9b, 0x05, 0x92, 0xb9, 0x85, 0xcf, 0x0d, 0x72, 0x6e, 0x69, 0x42, 0x39,
-	0x8a, 0xed, 0x78, 0x19, 0x91, 0x88, 0x4d, 0x10, 0x75, 0xac, 0xd2, 0x4d, 0x13, 0x6a, 0xba, 0x06,
-	0xc7, 0x95, 0x65, 0xde, 0x3b, 0xce, 0xb3, 0xab, 0x50, 0x6a, 0x0f, 0x7a, 0xbd, 0x56, 0xbf, 0x63,
-	0x64, 0xe8, 0xe0, 0x46, 0x55, 0x36, 0xb2, 0xa8, 0x04, 0xb9, 0x71, 0x7b, 0x68, 0xe4, 0xcc, 0x0e,
-	0xc0, 0x13, 0xd7, 0xf3, 0x86, 0x81, 0xe7, 0x3a, 0xaf, 0xd1, 0xe7, 0x50, 0xd3, 0xdb, 0x45, 0x38,
-	0xe4, 0xb1, 0xf3, 0x44, 0x55, 0x6b, 0x20, 0xf3, 0xb7, 0xb3, 0x34, 0x66, 0x50, 0x8d, 0x80, 0x6e,
-	0x43, 0x7e, 0x19, 0xba, 0x74, 0xb2, 0xa6, 0x43, 0xc2, 0x85, 0xa3, 0xcd, 0xb4, 0x39, 0xc1, 0x5d,
-	0xcc, 0x30, 0xe8, 0x3e, 0x54, 0x89, 0x7f, 0xe8, 0x86, 0x81, 0x3f, 0x3f, 0x1a, 0x4e, 0x58, 0x09,
-	0x07, 0xeb, 0xb0, 0x24, 0x2a, 0xe1, 0x36, 0x14, 0x51, 0x89, 0x1c, 0xa1, 0xf9, 0xa4, 0xc9, 0x47,
-	0xe8, 0x26, 0x14, 0xa2, 0x03, 0xe2, 0x79, 0xcc, 0xb9, 0xca, 0x0f, 0xf3, 0x71, 0xb8, 0x24, 0x98,
-	0x93, 0xe8, 0xe8, 0x67, 0x87, 0xfb, 0x4b, 0x9a, 0x63, 0xd4, 0x28, 0x5d, 0xcb, 0xd1, 0xd1, 0x4f,
-	0x11, 0x9a, 0x7f, 0x8e, 0x05, 0x03, 0xdd, 0x13, 0x22, 0xa0, 0x0d, 0x00, 0xc2, 0x42, 0x29, 0xfb,
-	0xb9, 0xc7, 0x67, 0xae, 0x32, 0xd6, 0x28, 0x68, 0x03, 0x4a, 0xe4, 0x55, 0x1c, 0xda, 0x4e, 0xcc,
-	0x74, 0x94, 0x25, 0x4b, 0x22, 0xcd, 0xd5, 0xb1, 0x9d, 0x03, 0xc2, 0x3a, 0x57, 0x19, 0xf3, 0x04,
-	0xba, 0x0a, 0xd5, 0x60, 0x19, 0x2f, 0x96, 0xf1, 0x74, 0xcf, 0xf5, 0x88, 0xa8, 0x08, 0x70, 0xd2,
-	0xb6, 0xeb, 0x11, 0xf3, 0x1f, 0xe5, 0xa1, 0xa6, 0x42, 0x38, 0x6a, 0xeb, 0x2d, 0xa8, 0x12, 0x91,
-	0x9e, 0xaa, 0x69, 0xf6, 0x8c, 0xb4, 0x9f, 0x0a, 0xf6, 0xa4, 0x6e, 0x41, 0xd8, 0x9d, 0x51, 0x3b,
-	0xcd, 0xec, 0xd8, 0x66, 0x45, 0xd7, 0x30, 0xfb, 0x8f, 0x3e, 0x85, 0x4a, 0x48, 0xa2, 0x60, 0x19,
-	0x3a, 0xac, 0xfb, 0xe6, 0xb4, 0x50, 0x02, 0x0b, 0x3a, 0x4e, 0x10, 0xef, 0xd8, 0x19, 0x1f, 0x40,
-	0x6d, 0x4f, 0x8e, 0xce, 0x54, 0xcb, 0xf2, 0x89, 0x93, 0x63, 0x55, 0xe1, 0xb8, 0x9e, 0x6c, 0xd6,
-	0xe2, 0x53, 0x0f, 0x9f, 0x5d, 0x9b, 0x50, 0xe4, 0x3a, 0x34, 0x80, 0x52, 0x1f, 0x65, 0x1b, 0x19,
-	0x2c, 0x28, 0x68, 0x0b, 0x2a, 0x8e, 0x0c, 0x40, 0xc5, 0x84, 0x72, 0xee, 0x48, 0x60, 0x4a, 0x15,
-	0x4b, 0x60, 0x54, 0x66, 0xe6, 0x46, 0x0e, 0x9d, 0x6e, 0x5f, 0x37, 0x6a, 0x29, 0x99, 0x8e, 0xa4,
-	0x73, 0x19, 0x05, 0x43, 0x3b, 0x70, 0x3e, 0x3a, 0x58, 0xc6, 0xb3, 0xe0, 0xa5, 0x3f, 0x4d, 0x75,
-	0x98, 0xfa, 0xc9, 0x1d, 0xe6, 0xac, 0x94, 0xd8, 0x49, 0x3a, 0x8e, 0x36, 0xfd, 0xad, 0x9d, 0x32,
-	0xfd, 0xa1, 0x4f, 0xc4, 0x58, 0xb6, 0xce, 0xc6, 0xb2, 0xc6, 0x6a, 0xe3, 0xd2, 0x0e, 0xa5, 0xcd,
-	0x71, 0x9f, 0x9c, 0x30, 0x10, 0x74, 0xac, 0xed, 0xd6, 0x64, 0x77, 0x6c, 0x64, 0x10, 0x40, 0xb1,
-	0x3d, 0x19, 0x8d, 0x07, 0x3d, 0x23, 0x6b, 0xfe, 0xcd, 0x0c, 0x40, 0xcf, 0x8e, 0x62, 0x6e, 0x19,
-	0x16, 0xcc, 0xcd, 0x84, 0xa7, 0xd3, 0x40, 0x45, 0x06, 0x77, 0x74, 0x9c, 0xa1, 0x51, 0x73, 0x43,
-	0x8b, 0x9a, 0xeb, 0x0f, 0xf3, 0x0f, 0xee, 0x3c, 0xb8, 0x23, 0xc6, 0x3a, 0x03, 0x72, 0x0b, 0x77,
-	0xc6, 0x7c, 0xaa, 0x82, 0xe9, 0xdf, 0x54, 0xe0, 0x51, 0x58, 0x09, 0x3c, 0x1a, 0x50, 0x3a, 0x24,
-	0x61, 0x44, 0xa3, 0x51, 0x16, 0xae, 0x60, 0x99, 0xd4, 0x63, 0x6c, 0xee, 0x59, 0x27, 0xc5, 0xd8,
-	0xe6, 0xdf, 0xcd, 0x40, 0x85, 0xaf, 0x87, 0xa8, 0xe6, 0xe9, 0x10, 0x35, 0x9b, 0x2a, 0x2d, 0xe5,
-	0xdc, 0xb9, 0x37, 0x3a, 0xf7, 0x1d, 0x00, 0x3b, 0x8e, 0x43, 0xf7, 0xf9, 0x32, 0x56, 0x9d, 0x41,
-	0x46, 0xae, 0x2d, 0xc9, 0xc0, 0x1a, 0x06, 0x6d, 0x30, 0xb3, 0x15, 0xd3, 0xfa, 0xf2, 0xa5, 0x1a,
-	0x33, 0xa3, 0x34, 0x1b, 0x75, 0xfc, 0x02, 0x33, 0xdb, 0x5d, 0x31, 0x6c, 0xff, 0xfd, 0x1c, 0x14,
-	0x9e, 0xb2, 0x11, 0xe5, 0x86, 0x8a, 0x6a, 0xb2, 0xb7, 0xd6, 0x54, 0x17, 0x66, 0x3c, 0x7d, 0xae,
-	0xfa, 0x98, 0xce, 0x1f, 0xb6, 0x67, 0x87, 0x2b, 0x61, 0x3c, 0x07, 0x8e, 0x18, 0x0b, 0x0b, 0x08,
-	0x05, 0x87, 0xb6, 0xbf, 0x4f, 0x22, 0x11, 0x8f, 0xa6, 0xc1, 0x98, 0xb1, 0xb0, 0x80, 0x20, 0x13,
-	0x72, 0x11, 0xe1, 0x71, 0x68, 0x52, 0x5f, 0x91, 0x2d, 0x89, 0x31, 0x65, 0x32, 0x25, 0xc9, 0x2b,
-	0x1e, 0x84, 0x56, 0x57, 0x95, 0x24, 0xaf, 0x62, 0xcc, 0xd8, 0xcd, 0x0d, 0x28, 0x72, 0x4d, 0xd2,
-	0xa3, 0x67, 0x46, 0x8c, 0x9e, 0xcd, 0xcf, 0xa0, 0xc0, 0x0a, 0xa7, 0xec, 0xe7, 0x64, 0xdf, 0xf5,
-	0x19, 0x3b, 0x8f, 0x79, 0x82, 0xfa, 0x12, 0xf1, 0x67, 0xcc, 0xed, 0xf2, 0x98, 0xfe, 0x6d, 0x6e,
-	0x41, 0x91, 0x6b, 0x8b, 0x6e, 0x41, 0x81, 0xe9, 0x2b, 0x66, 0x17, 0x74, 0xb4, 0x46, 0x98, 0x03,
-	0x9a, 0x17, 0x21, 0x37, 0x22, 0x6c, 0x12, 0x76, 0x63, 0x32, 0x67, 0xf8, 0x0a, 0x66, 0xff, 0x9b,
-	0x97, 0x21, 0x4f, 0xb5, 0x3d, 0x61, 0x09, 0x7c, 0x57, 0xf4, 0x20, 0x80, 0xe2, 0xa8, 0xdd, 0xda,
-	0x6d, 0x61, 0xe3, 0x3d, 0xfa, 0x1f, 0xb7, 0xfa, 0x3b, 0x2c, 0x2c, 0x2c, 0x41, 0x6e, 0x64, 0x8d,
-	0xf9, 0x72, 0x61, 0x6c, 0x7d, 0x33, 0x36, 0x72, 0xe6, 0xef, 0x52, 0x5f, 0x94, 0xfe, 0xa0, 0x06,
-	0xae, 0x8c, 0xb6, 0x2c, 0x90, 0xcd, 0x9b, 0x7d, 0xdb, 0xe6, 0xcd, 0xbd, 0x4b, 0xf3, 0xe6, 0xdf,
-	0xdc, 0xbc, 0x6f, 0xd7, 0x74, 0xd2, 0x0b, 0x8a, 0xa7, 0x78, 0x81, 0xf9, 0x37, 0xca, 0x50, 0x96,
-	0x1d, 0xe7, 0xff, 0xcf, 0xca, 0x8a, 0x5a, 0x14, 0x4e, 0xf3, 0xe5, 0x13, 0xd6, 0x4b, 0x9f, 0x40,
-	0x7e, 0xe6, 0x46, 0x2f, 0xc4, 0xe8, 0xd3, 0x58, 0x19, 0x27, 0xe8, 0xac, 0x20, 0xc2, 0x70, 0x8a,
-	0x42, 0x2d, 0xa8, 0x86, 0x24, 0xa2, 0x11, 0x34, 0x5b, 0x5a, 0x97, 0x53, 0x21, 0xa4, 0x12, 0xc2,
-	0x09, 0x84, 0x87, 0x4f, 0x9a, 0x0c, 0xfa, 0x9a, 0x8e, 0x4e, 0x87, 0x81, 0xc3, 0x22, 0x09, 0x1e,
-	0x83, 0x5e, 0x39, 0x9a, 0x81, 0x00, 0xf0, 0xb9, 0x48, 0xe1, 0xd1, 0x16, 0x14, 0xa3, 0x03, 0x3b,
-	0x24, 0x33, 0x36, 0x1f, 0x56, 0xb7, 0x9a, 0xab, 0x92, 0x23, 0xc6, 0x65, 0x62, 0x02, 0xd9, 0x7c,
-	0x0a, 0xeb, 0x2b, 0x0a, 0xa5, 0x17, 0x82, 0x99, 0xd5, 0x85, 0x60, 0x32, 0x4f, 0x65, 0x4f, 0x5b,
-	0xa6, 0xfd, 0x30, 0x0f, 0x65, 0x69, 0x1e, 0xb4, 0x0d, 0xd5, 0x05, 0x1d, 0xd2, 0xa3, 0x98, 0xf8,
-	0x0e, 0x11, 0xb1, 0xe4, 0xf5, 0x93, 0xac, 0xb9, 0x39, 0x4c, 0xb0, 0x58, 0x17, 0xa4, 0x65, 0x1f,
-	0x06, 0xde, 0x72, 0x4e, 0x56, 0xca, 0x7e, 0xca, 0x88, 0x58, 0x30, 0xd1, 0x17, 0x2a, 0x2e, 0xc8,
-	0x1d, 0xdf, 0x04, 0xaa, 0xa4, 0x11, 0x1f, 0xef, 0x05, 0xbc, 0xf9, 0x35, 0x54, 0xb5, 0xb2, 0x8f,
-	0x4c, 0x80, 0x29, 0xc3, 0x64, 0x57, 0x0c, 0xd3, 0xfc, 0xb5, 0x2c, 0x14, 0x79, 0x7e, 0xda, 0xa2,
-	0x34, 0xab, 0x2d, 0x4a, 0x4f, 0x2a, 0x5e, 0xef, 0x05, 0x5f, 0x6a, 0xab, 0x84, 0xea, 0x9b, 0x25,
-	0x87, 0x76, 0x7c, 0x20, 0xd6, 0x12, 0x5f, 0x43, 0x61, 0x1e, 0x2c, 0xfd, 0x58, 0xd4, 0xf9, 0xc6,
-	0x9b, 0x44, 0x7b, 0x14, 0x8c, 0xb9, 0x4c, 0xb3, 0x09, 0x79, 0x9a, 0x15, 0xed, 0xbf, 0x61, 0x10,
-	0xc4, 0xb2, 0xff, 0xd2, 0xff, 0xcd, 0x4b, 0x50, 0x60, 0xd8, 0xe3, 0x98, 0xe6, 0x25, 0x31, 0x3c,
-	0x96, 0x21, 0x3f, 0x6c, 0x8d, 0x1f, 0x1b, 0x19, 0x54, 0x81, 0x42, 0x6f, 0x30, 0xe9, 0x8f, 0x8d,
-	0x6c, 0x73, 0x1d, 0xea, 0x29, 0x5f, 0x6d, 0xd6, 0x00, 0x12, 0x17, 0x34, 0xff, 0x6c, 0x16, 0x1a,
-	0xe3, 0xd0, 0xde, 0xdb, 0x73, 0x1d, 0x1a, 0x92, 0x85, 0x81, 0x37, 0x8a, 0xed, 0xd8, 0x8d, 0x62,
-	0xd7, 0x89, 0x8e, 0xd8, 0xbe, 0x01, 0xa5, 0xe7, 0xb6, 0xf3, 0xc2, 0x0b, 0xf6, 0x99, 0x6d, 0xf2,
-	0x58, 0x26, 0xd9, 0xb4, 0xf1, 0x3a, 0x16, 0xd3, 0x1a, 0x9d, 0x36, 0x68, 0x82, 0x52, 0x67, 0x61,
-	0xb0, 0xe0, 0x03, 0x44, 0x1e, 0xf3, 0x04, 0x8d, 0xd4, 0x69, 0xd8, 0xe6, 0xb9, 0x73, 0x37, 0xe6,
-	0x2b, 0xd3, 0x3c, 0xd6, 0x28, 0xb4, 0x94, 0x85, 0xed, 0xbc, 0x20, 0x31, 0x5f, 0x80, 0xe6, 0xb1,
-	0x4c, 0xd2, 0xca, 0x7f, 0xe7, 0x11, 0x9f, 0x8d, 0x04, 0x79, 0xcc, 0xfe, 0x53, 0x74, 0x68, 0xc7,
-	0xe4, 0xf9, 0x22, 0x62, 0x7d, 0x3d, 0x8f, 0x65, 0x52, 0x72, 0x16, 0x8b, 0x88, 0x75, 0x62, 0xc1,
-	0x59, 0x2c, 0xd8, 0xb6, 0x64, 0x48, 0xbe, 0x5b, 0x92, 0x25, 0xdb, 0x26, 0xa1, 0x2c, 0x95, 0x36,
-	0x7f, 0xa9, 0x00, 0xb5, 0xee, 0x42, 0x33, 0xc2, 0x06, 0xc0, 0x76, 0x10, 0xbe, 0xb4, 0xc3, 0x99,
-	0xeb, 0xef, 0xb3, 0x6e, 0x93, 0xc3, 0x1a, 0x85, 0xf2, 0x3b, 0x64, 0xcf, 0x5e, 0x7a, 0xf1, 0x78,
-	0xbc, 0xcb, 0xec, 0x92, 0xc3, 0x1a, 0x85, 0xf2, 0xbb, 0x3e, 0x26, 0x0e, 0x71, 0x0f, 0x85, 0x7d,
-	0x72, 0x58, 0xa3, 0xa0, 0x6b, 0x50, 0xed, 0xfa, 0x8f, 0x67, 0xa1, 0x15, 0x86, 0x41, 0xc8, 0x4d,
-	0x95, 0xc3, 0x3a, 0x09, 0x99, 0x50, 0xeb, 0xfa, 0x34, 0xda, 0x12, 0x90, 0x02, 0x83, 0xa4, 0x68,
-	0xe8, 0x3a, 0xd4, 0xa9, 0x4e, 0x1d, 0x3b, 0xb6, 0xf7, 0x43, 0x7b, 0xce, 0x4d, 0x97, 0xc3, 0x69,
-	0x22, 0xba, 0x05, 0xeb, 0x5d, 0x7f, 0xe2, 0xbf, 0xf0, 0x83, 0x97, 0xfe, 0x30, 0x0c, 0xe2, 0x80,
-	0xc7, 0x74, 0x39, 0xbc, 0x4a, 0xe6, 0x5a, 0xd3, 0x80, 0xdb, 0x0e, 0x67, 0xdc, 0xb2, 0x4c, 0x6b,
-	0x49, 0x11, 0x7c, 0xe2, 0xb9, 0x34, 0x4c, 0x64, 0xf6, 0xe5, 0x7c, 0x41, 0xa1, 0xb5, 0x1a, 0x2c,
-	0x63, 0x4c, 0xad, 0x1a, 0xc5, 0xdc, 0xca, 0x39, 0xac, 0x93, 0x04, 0x42, 0x15, 0x51, 0x55, 0x08,
-	0x55, 0x06, 0x47, 0xf4, 0x03, 0x1c, 0xb0, 0xb8, 0xaf, 0xa6, 0x10, 0x92, 0x44, 0x2d, 0x83, 0x89,
-	0x1d, 0xcd, 0xc5, 0xc6, 0x1c, 0x8b, 0xf7, 0x73, 0x38, 0x45, 0xa3, 0x9a, 0xb2, 0x34, 0x26, 0xdf,
-	0xcd, 0x78, 0x5c, 0x9f, 0xc3, 0x1a, 0x85, 0x3a, 0x03, 0x4b, 0x0d, 0x9e, 0x44, 0x2c, 0xa0, 0xcf,
-	0x61, 0x95, 0x56, 0xb2, 0xdb, 0xb6, 0xeb, 0x45, 0x0d, 0x43, 0x93, 0x65, 0x14, 0xea, 0x62, 0xdb,
-	0xa1, 0xbd, 0x4f, 0x45, 0xcf, 0x30, 0xa6, 0x4c, 0xd2, 0x61, 0x8a, 0xfe, 0xe5, 0x82, 0x88, 0xf1,
-	0x12, 0x02, 0xad, 0x19, 0x4d, 0xb4, 0x43, 0x62, 0xd3, 0x9a, 0x9d, 0xe5, 0x35, 0xd3, 0x48, 0xe6,
-	0xdf, 0x2e, 0xc1, 0x5a, 0xd7, 0x99, 0xeb, 0x8e, 0x78, 0x01, 0x8a, 0x5d, 0xbf, 0x17, 0xed, 0x47,
-	0xc2, 0x09, 0x45, 0x8a, 0x56, 0xa0, 0xeb, 0x0b, 0xd7, 0xe0, 0xee, 0xa7, 0xd2, 0xdc, 0x75, 0xda,
-	0xd1, 0x72, 0x2e, 0xf8, 0x39, 0xe9, 0x3a, 0x09, 0x0d, 0xdd, 0x84, 0x35, 0xda, 0x70, 0x51, 0x3c,
-	0xf1, 0x43, 0x62, 0x3b, 0x07, 0xd2, 0x07, 0x57, 0xa8, 0xdc, 0x51, 0xa9, 0x55, 0xad, 0x57, 0xce,
-	0x4c, 0x7a, 0xa1, 0x4e, 0xe2, 0x88, 0xa1, 0x1d, 0xce, 0x87, 0x61, 0xf0, 0x5c, 0xba, 0xa0, 0x4e,
-	0xe2, 0xfa, 0x8c, 0x42, 0xe7, 0xfb, 0x4b, 0xe2, 0xd3, 0x92, 0x4a, 0x52, 0x9f, 0x84, 0xc6, 0x73,
-	0xc1, 0x64, 0xe6, 0x86, 0xc4, 0x89, 0xa5, 0xef, 0xe9, 0x24, 0x6a, 0xf6, 0xae, 0x6f, 0x39, 0x07,
-	0x81, 0xf4, 0x3c, 0x99, 0xe4, 0x6e, 0x49, 0xff, 0x62, 0xb2, 0x90, 0x5e, 0xa7, 0x51, 0x78, 0xf9,
-	0x54, 0xe1, 0x28, 0xb6, 0xe7, 0x0b, 0xe9, 0x75, 0x29, 0x1a, 0xef, 0x24, 0x2a, 0xcd, 0x32, 0xaa,
-	0xc9, 0x4e, 0x92, 0x22, 0x73, 0x4d, 0x69, 0x27, 0xec, 0xd9, 0xd1, 0x8b, 0x48, 0x78, 0x9f, 0x4e,
-	0xe2, 0xb6, 0x95, 0x49, 0x96, 0xd5, 0x9a, 0xb4, 0xad, 0x4e, 0xa5, 0x35, 0x1a, 0x2c, 0x63, 0xd6,
-	0xb8, 0xdc, 0x07, 0x65, 0x92, 0x3a, 0xd2, 0x60, 0x19, 0x8b, 0xe6, 0xe3, 0x1e, 0x98, 0x10, 0xa8,
-	0xae, 0xb4, 0xc7, 0xe8, 0x8d, 0xc7, 0x1d, 0x71, 0x95, 0x4c, 0x6b, 0x3e, 0x58, 0xc6, 0x49, 0xf3,
-	0x71, 0x9f, 0x4c, 0xd1, 0x04, 0x26, 0x69, 0xc0, 0xb3, 0x0a, 0x93, 0xb4, 0xe0, 0x75, 0xa8, 0x0f,
-	0x96, 0xb1, 0xd6, 0x84, 0xe7, 0xf8, 0x40, 0x93, 0x22, 0x8a, 0x9c, 0x92, 0x46, 0x3c, 0xaf, 0x72,
-	0x4a, 0x5a, 0xb1, 0x09, 0x65, 0x5a, 0x11, 0xd6, 0x8c, 0x17, 0xb8, 0xdf, 0xca, 0xb4, 0xe8, 0xfa,
-	0xaa, 0x21, 0xdf, 0x57, 0x5d, 0x5f, 0xb5, 0x24, 0xd7, 0x43, 0x6b, 0xca, 0x86, 0xd2, 0x43, 0x6b,
-	0xcb, 0xdb, 0x60, 0xe8, 0x04, 0x96, 0xd9, 0x45, 0x06, 0x3c, 0x42, 0x17, 0x3a, 0x27, 0xcd, 0xd9,
-	0x54, 0x3a, 0x27, 0xed, 0xc9, 0xed, 0x9d, 0x6a, 0xd0, 0x4b, 0xca, 0xde, 0x3a, 0xd9, 0xfc, 0x27,
-	0x39, 0xa8, 0x8f, 0x1d, 0xbd, 0xff, 0xd2, 0xc1, 0x2a, 0x0e, 0x5a, 0xde, 0x7e, 0x10, 0xba, 0xf1,
-	0xc1, 0x5c, 0xf4, 0xe2, 0x14, 0x8d, 0xf6, 0x71, 0x1c, 0x07, 0x3d, 0xd7, 0x17, 0x3d, 0x59, 0xa4,
-	0x24, 0xdd, 0x7e, 0x25, 0x7a, 0xb0, 0x48, 0x51, 0xbf, 0xe9, 0xd9, 0xaf, 0xda, 0x81, 0xef, 0x8b,
-	0x4e, 0x2b, 0x93, 0xd4, 0x82, 0x2d, 0x27, 0x76, 0x0f, 0xc9, 0x60, 0x41, 0x7c, 0xd5, 0x5b, 0x35,
-	0x12, 0xd5, 0x67, 0x68, 0x47, 0x91, 0x82, 0xf0, 0xee, 0x9a, 0xa2, 0x51, 0x4c, 0x2b, 0x8e, 0xc9,
-	0x7c, 0x11, 0xf3, 0x91, 0x4c, 0xf4, 0x57, 0x9d, 0x46, 0x4b, 0xb2, 0xa2, 0xd8, 0x7e, 0x4e, 0x43,
-	0xd8, 0xa4, 0xbf, 0x6a, 0x24, 0xea, 0xc3, 0xed, 0x65, 0x18, 0x32, 0x92, 0xe8, 0xb1, 0x09, 0x81,
-	0x8f, 0x6b, 0x23, 0xb2, 0x2f, 0xfb, 0xab, 0x48, 0x89, 0x3e, 0xc1, 0x18, 0x55, 0xd5, 0x27, 0x18,
-	0xe7, 0x1a, 0x54, 0x31, 0x89, 0x43, 0xdb, 0x8f, 0x18, 0x57, 0x4c, 0x0c, 0x1a, 0x89, 0xe7, 0x69,
-	0x85, 0xa1, 0xec, 0x94, 0x22, 0x25, 0xf2, 0xc4, 0x74, 0x4a, 0x5a, 0x53, 0x79, 0xd2, 0xe4, 0x91,
-	0x91, 0x72, 0xfd, 0xe8, 0x48, 0x69, 0xfe, 0x7a, 0x16, 0xea, 0x93, 0x99, 0xde, 0xa6, 0x6c, 0x04,
-	0x48, 0x26, 0xdd, 0x8c, 0x1c, 0x01, 0x92, 0x29, 0xb7, 0x01, 0xa5, 0x7e, 0x30, 0x0c, 0xc2, 0x58,
-	0x0e, 0xce, 0x32, 0x99, 0x1a, 0xb7, 0x73, 0x47, 0xc7, 0x6d, 0xda, 0x81, 0x55, 0xc6, 0x79, 0xe5,
-	0x8b, 0x49, 0xce, 0xd4, 0x9f, 0x9c, 0xc3, 0xe7, 0xcb, 0xbd, 0x74, 0x58, 0xa0, 0xd3, 0x28, 0x66,
-	0xe4, 0xcf, 0x12, 0x8c, 0x68, 0x63, 0x9d, 0x76, 0xa4, 0xe6, 0xa5, 0x63, 0xe6, 0x08, 0x8a, 0xd9,
-	0xf7, 0x83, 0x90, 0xcc, 0x7a, 0x4b, 0x2f, 0x76, 0x45, 0x23, 0xa7, 0x68, 0xe6, 0x8f, 0x33, 0xb0,
-	0x36, 0xea, 0xf7, 0x86, 0x9a, 0x79, 0x36, 0xa1, 0xec, 0x2e, 0xa6, 0x51, 0x6c, 0xc7, 0xd1, 0xca,
-	0xe6, 0xb5, 0x1e, 0x62, 0xe1, 0x92, 0xcb, 0x52, 0x11, 0xba, 0x0f, 0xe0, 0x3a, 0x73, 0x29, 0x91,
-	0x4d, 0x7d, 0x47, 0x4c, 0xcf, 0x86, 0xb8, 0xe2, 0x8a, 0x74, 0x84, 0xee, 0x42, 0x25, 0x76, 0xa4,
-	0x50, 0x2e, 0xb5, 0x65, 0x98, 0xea, 0x81, 0xb8, 0x1c, 0x3b, 0x89, 0xc8, 0x72, 0x26, 0x45, 0xf2,
-	0x29, 0x91, 0x54, 0x03, 0xe3, 0xf2, 0x92, 0x27, 0x23, 0xf3, 0xef, 0xac, 0x03, 0x92, 0x81, 0xbc,
-	0x56, 0xc5, 0xcb, 0x50, 0x89, 0xe5, 0x30, 0x22, 0xf6, 0x54, 0x12, 0x02, 0xfa, 0x14, 0xce, 0x3a,
-	0x8b, 0x65, 0x34, 0x5d, 0x46, 0xe2, 0xc3, 0xe2, 0x34, 0x22, 0x8e, 0xf8, 0x34, 0x84, 0x0d, 0xca,
-	0x9a, 0x44, 0xfc, 0xbb, 0xe2, 0x88, 0x38, 0x54, 0xad, 0xf3, 0x0c, 0x1e, 0xbd, 0x8e, 0x62, 0x32,
-	0xd7, 0x04, 0xd8, 0xf7, 0x21, 0x8c, 0x28, 0x73, 0xc4, 0x78, 0x4a, 0xe4, 0x0a, 0x00, 0x13, 0x61,
-	0xc1, 0x33, 0xff, 0x32, 0x84, 0x2b, 0x94, 0xb2, 0x4b, 0x09, 0xc8, 0x84, 0xfa, 0x9c, 0xcc, 0xa7,
-	0x61, 0x14, 0x4d, 0x79, 0x80, 0xce, 0xe3, 0xed, 0xea, 0x9c, 0xcc, 0x71, 0x14, 0x3d, 0x62, 0x61,
-	0xfa, 0x4d, 0x58, 0xa7, 0x18, 0x96, 0x83, 0x40, 0xf1, 0xc0, 0x9b, 0x8a, 0xb2, 0x6c, 0x14, 0x8e,
-	0x15, 0xe5, 0x87, 0x62, 0x7f, 0x95, 0xfb, 0x4a, 0x1d, 0xd7, 0x29, 0xb9, 0x1f, 0xf2, 0x4d, 0x54,
-	0x3a, 0xe8, 0x9e, 0x91, 0xb8, 0xf8, 0x20, 0x0c, 0xe2, 0xd8, 0x23, 0x7c, 0x8b, 0xb9, 0x8e, 0xd7,
-	0x39, 0x72, 0x2c, 0xc9, 0xe8, 0x0b, 0x68, 0x30, 0xac, 0x02, 0x6a, 0x95, 0xae, 0xb0, 0xca, 0x30,
-	0x8b, 0x28, 0x01, 0x55, 0xef, 0xeb, 0xb0, 0x46, 0x95, 0xde, 0x73, 0x3d, 0x22, 0x74, 0xe6, 0x91,
-	0x7c, 0x6d, 0x4e, 0xe6, 0xdb, 0xae, 0x47, 0xb8, 0xca, 0x02, 0x65, 0xfb, 0x81, 0x2f, 0x50, 0x55,
-	0x85, 0x6a, 0xf9, 0x81, 0xcf, 0x51, 0x77, 0xe1, 0x3c, 0x45, 0xcd, 0xed, 0xc5, 0x82, 0xcc, 0xf4,
-	0x2c, 0x6b, 0x0c, 0x8c, 0xe6, 0x64, 0xde, 0x63, 0xbc, 0x24, 0xe3, 0x8f, 0x20, 0xbf, 0x20, 0xe1,
-	0x9e, 0xd8, 0x61, 0x96, 0x3e, 0x3a, 0x24, 0xe1, 0x9e, 0xe6, 0x3c, 0x0c, 0x42, 0x75, 0xf0, 0x49,
-	0x3c, 0x0d, 0x5f, 0x4d, 0xe5, 0xb2, 0x66, 0x8d, 0xeb, 0xe0, 0x93, 0x18, 0xbf, 0x1a, 0x8a, 0xb5,
-	0xcd, 0x35, 0xa8, 0x09, 0x14, 0x2f, 0x7a, 0x9d, 0xaf, 0x8b, 0x18, 0x86, 0x17, 0x69, 0x42, 0x5d,
-	0x20, 0x48, 0x12, 0x0d, 0xe4, 0x71, 0x95, 0x41, 0xd4, 0x32, 0x40, 0x96, 0x45, 0xd7, 0x5a, 0x0b,
-	0x32, 0x63, 0xe1, 0x80, 0x2c, 0xab, 0xc3, 0x69, 0x12, 0x15, 0x27, 0x1a, 0x21, 0x85, 0x1a, 0xaf,
-	0x6a, 0x14, 0x4b, 0x8d, 0xce, 0x2a, 0x8d, 0xc6, 0x69, 0x8d, 0x62, 0xa5, 0xd1, 0x39, 0xa5, 0xd1,
-	0x78, 0x45, 0xa3, 0x38, 0xd1, 0xe8, 0xbc, 0x56, 0x96, 0xd4, 0xe8, 0x4b, 0xb8, 0xc8, 0x50, 0xce,
-	0x62, 0x1a, 0xc6, 0xf1, 0x74, 0xee, 0x3a, 0x61, 0x40, 0x7d, 0x60, 0xba, 0x78, 0x70, 0x87, 0x05,
-	0x07, 0x19, 0x7c, 0x9e, 0x0a, 0x38, 0x0b, 0x1c, 0xc7, 0x3d, 0xc9, 0x1d, 0x3e, 0xb8, 0x73, 0x8a,
-	0xe4, 0x57, 0x77, 0x58, 0xdc, 0x70, 0xbc, 0xe4, 0x57, 0xa7, 0x4a, 0x3e, 0x60, 0xd1, 0xc4, 0x09,
-	0x92, 0x0f, 0x4e, 0x93, 0xfc, 0x8a, 0x85, 0x17, 0x27, 0x48, 0x7e, 0x85, 0x6e, 0x81, 0x31, 0x73,
-	0xa3, 0x17, 0xa9, 0xbe, 0xd6, 0x64, 0xf6, 0x58, 0xa3, 0xf4, 0x74, 0x67, 0x63, 0xc8, 0x65, 0x44,
-	0x66, 0x02, 0x78, 0x89, 0x77, 0x4a, 0x4a, 0x9e, 0x44, 0x64, 0xc6, 0x71, 0x5f, 0x43, 0x53, 0xea,
-	0x62, 0xb3, 0xc9, 0x7d, 0xea, 0x04, 0xbe, 0x4f, 0x9c, 0xd8, 0x0d, 0xfc, 0xa8, 0x71, 0x99, 0x29,
-	0xf3, 0x3e, 0x57, 0x86, 0x4f, 0xfe, 0xed, 0x84, 0x8d, 0x7e, 0x0e, 0xae, 0x48, 0x61, 0xd6, 0xed,
-	0x5e, 0xda, 0x6e, 0x9c, 0x92, 0xbf, 0xc2, 0xe4, 0x2f, 0x72, 0x79, 0xda, 0xf7, 0x9e, 0xd9, 0x6e,
-	0xac, 0xe7, 0xc0, 0xb6, 0x63, 0x02, 0x87, 0x44, 0x11, 0x89, 0x1a, 0x1b, 0xac, 0x8f, 0x27, 0x04,
-	0x3a, 0xf9, 0xc5, 0x07, 0x21, 0xb1, 0x67, 0x51, 0xe3, 0x2a, 0xe3, 0xc9, 0x24, 0xed, 0xf7, 0x6c,
-	0xcc, 0x09, 0x5e, 0x4e, 0x17, 0x21, 0x89, 0xa2, 0x65, 0x48, 0x15, 0x5f, 0xfa, 0x31, 0x09, 0x1b,
-	0xd7, 0x58, 0x3d, 0x69, 0x97, 0xdc, 0x0d, 0x5e, 0x0e, 0x05, 0xb7, 0xcd, 0x99, 0xe8, 0xa7, 0xe1,
-	0x12, 0xeb, 0xab, 0x64, 0xe6, 0x2e, 0xe7, 0x47, 0x65, 0x3f, 0x60, 0xb2, 0x34, 0xef, 0x1e, 0x43,
-	0xac, 0x8a, 0xb7, 0xe0, 0x0a, 0x15, 0x77, 0x42, 0x37, 0x76, 0x1d, 0xdb, 0x3b, 0x9a, 0x81, 0xc9,
-	0x32, 0x68, 0xce, 0xc9, 0xbc, 0x2d, 0x30, 0xab, 0x59, 0xcc, 0x60, 0x83, 0x19, 0x8d, 0xef, 0x9a,
-	0x50, 0x73, 0xc5, 0x61, 0xe0, 0xb1, 0xb9, 0x84, 0xf7, 0xfb, 0xc6, 0xf7, 0x52, 0x47, 0x2f, 0x4e,
-	0xda, 0x5e, 0xc1, 0x97, 0xa8, 0x59, 0x4f, 0xda, 0x7b, 0x11, 0x83, 0x72, 0x1c, 0xc4, 0xb6, 0x27,
-	0xda, 0xff, 0xba, 0x1a, 0x94, 0xc7, 0x94, 0x9a, 0x1a, 0xbb, 0x38, 0x6e, 0x4e, 0xe6, 0xd1, 0x4b,
-	0x81, 0xbe, 0xa1, 0xc6, 0x2e, 0x86, 0xee, 0x51, 0x16, 0x17, 0xf9, 0x0c, 0xce, 0x51, 0x91, 0x28,
-	0xd8, 0x8b, 0x53, 0x8e, 0x78, 0x93, 0x49, 0x9c, 0x99, 0x93, 0xf9, 0x28, 0xd8, 0x8b, 0xd3, 0xbe,
-	0xc8, 0x8c, 0x66, 0x3b, 0x07, 0x72, 0x64, 0xfc, 0x50, 0xe9, 0xd2, 0xa6, 0xd4, 0xd4, 0x68, 0x1b,
-	0xbd, 0xb4, 0x17, 0x02, 0x76, 0x4b, 0x8d, 0xb6, 0xa3, 0x97, 0xf6, 0x82, 0xa3, 0xb6, 0xb8, 0xc6,
-	0x4b, 0x9f, 0x1c, 0xba, 0x0e, 0xfb, 0x38, 0x2b, 0xc0, 0x1f, 0x31, 0xf0, 0xd9, 0x39, 0x99, 0x4f,
-	0x12, 0x1e, 0x97, 0xb1, 0xe0, 0x2c, 0xb5, 0x79, 0xe4, 0x8b, 0xe0, 0x40, 0x18, 0xfa, 0x76, 0x6a,
-	0xf4, 0x4d, 0x07, 0x1f, 0xf8, 0x8c, 0x4f, 0xe2, 0x91, 0xaf, 0x07, 0x0d, 0xe6, 0x6f, 0xe4, 0xa1,
-	0x2e, 0xe7, 0xf0, 0x49, 0x64, 0xef, 0x13, 0xf4, 0x35, 0x54, 0xe4, 0x87, 0x58, 0xf9, 0x7d, 0x7c,
-	0x75, 0xaf, 0x97, 0x01, 0xd5, 0xd7, 0x3d, 0x9c, 0xe0, 0xd1, 0x0d, 0x28, 0x30, 0xbb, 0x37, 0xb2,
-	0xc7, 0x7f, 0xc2, 0xe2, 0xdc, 0xe6, 0x5f, 0xcb, 0x41, 0x59, 0x8a, 0xa3, 0x2f, 0xa1, 0x9e, 0x7c,
-	0x1f, 0xf6, 0xf7, 0x02, 0xf1, 0x85, 0xf8, 0xec, 0x31, 0x1f, 0x11, 0x71, 0x8d, 0xe8, 0x5f, 0x96,
-	0x3f, 0x85, 0x8a, 0xed, 0x79, 0x81, 0x63, 0xc7, 0x64, 0x76, 0x52, 0x89, 0x09, 0x02, 0x7d, 0x05,
-	0xa0, 0x59, 0x8a, 0x87, 0x45, 0x17, 0x57, 0xf0, 0x9a, 0xb5, 0x34, 0x30, 0x7a, 0x00, 0x35, 0xf5,
-	0x41, 0x76, 0xca, 0xbe, 0x21, 0x66, 0x4f, 0x38, 0x53, 0x58, 0x55, 0xb8, 0x2e, 0x1d, 0xc4, 0x0b,
-	0x31, 0x5b, 0x39, 0xf1, 0x2f, 0x74, 0xe6, 0xa9, 0x76, 0xdc, 0x1c, 0xd3, 0x95, 0x12, 0x17, 0x68,
-	0xfe, 0x5a, 0x06, 0xf2, 0x34, 0x7d, 0xec, 0xb7, 0x8b, 0x2b, 0x6c, 0x17, 0x92, 0x9f, 0xef, 0x93,
-	0x9b, 0xcd, 0xfc, 0x54, 0x25, 0xdb, 0x94, 0x7c, 0xc7, 0x6f, 0x89, 0xc9, 0xd6, 0x79, 0xfe, 0x94,
-	0xad, 0x73, 0xf3, 0xc7, 0x06, 0xac, 0xa5, 0x67, 0xf3, 0x37, 0x44, 0x7a, 0xcd, 0xd4, 0x79, 0x3e,
-	0xca, 0x54, 0x69, 0xba, 0x1a, 0x71, 0x5e, 0x3b, 0x9e, 0xda, 0x1e, 0x15, 0x29, 0xf4, 0x39, 0xbc,
-	0x1f, 0xc5, 0xb6, 0x47, 0xa3, 0x1e, 0x4e, 0x99, 0xee, 0x85, 0x81, 0x1f, 0x13, 0x7f, 0x26, 0x76,
-	0x4c, 0xcf, 0x0b, 0x76, 0x9b, 0x71, 0xb7, 0x05, 0x13, 0xdd, 0x87, 0x0b, 0x2b, 0x72, 0xcf, 0xe9,
-	0x9c, 0xed, 0xcf, 0x44, 0x74, 0x77, 0x2e, 0x25, 0xf6, 0x88, 0xf3, 0x68, 0x0c, 0xef, 0xfa, 0x51,
-	0x1c, 0x2e, 0xc5, 0xd8, 0xce, 0x63, 0xbc, 0x14, 0x0d, 0x7d, 0x04, 0x06, 0xef, 0xe5, 0x21, 0xd9,
-	0x23, 0x21, 0xf1, 0xa9, 0x4d, 0xf9, 0x6e, 0xeb, 0x3a, 0xa3, 0x63, 0x45, 0x46, 0x1f, 0x40, 0x8d,
-	0x43, 0xe7, 0x2e, 0x1b, 0xfc, 0xf9, 0xee, 0x6b, 0x95, 0xd1, 0x7a, 0x8c, 0x44, 0x6d, 0xf2, 0x3c,
-	0xb4, 0x7d, 0xe7, 0x80, 0xc8, 0x2d, 0x58, 0x95, 0x46, 0xdf, 0x83, 0x3a, 0xff, 0x2f, 0xe5, 0x45,
-	0xf8, 0xc6, 0x89, 0x22, 0x83, 0x2b, 0x00, 0xcf, 0x97, 0x91, 0xa8, 0xa4, 0x08, 0xdd, 0x2a, 0xcf,
-	0x97, 0x11, 0xaf, 0x18, 0x65, 0x87, 0x64, 0x4f, 0xb2, 0x79, 0xb0, 0x56, 0x09, 0xc9, 0x9e, 0x60,
-	0x5f, 0x02, 0x1a, 0x08, 0x4f, 0x1d, 0x2f, 0x70, 0x5e, 0xb0, 0x40, 0x2d, 0x83, 0xcb, 0xce, 0x62,
-	0xd9, 0xa6, 0x69, 0x2a, 0x4b, 0x7d, 0x4f, 0x70, 0xd7, 0x78, 0xdc, 0x4c, 0x29, 0x9c, 0x7d, 0x15,
-	0xaa, 0x0b, 0x7b, 0x9f, 0x4c, 0xd9, 0x36, 0xae, 0x8a, 0xc6, 0x28, 0x69, 0x9b, 0x51, 0x68, 0xf5,
-	0xe7, 0xae, 0x1f, 0x84, 0x12, 0x21, 0x82, 0x31, 0x46, 0xd3, 0x20, 0xf6, 0x1f, 0x4d, 0x20, 0x67,
-	0x04, 0x84, 0xd2, 0x04, 0x84, 0xda, 0x9b, 0x36, 0xea, 0xab, 0x78, 0x1a, 0xbd, 0x74, 0x63, 0x66,
-	0x29, 0x24, 0xec, 0xcd, 0xe9, 0x23, 0x41, 0x46, 0x37, 0x60, 0x8d, 0xd6, 0x66, 0xee, 0xee, 0x73,
-	0xaf, 0x92, 0x01, 0x19, 0x0d, 0xbe, 0x7b, 0x8a, 0x48, 0x73, 0xb4, 0x3d, 0x77, 0x9f, 0x1d, 0xcb,
-	0x91, 0x05, 0xf3, 0xb0, 0x6c, 0x5d, 0xd1, 0x93, 0xc2, 0xc9, 0x7c, 0xe9, 0x31, 0x41, 0x09, 0xe5,
-	0xc1, 0xd9, 0xba, 0xa2, 0x0b, 0xe8, 0x4d, 0x58, 0xf7, 0xee, 0x4e, 0x67, 0xbc, 0xc1, 0xbd, 0x80,
-	0x4e, 0xe8, 0x17, 0x78, 0xe9, 0xde, 0xdd, 0x0e, 0xa3, 0xee, 0x52, 0x22, 0x9d, 0x8d, 0xd2, 0x38,
-	0xd9, 0xba, 0xef, 0xf3, 0xd9, 0x48, 0x47, 0x8b, 0x36, 0xbe, 0x05, 0x46, 0x22, 0x12, 0xc5, 0x41,
-	0x48, 0xf8, 0x5e, 0x4e, 0x1e, 0xaf, 0x49, 0xf4, 0x88, 0x51, 0xd1, 0x3d, 0xb8, 0xb0, 0x82, 0x94,
-	0xb9, 0x5f, 0xe4, 0x33, 0x47, 0x0a, 0x2f, 0xb2, 0xbf, 0x03, 0xe7, 0x12, 0xa1, 0x05, 0x75, 0x6b,
-	0x6e, 0xe5, 0x66, 0x5a, 0xa1, 0xa1, 0xe2, 0xa0, 0xaf, 0xe0, 0xe2, 0x51, 0x09, 0x59, 0x12, 0x8f,
-	0xc1, 0x2e, 0xac, 0x8a, 0x89, 0xc2, 0xb8, 0x99, 0x5c, 0xdd, 0x4c, 0x97, 0xa5, 0x99, 0xba, 0x47,
-	0xcc, 0xe4, 0x1e, 0x35, 0xd3, 0x15, 0xa9, 0x55, 0x77, 0xd5, 0x4c, 0xbc, 0x1e, 0xee, 0x91, 0x7a,
-	0x6c, 0xa4, 0x25, 0x8e, 0xd4, 0xc3, 0x3d, 0xbe, 0x1e, 0x57, 0x65, 0x3d, 0xba, 0xc7, 0xd5, 0xe3,
-	0x12, 0x54, 0x3c, 0xcf, 0x11, 0x35, 0xe0, 0xe1, 0x58, 0xd9, 0xf3, 0x1c, 0xae, 0x3c, 0xad, 0xa4,
-	0x60, 0xca, 0xdc, 0x3e, 0x10, 0x95, 0xe4, 0x90, 0xa4, 0xf3, 0x52, 0x9c, 0x68, 0x52, 0x1e, 0x57,
-	0xd1, 0x6c, 0x45, 0x6b, 0xd2, 0x76, 0x97, 0x6c, 0x99, 0xcf, 0xf7, 0x44, 0xbb, 0x0b, 0x90, 0xc8,
-	0xe8, 0x06, 0x50, 0x8a, 0x5e, 0xe9, 0xeb, 0xaa, 0x3c, 0xad, 0xbe, 0x9b, 0x70, 0x56, 0x87, 0xc9,
-	0x3c, 0x79, 0x1c, 0x74, 0x46, 0xc3, 0x26, 0xfa, 0xcd, 0x62, 0xef, 0xb9, 0xa8, 0x25, 0x0f, 0x7e,
-	0x2a, 0x94, 0xc2, 0xab, 0x49, 0x43, 0x75, 0xc9, 0x96, 0x79, 0x7d, 0x28, 0x42, 0x75, 0x01, 0x12,
-	0x19, 0x5d, 0x85, 0x2a, 0x43, 0x8a, 0x9a, 0xf2, 0x98, 0x87, 0xe5, 0x2d, 0xaa, 0x7a, 0x1b, 0xce,
-	0x24, 0x00, 0x99, 0x17, 0x8f, 0x76, 0xd6, 0x15, 0x4c, 0x64, 0xf6, 0x21, 0x30, 0x92, 0x5e, 0xdb,
-	0xdb, 0x49, 0xa9, 0x5a, 0x75, 0xef, 0xc0, 0xb9, 0x14, 0x50, 0xe6, 0xfb, 0x31, 0x77, 0x08, 0x1d,
-	0x9d, 0x54, 0xd8, 0x4d, 0x2a, 0xfc, 0x09, 0xaf, 0xb0, 0xab, 0x57, 0xd8, 0x5d, 0xad, 0xf0, 0xa7,
-	0xbc, 0x68, 0x37, 0x5d, 0xe1, 0x0f, 0x40, 0x0c, 0xd3, 0x22, 0xab, 0x4d, 0x3e, 0xb0, 0x71, 0x1a,
-	0xcf, 0xec, 0x13, 0x40, 0x1a, 0x44, 0x66, 0xf7, 0x19, 0x03, 0x1a, 0x09, 0x30, 0xd1, 0xcc, 0x0f,
-	0x66, 0xb2, 0xcb, 0xdc, 0xe1, 0x9a, 0x51, 0x8a, 0xd2, 0x4c, 0xb1, 0x65, 0x56, 0x77, 0xb9, 0x66,
-	0x12, 0x94, 0x34, 0x05, 0x43, 0x8a, 0xa6, 0xd8, 0x12, 0x4b, 0xd6, 0x60, 0x46, 0x92, 0xa6, 0x48,
-	0x00, 0x32, 0xaf, 0x7b, 0xbc, 0x29, 0x14, 0x2c, 0x69, 0x0a, 0x86, 0xd5, 0x9a, 0xe2, 0x7e, 0x52,
-	0x6a, 0xba, 0x29, 0x52, 0x40, 0x99, 0xef, 0x03, 0xde, 0x14, 0x3a, 0x9a, 0x67, 0x6d, 0x3a, 0x50,
-	0x12, 0x1f, 0xc2, 0xd0, 0x47, 0x50, 0xb6, 0xf7, 0xe9, 0x60, 0xed, 0xca, 0x03, 0x9e, 0xab, 0x07,
-	0x96, 0x4a, 0x8c, 0xdf, 0x5d, 0x09, 0x75, 0xb2, 0x6f, 0x0a, 0x75, 0xcc, 0xbf, 0x05, 0x50, 0x60,
-	0x17, 0x58, 0xc4, 0x71, 0xa8, 0x4c, 0xea, 0x8a, 0x84, 0xb8, 0xda, 0xc2, 0x62, 0xa8, 0xd5, 0xf3,
-	0x80, 0xd9, 0x54, 0xc0, 0x77, 0xe2, 0x79, 0x40, 0x5d, 0xf5, 0x5c, 0xfa, 0xfe, 0xc5, 0xaa, 0xea,
-	0xfa, 0x69, 0xb0, 0xfc, 0x69, 0xa7, 0xc1, 0xde, 0x7c, 0xd4, 0xf1, 0x3e, 0xd4, 0xb4, 0x13, 0x96,
-	0x34, 0x8e, 0xc9, 0x1d, 0x7f, 0xc4, 0xb2, 0x9a, 0x1c, 0xb1, 0x5c, 0x3d, 0x43, 0x56, 0x7a, 0x8b,
-	0x33, 0x64, 0x97, 0x21, 0xb7, 0x0c, 0x3d, 0x71, 0x82, 0x04, 0xe4, 0xee, 0x20, 0xde, 0xc5, 0x94,
-	0x7c, 0xcc, 0xb5, 0x87, 0xca, 0x3b, 0x5c, 0x7b, 0x68, 0xfe, 0xe5, 0x12, 0x54, 0x06, 0x0b, 0x22,
-	0x02, 0xc4, 0xcf, 0x52, 0x07, 0xe8, 0x2f, 0xe9, 0x2d, 0xb4, 0xa9, 0x50, 0xfa, 0x11, 0x85, 0x07,
-	0x34, 0x8a, 0x5d, 0xfa, 0x8e, 0x3c, 0xa4, 0x70, 0xe5, 0x04, 0x91, 0x5d, 0x06, 0xc2, 0x02, 0x8c,
-	0xbe, 0x84, 0x12, 0x3f, 0xe8, 0x22, 0x4f, 0x65, 0x6c, 0x9c, 0x20, 0xc7, 0x8f, 0xa3, 0x10, 0x2c,
-	0xe1, 0xe8, 0x67, 0xa0, 0xb2, 0xf4, 0xa5, 0x6c, 0x3e, 0x75, 0x30, 0x7e, 0x55, 0x76, 0x22, 0x71,
-	0x38, 0x11, 0xa1, 0x0a, 0x3b, 0xec, 0xd3, 0xa6, 0x38, 0x02, 0x74, 0x92, 0xc2, 0xfc, 0xfb, 0x27,
-	0x16, 0x60, 0xaa, 0xf0, 0x8c, 0x44, 0x71, 0x18, 0xbc, 0x16, 0x07, 0xa0, 0x4e, 0x52, 0xb8, 0xc3,
-	0x51, 0x58, 0xc2, 0x91, 0x05, 0x35, 0x5e, 0xe9, 0xe9, 0x7e, 0x18, 0x2c, 0x17, 0xe2, 0xf4, 0x90,
-	0x79, 0xaa, 0x9d, 0x76, 0x28, 0x12, 0x57, 0xbd, 0x24, 0xd1, 0xfc, 0x12, 0x8a, 0x9c, 0x87, 0x36,
-	0x45, 0xc0, 0x48, 0x17, 0x6d, 0x72, 0xa9, 0xb8, 0xae, 0x2f, 0x47, 0xd8, 0x41, 0xa0, 0x58, 0xfc,
-	0x8b, 0x9a, 0x11, 0x54, 0xb5, 0x5c, 0xd1, 0x67, 0x50, 0x96, 0xee, 0x78, 0xda, 0x92, 0x4f, 0x81,
-	0xd0, 0x3d, 0x51, 0x1e, 0x57, 0x9f, 0xf7, 0xc8, 0x73, 0x5a, 0x79, 0x2c, 0xdb, 0xa4, 0x50, 0xa9,
-	0x6e, 0x49, 0x34, 0x5d, 0xba, 0x57, 0x65, 0xde, 0xd4, 0xab, 0x9a, 0x0f, 0xa1, 0xa2, 0x1a, 0xee,
-	0x5d, 0x65, 0xef, 0x41, 0x91, 0xb7, 0x1b, 0xfa, 0x08, 0x4a, 0xfc, 0xfc, 0xcf, 0x89, 0x62, 0x92,
-	0xdf, 0xbc, 0x0f, 0x25, 0xd1, 0x68, 0xef, 0x20, 0x65, 0xee, 0x1d, 0x77, 0x98, 0x16, 0xa0, 0xb8,
-	0xdb, 0x9a, 0xf4, 0xdb, 0x8f, 0x8d, 0x0c, 0x32, 0xa0, 0xc6, 0xff, 0x4f, 0x77, 0xf0, 0x60, 0x32,
-	0x34, 0x8a, 0x14, 0x8a, 0xad, 0x91, 0x85, 0x9f, 0x5a, 0x46, 0x16, 0xd5, 0xa1, 0x32, 0xe9, 0xcb,
-	0x64, 0x8e, 0x9d, 0xbc, 0xc5, 0x56, 0x6b, 0x6c, 0x19, 0x79, 0x7e, 0x24, 0x77, 0x34, 0xc6, 0x83,
-	0x6f, 0x8d, 0x82, 0xf9, 0x97, 0xb2, 0x50, 0xeb, 0xfa, 0x87, 0x24, 0x8c, 0xc8, 0xdb, 0x0d, 0xa1,
-	0x62, 0xb4, 0xc8, 0x1e, 0x3f, 0x5a, 0xac, 0x0e, 0xb0, 0xb9, 0x77, 0x1f, 0x60, 0xf3, 0xa7, 0xcf,
-	0x0d, 0x47, 0xc7, 0xa3, 0x02, 0x2b, 0xe3, 0xed, 0xc6, 0xa3, 0x74, 0x8b, 0x17, 0xdf, 0x38, 0xb5,
-	0xfc, 0x28, 0x0f, 0x65, 0xe9, 0xf4, 0xc7, 0x2e, 0xda, 0x6f, 0x42, 0x89, 0xf7, 0x96, 0x13, 0x56,
-	0xee, 0x45, 0xd6, 0x51, 0xde, 0x69, 0x0a, 0x49, 0xa9, 0x98, 0x7f, 0xe3, 0x34, 0xa1, 0x77, 0xb8,
-	0x42, 0xea, 0xdb, 0xd3, 0x09, 0x1d, 0x4e, 0x9e, 0xc2, 0x2f, 0x6a, 0xa7, 0xf0, 0xdf, 0xf9, 0x58,
-	0xfd, 0x01, 0xbb, 0xa4, 0x32, 0x65, 0xb7, 0xc0, 0x56, 0x8e, 0xd5, 0x6b, 0xf7, 0x57, 0x70, 0xf5,
-	0x40, 0xbb, 0x6f, 0x94, 0x3a, 0x26, 0x5f, 0x79, 0xbb, 0x63, 0xf2, 0xc9, 0x36, 0x06, 0x9c, 0x76,
-	0x52, 0x3d, 0x75, 0x9a, 0xbe, 0xfa, 0x76, 0xa7, 0xe9, 0xb7, 0xa0, 0xfa, 0xc2, 0xf5, 0xbc, 0xe9,
-	0x82, 0x5d, 0x42, 0x11, 0x67, 0xf0, 0xe5, 0xf4, 0x9a, 0xdc, 0x4e, 0xc1, 0xf0, 0x42, 0xfd, 0x37,
-	0x3f, 0x87, 0x7a, 0x6a, 0x4c, 0x62, 0x5b, 0x63, 0x6c, 0x2f, 0xe8, 0x84, 0x81, 0x92, 0x73, 0xcd,
-	0xdf, 0xcb, 0x9f, 0xb2, 0xf1, 0xf3, 0xb6, 0x3e, 0xf4, 0x87, 0xec, 0x5c, 0x2b, 0x37, 0x35, 0xf2,
-	0xa9, 0x7a, 0x9e, 0x70, 0x53, 0x43, 0x77, 0xd7, 0xc2, 0xe9, 0xee, 0x7a, 0x93, 0xdd, 0x03, 0x8d,
-	0x49, 0xa3, 0xc8, 0x0e, 0x20, 0x1a, 0x9a, 0xee, 0x23, 0x4a, 0xc7, 0x9c, 0x9d, 0x76, 0xeb, 0xd2,
-	0x1b, 0xdd, 0xfa, 0x53, 0xed, 0x5a, 0x52, 0x39, 0x15, 0xf9, 0xc8, 0x9c, 0x97, 0x51, 0x72, 0x53,
-	0x09, 0xfd, 0x1c, 0x9c, 0xe5, 0xff, 0xa7, 0xcb, 0xc5, 0xcc, 0x8e, 0xc9, 0x94, 0xeb, 0x54, 0x61,
-	0x81, 0xc6, 0x51, 0x9d, 0xce, 0x70, 0xf0, 0x84, 0x61, 0x19, 0x89, 0x46, 0xf2, 0xe9, 0x1c, 0x96,
-	0x4b, 0x97, 0x1f, 0x6e, 0xad, 0x61, 0x43, 0x87, 0x4f, 0x96, 0xee, 0xec, 0x2d, 0x2f, 0x10, 0xfe,
-	0xa1, 0x6e, 0x79, 0xa4, 0xba, 0x49, 0xfd, 0xed, 0xba, 0x89, 0xbc, 0x81, 0xb4, 0x96, 0xdc, 0x40,
-	0x32, 0x7f, 0xa7, 0x0a, 0x90, 0xd8, 0x4a, 0xf7, 0xb2, 0xcc, 0x69, 0x5e, 0xa6, 0xda, 0x33, 0x7b,
-	0x7a, 0x7b, 0xca, 0x61, 0x24, 0xa7, 0x0d, 0x23, 0x0d, 0x28, 0xcd, 0x49, 0x14, 0xd9, 0xfb, 0x44,
-	0xdc, 0xc7, 0x90, 0xc9, 0x15, 0x87, 0x3a, 0x75, 0x84, 0x4f, 0xed, 0x3f, 0x16, 0xc5, 0x86, 0x95,
-	0xda, 0x7f, 0x5c, 0xf1, 0xe6, 0xd2, 0xdb, 0x78, 0x73, 0x03, 0x4a, 0x7c, 0x1c, 0x7a, 0xcd, 0x86,
-	0xaa, 0x32, 0x96, 0x49, 0x74, 0x47, 0x9d, 0xde, 0xad, 0xa4, 0xee, 0xb8, 0x24, 0x76, 0x5b, 0x39,
-	0xb6, 0x4b, 0x25, 0x42, 0x62, 0x47, 0x81, 0xcf, 0x5c, 0xe3, 0x58, 0x09, 0xcc, 0xf8, 0x58, 0xe0,
-	0x58, 0xdb, 0x50, 0x57, 0xaa, 0x72, 0x43, 0x2d, 0xd3, 0xee, 0x53, 0x3b, 0xcd, 0x7d, 0x5a, 0x7c,
-	0xdb, 0x8c, 0x6f, 0x50, 0x73, 0x1f, 0x14, 0x1e, 0x71, 0x61, 0xd5, 0x23, 0x44, 0x8f, 0x58, 0x77,
-	0xd2, 0x04, 0xf4, 0x10, 0x8c, 0x25, 0x3f, 0x1b, 0xc5, 0xbe, 0x40, 0x50, 0x43, 0x8a, 0x4b, 0x3f,
-	0x47, 0x6e, 0x6c, 0xaf, 0x6b, 0x40, 0x4a, 0x34, 0x1f, 0xa9, 0x43, 0xc6, 0x67, 0xa0, 0xce, 0x2f,
-	0x97, 0x4e, 0x7b, 0xad, 0xd1, 0xd8, 0xc2, 0xc6, 0x7b, 0x34, 0x00, 0x11, 0xa4, 0xd6, 0x8e, 0xd5,
-	0x1f, 0x1b, 0x19, 0x74, 0x16, 0xd6, 0x05, 0xc5, 0xfa, 0xc6, 0x6a, 0x4f, 0xc6, 0x03, 0x6c, 0x64,
-	0xcd, 0x7f, 0x55, 0x84, 0x22, 0x37, 0x08, 0x32, 0x61, 0x03, 0x5b, 0xad, 0xd1, 0xa0, 0x3f, 0x15,
-	0x77, 0x03, 0x15, 0x6e, 0xba, 0xdd, 0xea, 0xee, 0x5a, 0x1d, 0xe3, 0xbd, 0x14, 0xa6, 0x3f, 0x6e,
-	0x75, 0xfb, 0x16, 0x9e, 0x8a, 0x38, 0x47, 0x60, 0xce, 0xa3, 0xab, 0x70, 0xe9, 0x28, 0xa6, 0xdb,
-	0xeb, 0x8e, 0x5b, 0xe3, 0xee, 0xa0, 0x6f, 0x9c, 0x45, 0xd7, 0xe1, 0xda, 0x29, 0x80, 0x69, 0xa7,
-	0x3b, 0x7a, 0x62, 0x9c, 0x43, 0x37, 0xc1, 0x3c, 0x0d, 0xd5, 0xb3, 0x7a, 0x03, 0xfc, 0xad, 0x51,
-	0x46, 0x1b, 0xd0, 0x3c, 0x82, 0x1b, 0x62, 0xcb, 0xea, 0x0d, 0xc7, 0x56, 0xc7, 0x38, 0x73, 0xac,
-	0xca, 0x93, 0x61, 0xa7, 0x35, 0xb6, 0xa4, 0xca, 0x17, 0xd0, 0x2d, 0xb8, 0x2e, 0x30, 0xaa, 0xca,
-	0xd8, 0xda, 0xe9, 0x8e, 0xc6, 0x98, 0x17, 0x36, 0xee, 0xf6, 0xac, 0xc1, 0x64, 0x6c, 0xbc, 0x8f,
-	0x6e, 0xc3, 0xcd, 0xa3, 0xc8, 0x63, 0xb1, 0x0d, 0x4d, 0x33, 0x85, 0x1d, 0x5b, 0xb8, 0xd7, 0xed,
-	0xb7, 0xa8, 0x66, 0x19, 0x74, 0x0d, 0x2e, 0xaf, 0xf2, 0x69, 0x50, 0x48, 0xf3, 0xb2, 0xb0, 0xd5,
-	0x31, 0xb2, 0xe8, 0x32, 0x34, 0x04, 0x62, 0x1b, 0xb7, 0x7a, 0xd6, 0xb3, 0x01, 0x7e, 0x32, 0xc5,
-	0x56, 0x6f, 0xf0, 0xd4, 0xea, 0x18, 0x39, 0xda, 0xa0, 0x82, 0xbb, 0xd3, 0x9e, 0x5a, 0x18, 0x0f,
-	0xb0, 0x91, 0xd7, 0x0a, 0xed, 0xf6, 0x9f, 0xb6, 0x76, 0xbb, 0x9d, 0x44, 0xb4, 0xdb, 0x31, 0x0a,
-	0xe8, 0x22, 0x9c, 0x5f, 0xe1, 0x0f, 0xb6, 0xb7, 0x2d, 0x3c, 0x32, 0x8a, 0x9a, 0x28, 0xf7, 0x22,
-	0xda, 0x12, 0xed, 0x41, 0xbf, 0x6f, 0xb5, 0xa9, 0xbe, 0x25, 0x4d, 0x14, 0x5b, 0xed, 0x41, 0xbf,
-	0xdd, 0xdd, 0xed, 0xf2, 0x26, 0xad, 0x68, 0x8a, 0xaa, 0x6b, 0xce, 0x53, 0x19, 0x18, 0x23, 0x74,
-	0x05, 0x2e, 0x0a, 0x2e, 0xf3, 0xc5, 0x74, 0xbe, 0x80, 0x1a, 0x70, 0x2e, 0xc5, 0x96, 0x35, 0xac,
-	0xa2, 0x26, 0x5c, 0x58, 0xe1, 0x8c, 0xc6, 0x2d, 0x4c, 0xa5, 0x6a, 0x47, 0xa4, 0x64, 0x71, 0x75,
-	0xad, 0x38, 0x76, 0x4b, 0x9b, 0x05, 0xe0, 0xb2, 0xb6, 0xc6, 0x45, 0xcd, 0x21, 0x34, 0xf6, 0xa4,
-	0xdf, 0x9a, 0x8c, 0x1f, 0x0f, 0x70, 0xf7, 0x07, 0x56, 0xc7, 0x68, 0xf2, 0xab, 0xdf, 0x09, 0x46,
-	0x0a, 0xaf, 0x69, 0x15, 0x65, 0x8c, 0x94, 0xd8, 0xfa, 0xaa, 0x98, 0x54, 0xc9, 0x30, 0xef, 0x41,
-	0x69, 0xdb, 0xf5, 0x62, 0xc2, 0xce, 0x4a, 0xae, 0x85, 0x64, 0x6f, 0x19, 0x91, 0x69, 0xf2, 0x78,
-	0x05, 0xbb, 0xe1, 0xff, 0x00, 0xd7, 0x39, 0x43, 0xdc, 0x6c, 0x36, 0xff, 0x18, 0x54, 0xb5, 0x9b,
-	0xae, 0xe8, 0x2b, 0xa8, 0x1c, 0xda, 0xa1, 0x4b, 0xbb, 0xbf, 0x8c, 0x67, 0x2e, 0x1d, 0xbd, 0x10,
-	0xbb, 0xf9, 0x54, 0x60, 0x70, 0x82, 0x6e, 0xde, 0x87, 0xb2, 0x24, 0x1f, 0x1b, 0xe2, 0xa8, 0xfb,
-	0x4e, 0x59, 0xfd, 0xbe, 0xd3, 0x3d, 0xa8, 0xa8, 0x47, 0x28, 0x90, 0x01, 0xb9, 0x17, 0xe4, 0xb5,
-	0x90, 0xa2, 0x7f, 0x4f, 0x10, 0xfa, 0x29, 0x00, 0x25, 0x14, 0xa1, 0x4d, 0xa8, 0x2c, 0x64, 0x4a,
-	0xe8, 0x7c, 0xf4, 0x7d, 0x8b, 0x04, 0x62, 0x3e, 0x02, 0x68, 0x87, 0x64, 0x46, 0xfc, 0xd8, 0xb5,
-	0xbd, 0xd5, 0xdb, 0x27, 0xd9, 0xf4, 0xed, 0x93, 0x0b, 0x50, 0x8c, 0x88, 0x13, 0x92, 0x58, 0xdc,
-	0xbf, 0x10, 0x29, 0xf3, 0x11, 0x54, 0x93, 0x3c, 0x22, 0x74, 0x0f, 0xaa, 0x4e, 0x92, 0x14, 0x4a,
-	0xc8, 0x19, 0x29, 0x01, 0x62, 0x1d, 0x65, 0x3e, 0x83, 0x0a, 0xb6, 0x63, 0xc2, 0x0f, 0x2f, 0x19,
-	0x90, 0xfb, 0x6e, 0x21, 0x9a, 0x09, 0xd3, 0xbf, 0xab, 0xb7, 0x3f, 0x56, 0x14, 0x6b, 0x42, 0xd9,
-	0xb1, 0x17, 0xb6, 0x23, 0x1f, 0xa1, 0xc8, 0x63, 0x95, 0x36, 0xff, 0x6a, 0x06, 0x40, 0xe5, 0x4c,
-	0x9d, 0xa1, 0x28, 0x2e, 0x20, 0xa4, 0x8d, 0xa3, 0x20, 0x58, 0xf0, 0xd1, 0x16, 0x9c, 0xb7, 0xf7,
-	0xf7, 0x43, 0xb2, 0x4f, 0x63, 0x9f, 0x19, 0x3f, 0xd7, 0x3f, 0xa5, 0x6a, 0xf1, 0x43, 0x5c, 0x67,
-	0x15, 0x53, 0x9c, 0xf9, 0xff, 0xfe, 0x22, 0x42, 0x3f, 0x05, 0xcd, 0xa3, 0x32, 0x2b, 0xaa, 0x35,
-	0x56, 0x05, 0xdb, 0x52, 0xd5, 0x9f, 0x64, 0xa1, 0xd0, 0x9d, 0xd3, 0xe8, 0xe0, 0xf8, 0x2b, 0x88,
-	0x8c, 0xa7, 0xef, 0x06, 0xdd, 0x80, 0xbc, 0xbd, 0x58, 0x38, 0x62, 0xf1, 0x99, 0x86, 0xb5, 0x16,
-	0x0b, 0x07, 0x33, 0x36, 0xfa, 0x18, 0x8a, 0xb3, 0xc0, 0x79, 0x41, 0x56, 0x6f, 0x77, 0x71, 0x60,
-	0x87, 0xb1, 0xb0, 0x80, 0xa0, 0xcb, 0x50, 0x64, 0x5f, 0x06, 0x78, 0x60, 0x2c, 0xaf, 0x4b, 0x0b,
-	0x5a, 0xf3, 0xfb, 0x90, 0xa7, 0x19, 0x1f, 0xeb, 0xd3, 0x6b, 0xe2, 0x7b, 0x6d, 0x46, 0xdc, 0x1a,
-	0x49, 0xe6, 0xf4, 0xdc, 0x69, 0x97, 0x95, 0x06, 0x50, 0xe4, 0x2a, 0x1c, 0x9b, 0xe9, 0x5d, 0x80,
-	0xc4, 0x4d, 0x56, 0x2a, 0xaa, 0xf9, 0x92, 0x06, 0x32, 0x2f, 0x27, 0xd7, 0x62, 0x5a, 0xc3, 0x61,
-	0x9b, 0xdf, 0xb3, 0xed, 0x0c, 0xda, 0x4f, 0x2c, 0x3a, 0xff, 0xfe, 0xf3, 0x02, 0x14, 0xf9, 0x95,
-	0x25, 0xf6, 0x65, 0x4d, 0x45, 0x13, 0xec, 0xe6, 0x0f, 0x2f, 0xb9, 0xae, 0xa8, 0xec, 0x5e, 0xce,
-	0x25, 0xa8, 0x1c, 0x04, 0x51, 0x3c, 0xd5, 0x5e, 0x10, 0x60, 0x7b, 0x98, 0x43, 0xfe, 0x96, 0x0c,
-	0x7f, 0x32, 0x25, 0xc7, 0x5a, 0x0a, 0xa5, 0xee, 0x44, 0xe9, 0xaf, 0xa5, 0x98, 0x50, 0x70, 0xe7,
-	0x32, 0x0e, 0xac, 0x6e, 0xd5, 0xf4, 0x26, 0xc0, 0x9c, 0x85, 0x3e, 0x51, 0xc1, 0x57, 0x21, 0x15,
-	0xe5, 0x8a, 0xdc, 0x56, 0xee, 0x4b, 0xfd, 0x41, 0x4e, 0x45, 0x23, 0x9f, 0xa6, 0xb6, 0x11, 0x2f,
-	0x1e, 0x27, 0xa6, 0xbb, 0xcd, 0x36, 0xd4, 0x79, 0x63, 0x4f, 0x53, 0x17, 0xba, 0x3e, 0x38, 0x56,
-	0x8e, 0xb7, 0x8d, 0xb8, 0xe4, 0x55, 0x9b, 0x69, 0x29, 0xd4, 0x86, 0x5a, 0x64, 0xfb, 0xb3, 0xe7,
-	0xc1, 0xab, 0xa9, 0x7a, 0x63, 0x27, 0xd9, 0x1e, 0x4c, 0x67, 0x33, 0xe2, 0x40, 0x76, 0x67, 0xaa,
-	0x1a, 0x25, 0x89, 0x66, 0x0c, 0x35, 0xbd, 0x08, 0x3a, 0xc8, 0xcc, 0x42, 0xf7, 0x90, 0x8d, 0x5e,
-	0x6c, 0x90, 0xe1, 0xa9, 0x63, 0x5f, 0x78, 0xf9, 0x12, 0xd6, 0x38, 0x77, 0x1a, 0x2c, 0xf8, 0xa7,
-	0xd1, 0x5c, 0xca, 0x41, 0x92, 0x71, 0x11, 0xd7, 0x39, 0x70, 0xc0, 0x71, 0xcd, 0x5f, 0xce, 0x40,
-	0x55, 0x53, 0x09, 0x7d, 0x95, 0xb2, 0xe0, 0x8d, 0x37, 0x55, 0x41, 0xb7, 0x66, 0xf2, 0xb6, 0x44,
-	0x56, 0xbe, 0x2d, 0x61, 0x7e, 0x74, 0xdc, 0x6e, 0x55, 0x19, 0xf2, 0x23, 0x6b, 0x77, 0x9b, 0xfb,
-	0xe3, 0xb0, 0x85, 0x69, 0x90, 0x98, 0x35, 0xbf, 0x3c, 0x0e, 0x7a, 0x06, 0xea, 0xdc, 0x61, 0xa7,
-	0x4f, 0x07, 0xbb, 0x93, 0x9e, 0xc5, 0xf7, 0xb7, 0x46, 0xad, 0x7e, 0xe7, 0xd1, 0xe0, 0x9b, 0x29,
-	0xbb, 0xec, 0x95, 0x35, 0x2f, 0x24, 0x2f, 0xe8, 0xe0, 0x67, 0x46, 0x86, 0xfd, 0x0e, 0x8c, 0xac,
-	0xf9, 0xcf, 0x72, 0x50, 0xed, 0x93, 0x58, 0xbd, 0x97, 0x73, 0x01, 0x8a, 0x6c, 0x2f, 0x91, 0x9f,
-	0x92, 0xa8, 0x60, 0x91, 0x7a, 0xcb, 0x13, 0x11, 0xe8, 0x67, 0xa1, 0xe6, 0x2e, 0xa6, 0xe2, 0xae,
-	0xb7, 0xda, 0xa8, 0xbf, 0x2c, 0xc0, 0x5a, 0x41, 0x9b, 0xdd, 0xa1, 0xbc, 0x1a, 0x5e, 0x75, 0x17,
-	0x2d, 0x29, 0xa0, 0x5a, 0xae, 0xa8, 0xbd, 0x1e, 0xd0, 0x86, 0xfa, 0x22, 0x08, 0x63, 0x76, 0xf4,
-	0xd2, 0xf5, 0xf7, 0xe5, 0x02, 0x78, 0xe3, 0x98, 0x5c, 0x87, 0x41, 0x18, 0xf7, 0x38, 0x0c, 0xd7,
-	0x16, 0x49, 0x22, 0x6a, 0x3a, 0x50, 0x51, 0x45, 0xa2, 0x2f, 0xa0, 0xcc, 0x5e, 0xfa, 0x72, 0x02,
-	0x6f, 0x65, 0x3b, 0x3d, 0x95, 0x99, 0x80, 0x60, 0x05, 0x66, 0x5f, 0xc7, 0x54, 0xfd, 0xe4, 0xcd,
-	0x42, 0xa5, 0x7f, 0x73, 0x0e, 0x55, 0x4d, 0x83, 0x64, 0x30, 0x48, 0x9e, 0xfd, 0xe0, 0x83, 0x41,
-	0x10, 0xc6, 0x2b, 0x03, 0x0a, 0x45, 0xf0, 0x0b, 0xfb, 0xda, 0x80, 0x42, 0x61, 0x4d, 0x4d, 0x55,
-	0xfe, 0x6e, 0x86, 0x4a, 0x9b, 0x1b, 0x50, 0x96, 0x3a, 0x52, 0x87, 0xe9, 0x0e, 0x0f, 0xef, 0xf3,
-	0x17, 0x43, 0xba, 0xc3, 0xc3, 0xcf, 0x8d, 0xac, 0xf9, 0xe3, 0x02, 0xac, 0x25, 0x4f, 0xea, 0xb0,
-	0xf6, 0xed, 0xac, 0x3c, 0x00, 0x44, 0x27, 0xb6, 0x35, 0xd5, 0x0d, 0xd3, 0xe0, 0x13, 0x5f, 0x00,
-	0x32, 0x7f, 0xb1, 0x90, 0x7a, 0xda, 0x67, 0x65, 0x9f, 0xb5, 0xd0, 0x7e, 0x4c, 0xff, 0xfe, 0x76,
-	0x09, 0x9d, 0x81, 0x5a, 0xa7, 0xd5, 0x9e, 0x0e, 0x9e, 0x5a, 0x18, 0x77, 0x3b, 0x96, 0xf1, 0xaf,
-	0x4b, 0xe8, 0x1c, 0xac, 0x53, 0x12, 0xb6, 0x5a, 0x9d, 0xe9, 0xc8, 0x6a, 0xe1, 0xf6, 0x63, 0xe3,
-	0x77, 0x4a, 0xa8, 0x0a, 0xc5, 0xed, 0xc1, 0xb3, 0xbe, 0x85, 0x8d, 0xdf, 0xe5, 0x89, 0x91, 0x35,
-	0xee, 0x76, 0x8c, 0x7f, 0x53, 0x42, 0x15, 0xc8, 0x3f, 0xe9, 0xee, 0xee, 0x1a, 0xff, 0x96, 0xd1,
-	0x47, 0xd6, 0x78, 0xa7, 0xdb, 0x31, 0xfe, 0x9d, 0x4c, 0x4c, 0xba, 0x1d, 0xe3, 0xdf, 0x97, 0x50,
-	0x0d, 0x4a, 0x23, 0x6b, 0x3c, 0x6c, 0xb7, 0x86, 0xc6, 0x7f, 0x60, 0x45, 0xec, 0x76, 0xfb, 0x93,
-	0x6f, 0xa6, 0xdd, 0x5e, 0x6f, 0x32, 0x6e, 0x3d, 0xda, 0xb5, 0x8c, 0xdf, 0x2b, 0xa1, 0xf3, 0x60,
-	0xf4, 0xad, 0xf1, 0xf4, 0x51, 0xb7, 0x4f, 0x0b, 0xc6, 0x4f, 0xbb, 0x6d, 0xcb, 0xf8, 0x8f, 0x25,
-	0x84, 0xa0, 0xce, 0xc8, 0x78, 0xd0, 0xea, 0xb4, 0x5b, 0xa3, 0xb1, 0xf1, 0x9f, 0x4a, 0x68, 0x0d,
-	0x2a, 0x94, 0xd6, 0xea, 0xf4, 0xba, 0x7d, 0xe3, 0x3f, 0xb3, 0xec, 0x69, 0x1a, 0xb7, 0x9e, 0x19,
-	0xff, 0xa5, 0x84, 0xea, 0x50, 0xee, 0x0e, 0xdb, 0xd3, 0xdd, 0x41, 0xfb, 0x89, 0xf1, 0x5f, 0x19,
-	0x98, 0x26, 0xb9, 0xf6, 0xff, 0xad, 0x84, 0xd6, 0x01, 0x46, 0xdf, 0x8e, 0xa6, 0xbd, 0x41, 0x67,
-	0xb2, 0x6b, 0x19, 0xff, 0x9d, 0x01, 0x28, 0x01, 0xb7, 0x9e, 0x75, 0x07, 0xc6, 0xff, 0x50, 0x80,
-	0xf6, 0x63, 0x3c, 0x18, 0x8c, 0x8d, 0xff, 0xa9, 0x08, 0xc3, 0x31, 0x6e, 0xb5, 0x2d, 0xe3, 0x7f,
-	0x29, 0x89, 0x61, 0xab, 0xdd, 0x1e, 0x1b, 0xbf, 0xaf, 0xd2, 0x5c, 0x9f, 0xff, 0xcd, 0x34, 0xa0,
-	0xe9, 0x47, 0x54, 0xfe, 0x0f, 0x54, 0xb2, 0x4f, 0x6b, 0xf4, 0x7f, 0x98, 0xd1, 0x59, 0x79, 0x22,
-	0xd4, 0x37, 0xfe, 0x78, 0x59, 0x22, 0xe8, 0xe2, 0xc7, 0xf8, 0x61, 0x19, 0x9d, 0x85, 0x35, 0x96,
-	0x1c, 0x7f, 0x4b, 0x57, 0x5d, 0xdb, 0xdd, 0x1d, 0xe3, 0x17, 0xca, 0xb4, 0xdd, 0x7a, 0x4f, 0xfa,
-	0x83, 0x8e, 0xf1, 0x8b, 0xec, 0xff, 0xae, 0xd5, 0x1a, 0x59, 0xc6, 0x2f, 0x95, 0x91, 0x01, 0xd5,
-	0xd6, 0xa4, 0xd3, 0x1d, 0x4f, 0x9f, 0xe1, 0xee, 0xd8, 0x32, 0xfe, 0x44, 0x99, 0x9a, 0x8c, 0x53,
-	0xe8, 0x92, 0x0d, 0x0f, 0x76, 0x8d, 0x3f, 0x59, 0x16, 0x2d, 0xb0, 0x4d, 0x5b, 0xe0, 0x97, 0xcb,
-	0x54, 0x85, 0x9e, 0xde, 0xee, 0xbf, 0x52, 0xa6, 0x75, 0xa0, 0x24, 0x5e, 0x87, 0x3f, 0x55, 0x66,
-	0xed, 0xf7, 0xed, 0x68, 0x77, 0xb0, 0x63, 0xfc, 0xe9, 0x32, 0xb5, 0xc0, 0xb3, 0xd6, 0x13, 0x6b,
-	0xda, 0xda, 0x6d, 0xe1, 0x9e, 0xf1, 0x67, 0x58, 0x11, 0x8f, 0xa8, 0x81, 0xa7, 0xa3, 0xc9, 0x68,
-	0x68, 0xf5, 0x3b, 0xc6, 0xaf, 0x32, 0x10, 0x2f, 0x96, 0xfa, 0x8e, 0xf1, 0xa3, 0xb2, 0xf9, 0x04,
-	0x2a, 0xbb, 0xae, 0xbf, 0x7c, 0xc5, 0x1c, 0xfb, 0x67, 0x60, 0x5d, 0xb9, 0xe8, 0x6b, 0x79, 0x68,
-	0x4e, 0xff, 0xd6, 0x96, 0xf6, 0x6d, 0xbc, 0xe6, 0xa4, 0xd2, 0xe6, 0x6f, 0xe6, 0x00, 0x30, 0x0b,
-	0xea, 0xc4, 0x0b, 0x2d, 0xa5, 0x30, 0x15, 0xfb, 0xa9, 0x2b, 0xe5, 0x0a, 0x23, 0xfe, 0x62, 0x09,
-	0x6c, 0xfe, 0x7e, 0x16, 0x8a, 0x9c, 0x86, 0xee, 0xa6, 0xa6, 0x88, 0x2b, 0x27, 0xc9, 0xae, 0x4c,
-	0x0d, 0x07, 0x76, 0x38, 0x13, 0x97, 0x66, 0xd9, 0x7f, 0x4a, 0x8b, 0x82, 0xbd, 0x58, 0x04, 0x83,
-	0xec, 0xbf, 0xf9, 0x17, 0xb2, 0x27, 0x3c, 0x15, 0x82, 0x77, 0x7b, 0xe3, 0x69, 0x6b, 0x64, 0x64,
-	0x50, 0x1d, 0x2a, 0x2c, 0xd1, 0x1e, 0x60, 0xcb, 0xc8, 0xa2, 0x1a, 0x94, 0x79, 0x72, 0x38, 0x31,
-	0x72, 0x8a, 0xd9, 0x69, 0x8d, 0x5b, 0x46, 0x1e, 0xad, 0xd1, 0x6a, 0xf7, 0xc6, 0xd3, 0xed, 0x51,
-	0xf7, 0x07, 0x96, 0x51, 0x50, 0x69, 0x6a, 0x7d, 0xba, 0xec, 0x34, 0xa0, 0xc6, 0xd2, 0x3d, 0xab,
-	0xc7, 0x3c, 0x9e, 0xfa, 0x57, 0x9d, 0x53, 0x46, 0x3b, 0xdf, 0x9f, 0x58, 0x13, 0xcb, 0x28, 0xab,
-	0x3c, 0x99, 0x0b, 0x56, 0xd0, 0x3a, 0x54, 0x79, 0x72, 0xb0, 0xdd, 0xdd, 0xb5, 0x0c, 0x50, 0x99,
-	0xf6, 0x87, 0x78, 0xd0, 0x36, 0xaa, 0x4a, 0x23, 0x3c, 0x1a, 0x19, 0x35, 0x05, 0xc7, 0xe3, 0x21,
-	0xee, 0x0e, 0x8c, 0xba, 0x46, 0x60, 0x1e, 0xbb, 0xc6, 0xd6, 0xd2, 0x94, 0x30, 0xea, 0xee, 0x50,
-	0x6f, 0xe8, 0xf6, 0x77, 0x8c, 0x75, 0x95, 0xe9, 0x68, 0xdc, 0x6a, 0x3f, 0x31, 0x0c, 0xf3, 0x87,
-	0x19, 0x28, 0x8d, 0xc7, 0xdf, 0xb2, 0xe6, 0x7b, 0x08, 0xd5, 0x97, 0xae, 0x3f, 0x0b, 0x5e, 0x4e,
-	0x23, 0xf7, 0xe7, 0xe5, 0x3d, 0x76, 0x19, 0xeb, 0x08, 0xd0, 0xe6, 0x33, 0x86, 0x18, 0xb9, 0x3f,
-	0x4f, 0x30, 0xbc, 0x54, 0xff, 0x9b, 0x0f, 0x01, 0x12, 0x0e, 0xbf, 0x4b, 0xfd, 0x32, 0x92, 0xaf,
-	0x36, 0xd1, 0xff, 0xa8, 0x01, 0x25, 0x87, 0xce, 0xf6, 0x7e, 0x24, 0xc6, 0x6c, 0x99, 0x34, 0x7f,
-	0xbd, 0x0c, 0xf5, 0xd4, 0x3e, 0xa3, 0x16, 0x6e, 0x65, 0xb5, 0x70, 0x2b, 0x85, 0xd1, 0xbd, 0xe0,
-	0xc3, 0xe4, 0x2b, 0x17, 0xff, 0x76, 0xbf, 0x72, 0x73, 0x5e, 0x72, 0xd1, 0x17, 0x2b, 0x71, 0xfa,
-	0xd5, 0x63, 0x73, 0xe6, 0xd1, 0x12, 0x7f, 0x47, 0x40, 0xc4, 0xec, 0xe9, 0x8f, 0xec, 0xe9, 0x07,
-	0x5e, 0xee, 0x03, 0x7f, 0xfa, 0x52, 0xc4, 0x94, 0x1b, 0xc7, 0xe6, 0xd9, 0xa3, 0x34, 0xbe, 0x3f,
-	0xcf, 0xd8, 0xe8, 0x0b, 0x76, 0xc2, 0x9f, 0x6f, 0xac, 0xb3, 0xef, 0x9e, 0xa5, 0xd4, 0x23, 0x1f,
-	0xda, 0x94, 0xca, 0x0e, 0xf4, 0xcb, 0x44, 0x84, 0x3e, 0x03, 0xf0, 0x68, 0x07, 0xe6, 0xdd, 0xb5,
-	0x9c, 0x7a, 0xf6, 0x41, 0xf5, 0x6c, 0x5c, 0xf1, 0x54, 0x27, 0xdf, 0x82, 0x2a, 0xef, 0x6c, 0x5c,
-	0xa2, 0x92, 0x0a, 0xe0, 0x92, 0xde, 0x85, 0x81, 0xa3, 0x98, 0xcc, 0x47, 0x50, 0x8e, 0xe5, 0x88,
-	0x00, 0xa9, 0xcd, 0x53, 0xe1, 0x07, 0xb8, 0x14, 0xf3, 0x31, 0xa0, 0xf9, 0xa3, 0x3c, 0x40, 0x62,
-	0x31, 0xba, 0x84, 0xe6, 0x61, 0xb8, 0x78, 0x67, 0x84, 0x07, 0xde, 0x3b, 0x50, 0x12, 0x95, 0x10,
-	0x4f, 0x1f, 0x7e, 0xf8, 0x06, 0xcb, 0x4b, 0x03, 0x3c, 0xcc, 0x3f, 0x1e, 0x8c, 0xc6, 0x58, 0x4a,
-	0xa3, 0xe1, 0x6a, 0x58, 0xc3, 0xcf, 0xa5, 0x7e, 0xfc, 0xa6, 0xec, 0x4e, 0x8c, 0x71, 0xd0, 0x0d,
-	0x80, 0x45, 0xe8, 0x1e, 0xba, 0x1e, 0xd9, 0x57, 0x4b, 0x32, 0xf9, 0xe4, 0x60, 0xc2, 0x40, 0x77,
-	0x00, 0xd4, 0x9a, 0x7e, 0xf5, 0xa5, 0x9c, 0x64, 0xdd, 0xaf, 0x61, 0xd0, 0x2d, 0x30, 0xf6, 0x82,
-	0xd0, 0x21, 0xd3, 0xc5, 0xd2, 0xf3, 0xa6, 0xdc, 0x28, 0xec, 0x69, 0x2e, 0xbc, 0xc6, 0xe8, 0xc3,
-	0xa5, 0xe7, 0xf1, 0xc5, 0xe8, 0x87, 0x50, 0xe7, 0x1e, 0x3a, 0x15, 0x81, 0x79, 0x49, 0x3d, 0xf8,
-	0x54, 0xe3, 0x8c, 0x0e, 0xa3, 0xff, 0xbf, 0x0e, 0x95, 0xee, 0x41, 0x49, 0x34, 0x03, 0x7b, 0x51,
-	0x6d, 0x30, 0x12, 0x4f, 0x2a, 0x3d, 0xc2, 0xdd, 0xce, 0x8e, 0xc5, 0x5f, 0x85, 0xe9, 0x0f, 0xfa,
-	0x96, 0x91, 0xa3, 0xff, 0x26, 0x23, 0x0b, 0x1b, 0xf9, 0xe6, 0x67, 0x50, 0x51, 0xce, 0x9e, 0x2c,
-	0xca, 0x32, 0x27, 0x2e, 0xca, 0xcc, 0x2b, 0xc9, 0x1b, 0x34, 0x62, 0x0d, 0xc9, 0x9f, 0x59, 0xb0,
-	0x46, 0x83, 0x91, 0x91, 0x35, 0x7f, 0x2b, 0x03, 0xeb, 0x2b, 0x7b, 0xce, 0x47, 0x3b, 0x4f, 0xe6,
-	0x2d, 0x3b, 0xcf, 0x16, 0x54, 0x1d, 0x16, 0x9c, 0x73, 0xd7, 0x5e, 0x59, 0xed, 0xee, 0xab, 0x0f,
-	0xff, 0xe0, 0xa8, 0xff, 0xe8, 0x03, 0xed, 0x54, 0xcc, 0x42, 0xbc, 0x77, 0x59, 0x4f, 0x8e, 0xc0,
-	0x0c, 0xf9, 0x29, 0x9f, 0x95, 0x63, 0xdd, 0x99, 0xb7, 0x38, 0xd6, 0x6d, 0x3a, 0x00, 0x49, 0x99,
-	0xe8, 0x2e, 0xeb, 0x23, 0x53, 0xc7, 0x93, 0x37, 0xfa, 0x1a, 0x47, 0xf4, 0xa2, 0x35, 0x6b, 0xd3,
-	0x95, 0x83, 0xcf, 0x7e, 0x9b, 0x26, 0x14, 0x39, 0x85, 0x8d, 0xae, 0x9e, 0x1d, 0x45, 0xe2, 0xa8,
-	0x13, 0x1d, 0x5d, 0x79, 0xd2, 0xdc, 0x84, 0x22, 0x5f, 0x6f, 0xa0, 0xeb, 0x6a, 0x39, 0xc2, 0xcd,
-	0x55, 0xd3, 0x97, 0x23, 0xea, 0x7c, 0xf6, 0x67, 0x50, 0x60, 0x84, 0xd3, 0xb7, 0xc7, 0x92, 0xb7,
-	0xe8, 0xcc, 0xbf, 0x9e, 0x81, 0x3c, 0x73, 0xa5, 0x0b, 0x50, 0xf4, 0x97, 0xf3, 0xe7, 0xe2, 0xe1,
-	0xd0, 0x3a, 0x16, 0x29, 0x6d, 0x61, 0xa9, 0x3f, 0x6e, 0x76, 0xa2, 0xdb, 0xa1, 0x9f, 0x05, 0x38,
-	0x74, 0x23, 0x57, 0x7c, 0x6c, 0xcf, 0xb3, 0xf1, 0xe2, 0xea, 0x71, 0xdf, 0xb0, 0x36, 0x9f, 0x2a,
-	0x18, 0xd6, 0x44, 0xb4, 0x75, 0x57, 0xe1, 0xb4, 0x93, 0xe8, 0xb7, 0xa1, 0xc0, 0xef, 0x8b, 0x7e,
-	0x00, 0x85, 0x05, 0xbb, 0x47, 0xca, 0xed, 0x52, 0x95, 0xdd, 0x3a, 0x08, 0x63, 0xcc, 0x39, 0xe6,
-	0x6f, 0x66, 0xa1, 0x9e, 0x2a, 0x7b, 0x45, 0x4b, 0x3e, 0x53, 0xbd, 0x93, 0x96, 0xc7, 0x99, 0xe5,
-	0x5a, 0xfa, 0x8d, 0x40, 0x6e, 0x99, 0xd4, 0x7b, 0x80, 0x4d, 0x28, 0xb3, 0x7b, 0x08, 0x6e, 0xe0,
-	0xcb, 0x99, 0x48, 0xa6, 0xf5, 0xa7, 0xc6, 0x0a, 0xe9, 0xa7, 0xc6, 0x4c, 0x59, 0xc3, 0x62, 0xaa,
-	0x1f, 0xb2, 0xea, 0x8b, 0x2a, 0x6a, 0x56, 0x2b, 0x9d, 0x66, 0xb5, 0xcf, 0x01, 0x92, 0x0a, 0xd1,
-	0x20, 0x46, 0x6d, 0xc6, 0x8b, 0x57, 0x18, 0x77, 0x27, 0xec, 0x7b, 0x0d, 0x7b, 0x70, 0xd6, 0xfa,
-	0x66, 0x6c, 0xe1, 0x7e, 0x6b, 0x97, 0x2d, 0xc3, 0xe1, 0x19, 0x71, 0xf7, 0x0f, 0x62, 0xb9, 0x64,
-	0x7e, 0xc9, 0x52, 0xe2, 0xbc, 0xbf, 0x48, 0xf1, 0xc8, 0xc1, 0x53, 0x46, 0xa1, 0xff, 0xcd, 0x7f,
-	0x9a, 0x81, 0xea, 0x53, 0x5e, 0x11, 0x26, 0xab, 0x55, 0x93, 0x3b, 0xa7, 0xaa, 0x26, 0x3b, 0xd5,
-	0xee, 0x7a, 0xb3, 0xe9, 0x8c, 0x7f, 0x4e, 0x64, 0x2b, 0x4d, 0x46, 0xe9, 0xd8, 0x31, 0x49, 0xd8,
-	0xec, 0x9b, 0x14, 0xbf, 0xf9, 0xc9, 0xd9, 0x63, 0x77, 0xae, 0xb1, 0xd9, 0x87, 0xcd, 0xbc, 0x26,
-	0x3d, 0x89, 0x48, 0x88, 0xde, 0x87, 0xd2, 0xbe, 0x1b, 0x4f, 0xa3, 0x03, 0x5b, 0x58, 0xb7, 0xb8,
-	0xef, 0xc6, 0xa3, 0x03, 0x9b, 0xca, 0x51, 0x06, 0x3f, 0x7b, 0x29, 0x16, 0xe1, 0x95, 0x7d, 0x37,
-	0x7e, 0xc4, 0x08, 0x52, 0x2e, 0xb6, 0xf7, 0xc5, 0xa3, 0xb4, 0x54, 0x6e, 0x6c, 0xef, 0x9b, 0x77,
-	0x20, 0xbf, 0xed, 0xd9, 0xfb, 0x6f, 0xda, 0xbe, 0xd6, 0xba, 0xda, 0x9f, 0xcf, 0x40, 0x1e, 0x07,
-	0x27, 0xec, 0x78, 0x27, 0x26, 0xcd, 0xa6, 0x4c, 0xba, 0x05, 0xa0, 0x3e, 0xce, 0xcb, 0xf9, 0xf2,
-	0xb8, 0x4f, 0xf8, 0x1a, 0xea, 0x1d, 0x4f, 0x84, 0x98, 0x5b, 0x50, 0xec, 0x91, 0x38, 0x74, 0x9d,
-	0x37, 0xd7, 0x45, 0x3e, 0x8c, 0x66, 0xfe, 0x46, 0x06, 0xca, 0xdb, 0x2e, 0x7f, 0x3a, 0x47, 0x6d,
-	0xf1, 0x64, 0x92, 0x2d, 0x1e, 0x2a, 0xe6, 0x7b, 0xae, 0xcf, 0x23, 0x86, 0x02, 0xe6, 0x09, 0x16,
-	0xdd, 0xd3, 0xe8, 0x54, 0x46, 0xf7, 0x34, 0xdc, 0xbc, 0x01, 0x85, 0x39, 0x6b, 0xd2, 0xfc, 0xf1,
-	0x9f, 0x19, 0x39, 0x97, 0x8a, 0xb2, 0x9d, 0x44, 0xf6, 0x64, 0xab, 0xd8, 0x35, 0x34, 0x20, 0xb7,
-	0x14, 0xef, 0xd9, 0x55, 0x30, 0xfd, 0x4b, 0x29, 0xfb, 0xe2, 0x33, 0x6f, 0x05, 0xd3, 0xbf, 0xb7,
-	0xff, 0x08, 0x14, 0xc5, 0xbc, 0x73, 0x01, 0x50, 0x07, 0x77, 0x9f, 0x5a, 0x78, 0xda, 0x1f, 0xb0,
-	0x18, 0x1a, 0xf3, 0xcf, 0x5e, 0x08, 0xd6, 0x04, 0x1d, 0x4f, 0xfa, 0xe2, 0x81, 0xe5, 0x84, 0xd6,
-	0x7a, 0x34, 0x60, 0xb8, 0x9c, 0x46, 0x1b, 0x8d, 0x07, 0xc3, 0xa1, 0xd5, 0x31, 0xf2, 0xb7, 0x7f,
-	0x35, 0x0b, 0x15, 0xf5, 0xa9, 0x9b, 0xae, 0x1c, 0xd8, 0x77, 0x96, 0xd1, 0xb8, 0xb5, 0x43, 0xf3,
-	0x29, 0xd2, 0x95, 0x83, 0xa4, 0xe0, 0x31, 0x25, 0xbd, 0xa7, 0x40, 0xb2, 0xb0, 0x8c, 0xa2, 0x88,
-	0xe7, 0x7b, 0x8d, 0xb2, 0x12, 0xdb, 0xee, 0xf6, 0xbb, 0xa3, 0xc7, 0xec, 0xd3, 0xdb, 0x3a, 0x54,
-	0x39, 0x89, 0x7f, 0x23, 0xcc, 0x29, 0x02, 0x95, 0xa2, 0xba, 0xd0, 0xd5, 0x01, 0x23, 0xf0, 0x2f,
-	0x6f, 0x74, 0x91, 0x5c, 0x61, 0xe9, 0x5d, 0x3a, 0xf5, 0x17, 0x54, 0x29, 0x1d, 0xcc, 0x95, 0xaf,
-	0xa0, 0x73, 0x60, 0x88, 0xcf, 0x42, 0xd8, 0x6a, 0xb5, 0x1f, 0xb3, 0x6d, 0x03, 0x50, 0x62, 0x3b,
-	0x34, 0x36, 0xa8, 0xa2, 0x8b, 0x70, 0x5e, 0x25, 0xa7, 0x8f, 0xbe, 0x9d, 0x0e, 0x86, 0x16, 0x6e,
-	0x8d, 0x07, 0xd8, 0xa8, 0xa9, 0x1c, 0xd5, 0x97, 0xae, 0x47, 0x97, 0xff, 0xc1, 0x4f, 0x36, 0x32,
-	0xff, 0xf8, 0x27, 0x1b, 0x99, 0x7f, 0xf1, 0x93, 0x8d, 0xcc, 0x5f, 0xfc, 0x97, 0x1b, 0xef, 0xfd,
-	0x00, 0x58, 0x6b, 0xb2, 0xd9, 0xe1, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x65, 0x58, 0x85, 0x17,
-	0x72, 0x5e, 0x00, 0x00,
+	// 8633 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x7c, 0x49, 0x8c, 0x1b, 0xc9,
+	0x9a, 0x5e, 0x73, 0x27, 0x7f, 0x92, 0x55, 0xa9, 0xd4, 0xd2, 0x14, 0x25, 0x95, 0xaa, 0xf3, 0x49,
+	0x6a, 0xb5, 0xba, 0x5b, 0x2d, 0x95, 0xa4, 0x5e, 0xf4, 0x66, 0xa3, 0xc8, 0xac, 0x12, 0xa1, 0xe2,
+	0xf2, 0x82, 0xa4, 0xd4, 0xfd, 0x60, 0x20, 0x91, 0x4a, 0x66, 0x55, 0xe5, 0x88, 0xcc, 0x64, 0x67,
+	0x26, 0xb5, 0xcc, 0xc1, 0xf0, 0x9b, 0x19, 0x7b, 0xc6, 0xf6, 0x3c, 0x0f, 0x30, 0x80, 0x0d, 0x03,
+	0x63, 0xc3, 0x37, 0x8f, 0xaf, 0xbe, 0x18, 0x3e, 0x79, 0xe0, 0xe5, 0xe0, 0x93, 0x61, 0x1f, 0x0c,
+	0x18, 0xf6, 0x03, 0x6c, 0x3c, 0xfb, 0x30, 0x9e, 0xf1, 0x36, 0xde, 0x97, 0xf1, 0xb3, 0xf1, 0xc7,
+	0x96, 0x91, 0xac, 0x4d, 0x7a, 0x07, 0xc3, 0xa7, 0xcc, 0xf8, 0xff, 0xef, 0x8f, 0xf8, 0x23, 0xe2,
+	0x8f, 0x88, 0x3f, 0x56, 0xa8, 0xce, 0xdd, 0x28, 0x88, 0x6e, 0x2f, 0xc2, 0x20, 0x0e, 0xf4, 0x02,
+	0x0d, 0x18, 0xdf, 0x81, 0xea, 0x76, 0x68, 0xcf, 0xdd, 0x57, 0x41, 0xf8, 0xa2, 0xdb, 0xd1, 0xcf,
+	0x41, 0xe1, 0xa5, 0x3d, 0x5b, 0xba, 0x8d, 0xcc, 0x66, 0xf6, 0x66, 0x85, 0xb0, 0x80, 0x71, 0x15,
+	0x4a, 0x83, 0xbd, 0x3d, 0x37, 0x3c, 0x09, 0xd0, 0xda, 0x77, 0xfd, 0xf8, 0x58, 0xc0, 0x06, 0x14,
+	0xc7, 0x76, 0x74, 0x7c, 0x0a, 0x06, 0x80, 0xf9, 0xda, 0x75, 0x96, 0x71, 0x70, 0x7c, 0x22, 0x03,
+	0xa8, 0xb6, 0x03, 0x3f, 0xb6, 0x3d, 0xff, 0x78, 0x4d, 0xf4, 0x5b, 0x50, 0x5c, 0xd8, 0xa1, 0xeb,
+	0xc7, 0x8d, 0xec, 0x66, 0xe6, 0x66, 0x75, 0x4b, 0xbf, 0xcd, 0x32, 0xad, 0x48, 0x12, 0x8e, 0x30,
+	0x3e, 0x81, 0xf2, 0xd8, 0x9b, 0xbb, 0x5d, 0x7f, 0x2f, 0xd0, 0x37, 0xa1, 0xea, 0xdb, 0x7e, 0x10,
+	0xb9, 0x4e, 0xe0, 0x4f, 0x23, 0x1a, 0x67, 0x8e, 0xa8, 0x24, 0xe3, 0x0e, 0xd4, 0x3a, 0xcb, 0xd0,
+	0x8e, 0xbd, 0xc0, 0x7f, 0x4b, 0x89, 0x2e, 0x94, 0x5a, 0xd3, 0x69, 0xe8, 0x46, 0x91, 0xde, 0x84,
+	0xf2, 0x41, 0x10, 0xc5, 0xbe, 0x3d, 0x47, 0x7d, 0x33, 0x37, 0x2b, 0x44, 0x86, 0xf5, 0x35, 0xc8,
+	0x7a, 0x0b, 0xaa, 0x6e, 0x85, 0x64, 0xbd, 0x85, 0xae, 0x43, 0x7e, 0x11, 0x84, 0x71, 0x23, 0xb7,
+	0x99, 0xbd, 0x59, 0x20, 0xf4, 0xdf, 0xf8, 0x2b, 0x19, 0xc8, 0x4d, 0xc8, 0xae, 0x7e, 0x01, 0x8a,
+	0x91, 0x73, 0xe0, 0xce, 0x45, 0xae, 0x79, 0x48, 0xbf, 0x09, 0x25, 0x9b, 0x25, 0xd5, 0xc8, 0x6e,
+	0x66, 0x6f, 0x56, 0xb7, 0xd6, 0x78, 0xbe, 0xb9, 0x02, 0x44, 0xb0, 0x69, 0xec, 0x76, 0x7c, 0xd0,
+	0xc8, 0xd1, 0xf4, 0xe8, 0xbf, 0x7e, 0x03, 0x0a, 0xdf, 0x2e, 0xdd, 0xf0, 0x4d, 0x23, 0xbf, 0x99,
+	0xbb, 0x59, 0xdd, 0xd2, 0xb8, 0xec, 0xd0, 0x46, 0xcb, 0x88, 0xdd, 0x90, 0x30, 0x36, 0xe6, 0x62,
+	0x2f, 0xb4, 0xf7, 0xe7, 0x58, 0xbc, 0x05, 0x96, 0x0b, 0x11, 0x36, 0x0e, 0x60, 0x6d, 0xe2, 0xdb,
+	0x2f, 0x6d, 0x6f, 0x66, 0x3f, 0xf7, 0x66, 0x5e, 0xfc, 0x46, 0xbf, 0x0e, 0x85, 0x28, 0xb6, 0xc3,
+	0x98, 0xaa, 0x5a, 0xdd, 0x5a, 0xe7, 0xb1, 0x8a, 0x22, 0x27, 0x8c, 0xab, 0x7f, 0x06, 0xe5, 0x29,
+	0x2f, 0x57, 0x5e, 0x67, 0x67, 0x39, 0x52, 0x2d, 0x6e, 0x22, 0x41, 0xc6, 0x17, 0x50, 0xe9, 0xd9,
+	0xce, 0x81, 0xe7, 0xbb, 0xdd, 0xce, 0xbb, 0x14, 0xac, 0xf1, 0x77, 0x33, 0x50, 0x15, 0x92, 0xac,
+	0x06, 0xb3, 0xde, 0x94, 0x6b, 0x27, 0xf2, 0x2c, 0x63, 0x26, 0x59, 0x6f, 0xaa, 0x7f, 0x0c, 0xf9,
+	0x79, 0x30, 0x75, 0x69, 0x1c, 0x6b, 0x5b, 0xef, 0xaf, 0x60, 0xfc, 0xbd, 0xe0, 0x76, 0x2f, 0x98,
+	0xba, 0x84, 0x82, 0xf4, 0x9f, 0x85, 0xb5, 0x65, 0xaa, 0x04, 0x68, 0x19, 0x57, 0xb7, 0xce, 0x73,
+	0xb1, 0x74, 0xf1, 0x90, 0x15, 0xb0, 0x71, 0x03, 0xf2, 0x18, 0x99, 0x5e, 0x84, 0xec, 0x64, 0xa8,
+	0x65, 0xf4, 0x1a, 0x94, 0x3b, 0xa4, 0xd5, 0xed, 0x77, 0xfb, 0x3b, 0x5a, 0x56, 0x2f, 0x43, 0xbe,
+	0x33, 0x78, 0xd6, 0xd7, 0x72, 0xc6, 0x4f, 0xf2, 0x50, 0x4f, 0x9a, 0x2c, 0xe6, 0x43, 0x87, 0xfc,
+	0x32, 0x72, 0x43, 0x6e, 0x12, 0xf4, 0x1f, 0x69, 0xb4, 0x4c, 0xb2, 0x8c, 0x86, 0xff, 0xba, 0x41,
+	0xf3, 0x9b, 0x4b, 0xb5, 0x0b, 0xa5, 0xf1, 0xd3, 0x1c, 0x7f, 0x02, 0xda, 0x9e, 0xed, 0xcd, 0x82,
+	0x97, 0x6e, 0x68, 0xc5, 0xde, 0xdc, 0x0d, 0x96, 0x71, 0x23, 0xbf, 0x99, 0xb9, 0x99, 0x79, 0x98,
+	0xb9, 0x43, 0xd6, 0x05, 0x6b, 0xcc, 0x38, 0xfa, 0x75, 0x00, 0xe7, 0xc0, 0x75, 0x5e, 0x2c, 0x02,
+	0x8f, 0x9b, 0x44, 0xf9, 0x61, 0x61, 0xcf, 0x9e, 0x45, 0x2e, 0x51, 0x18, 0xfa, 0x79, 0xc8, 0x87,
+	0xc1, 0xcc, 0x6d, 0x14, 0xb1, 0x2a, 0x1e, 0x66, 0x6e, 0x11, 0x1a, 0x4c, 0xd5, 0x5d, 0x69, 0xa5,
+	0xee, 0x2e, 0x43, 0x65, 0x11, 0x7a, 0xbe, 0xe3, 0x2d, 0xec, 0x59, 0xa3, 0x4c, 0x99, 0x09, 0x41,
+	0xbf, 0x04, 0x95, 0x57, 0xee, 0xf3, 0xa5, 0x67, 0x2d, 0xc3, 0x59, 0xa3, 0xc2, 0x44, 0x29, 0x61,
+	0x12, 0xce, 0xf4, 0x36, 0xd4, 0x1c, 0x7b, 0xc1, 0x8a, 0xd5, 0x73, 0xa3, 0x06, 0x50, 0xa3, 0xbe,
+	0x7a, 0x28, 0xc3, 0x58, 0x7d, 0x6d, 0x01, 0x7c, 0x43, 0x52, 0x42, 0xfa, 0x75, 0x28, 0xce, 0xec,
+	0xe7, 0xee, 0x2c, 0x6a, 0x54, 0x69, 0x79, 0xd5, 0xb9, 0xf8, 0x2e, 0x25, 0x12, 0xce, 0xc4, 0x4e,
+	0x08, 0xb3, 0x12, 0x35, 0x6a, 0x9b, 0x39, 0xec, 0x84, 0x68, 0xa0, 0xf9, 0xcf, 0x32, 0x00, 0x49,
+	0xcc, 0xfa, 0x97, 0x90, 0x8f, 0xdf, 0x2c, 0x98, 0x7d, 0xae, 0x6d, 0x5d, 0x3b, 0x45, 0x91, 0xdb,
+	0xe3, 0x37, 0x0b, 0x97, 0x50, 0x09, 0xe3, 0xb7, 0x32, 0x90, 0xc7, 0xa0, 0x5e, 0x85, 0xd2, 0xa4,
+	0xff, 0xa4, 0x8f, 0x16, 0xf0, 0x9e, 0xfe, 0x3e, 0x9c, 0x25, 0xe6, 0xd3, 0x41, 0xbb, 0xf5, 0x68,
+	0xd7, 0xb4, 0x88, 0x39, 0x1a, 0x4c, 0x48, 0xdb, 0x1c, 0x69, 0x19, 0xfd, 0x02, 0xe8, 0xe3, 0xd6,
+	0xe8, 0x89, 0xf5, 0xa4, 0xbb, 0xbb, 0xdb, 0xed, 0xef, 0x58, 0xa3, 0x71, 0x6b, 0x6c, 0x6a, 0x59,
+	0xfd, 0x0c, 0xd4, 0x77, 0x86, 0x13, 0x05, 0x9a, 0xd3, 0xcf, 0x81, 0x36, 0x7a, 0xdc, 0x22, 0x66,
+	0x47, 0xa1, 0xe6, 0xf5, 0xb3, 0xb0, 0x3e, 0x6c, 0x91, 0x71, 0x77, 0xdc, 0x1d, 0xf4, 0xad, 0xd6,
+	0xb3, 0x16, 0x31, 0xb5, 0x82, 0xbe, 0x06, 0xd0, 0x9b, 0xec, 0x8e, 0xbb, 0x16, 0x19, 0xec, 0x9a,
+	0x5a, 0xd1, 0xf8, 0xf5, 0x02, 0x54, 0x1f, 0xbb, 0xf6, 0x2c, 0x3e, 0x68, 0x63, 0x15, 0xeb, 0xf7,
+	0x21, 0x7f, 0x10, 0xc7, 0x0b, 0x9a, 0xbd, 0xea, 0xd6, 0x26, 0xcf, 0x9e, 0x82, 0xb8, 0xfd, 0x78,
+	0x3c, 0x1e, 0xd2, 0x3f, 0xda, 0x92, 0x29, 0x5a, 0xff, 0x10, 0xea, 0x53, 0x77, 0x66, 0xbf, 0xb1,
+	0x44, 0x07, 0x9a, 0xa5, 0x56, 0x96, 0xbd, 0xfb, 0x80, 0xd4, 0x28, 0x63, 0xc4, 0xe8, 0xfa, 0xa7,
+	0xa0, 0x79, 0x7e, 0xec, 0x86, 0x2f, 0xed, 0x99, 0xc4, 0xe6, 0x38, 0xf6, 0x0e, 0x59, 0x17, 0x3c,
+	0x01, 0xff, 0x18, 0xd6, 0xb9, 0xdd, 0x4a, 0x34, 0xb3, 0xdf, 0xec, 0xd6, 0x1d, 0xb2, 0xc6, 0x59,
+	0x02, 0x7c, 0x1f, 0xce, 0x39, 0x81, 0x1f, 0xe1, 0xc0, 0xe3, 0xbd, 0x74, 0x2d, 0x34, 0xef, 0x65,
+	0xe8, 0x46, 0xd4, 0x92, 0xeb, 0x0f, 0x33, 0xf7, 0xc8, 0x59, 0x85, 0xbd, 0xcd, 0xb9, 0x28, 0xb5,
+	0x1f, 0xda, 0x8e, 0x6b, 0x2d, 0xdc, 0xd0, 0x0b, 0xa6, 0x32, 0x9d, 0xa2, 0xd4, 0x4a, 0xa7, 0xfc,
+	0x21, 0x65, 0x8b, 0xb4, 0x3e, 0x81, 0x92, 0x13, 0xcc, 0xe7, 0xb6, 0x3f, 0x6d, 0x94, 0x52, 0x4d,
+	0xb0, 0xcd, 0xa8, 0xb4, 0x6c, 0x04, 0x04, 0x7b, 0x1e, 0x6a, 0x33, 0xe5, 0x54, 0xcf, 0xa3, 0x16,
+	0x6a, 0x62, 0x26, 0xfa, 0x5d, 0xc8, 0xc5, 0xce, 0x82, 0x36, 0x84, 0xc4, 0xd0, 0x53, 0xd8, 0xb6,
+	0x52, 0xfe, 0x88, 0x6d, 0xbe, 0x80, 0x7a, 0xaa, 0x56, 0xe4, 0xa8, 0x83, 0x9d, 0x48, 0x9d, 0x8d,
+	0x3a, 0x72, 0xac, 0xc8, 0x2a, 0x63, 0x45, 0x32, 0x02, 0xb1, 0x11, 0x84, 0x87, 0xb0, 0x31, 0x47,
+	0xb1, 0x1d, 0x2f, 0x23, 0x37, 0xa2, 0xc3, 0x48, 0x9d, 0xc8, 0x70, 0xd3, 0x80, 0x9a, 0xaa, 0xc1,
+	0x51, 0x69, 0x19, 0xf7, 0x8e, 0xb2, 0xf4, 0x2a, 0x94, 0xda, 0x83, 0x5e, 0xaf, 0xd5, 0xef, 0x68,
+	0x19, 0xec, 0x02, 0x51, 0x65, 0x2d, 0xab, 0x97, 0x20, 0x37, 0x6e, 0x0f, 0xb5, 0x9c, 0xd1, 0x01,
+	0x78, 0xe2, 0xcd, 0x66, 0xc3, 0x60, 0xe6, 0x39, 0x6f, 0xf4, 0xcf, 0xa1, 0xa6, 0xd6, 0x4b, 0x23,
+	0x73, 0xfc, 0x68, 0x52, 0x55, 0x2a, 0xc8, 0xf8, 0xbd, 0x2c, 0x7a, 0x16, 0xb2, 0x12, 0xf4, 0x5b,
+	0x90, 0x5f, 0x86, 0x1e, 0x0e, 0xe9, 0xd8, 0x71, 0x5c, 0x38, 0x5c, 0x4d, 0xb7, 0x27, 0xa4, 0x4b,
+	0x28, 0x46, 0xbf, 0x0f, 0x55, 0xd7, 0x7f, 0xe9, 0x85, 0x81, 0x3f, 0x3f, 0xec, 0x74, 0x98, 0x09,
+	0x87, 0xa8, 0xb0, 0xc4, 0x77, 0x61, 0x65, 0xc8, 0x02, 0xb2, 0x1f, 0x67, 0x43, 0x2b, 0xfd, 0xd7,
+	0x9b, 0x50, 0x88, 0x0e, 0xdc, 0xd9, 0x8c, 0x1a, 0x57, 0xf9, 0x61, 0x3e, 0x0e, 0x97, 0x2e, 0x61,
+	0x24, 0xec, 0x23, 0xed, 0x70, 0x7f, 0x89, 0x31, 0x46, 0x8d, 0x12, 0xed, 0x80, 0x12, 0x42, 0xf3,
+	0xb7, 0xa8, 0xcb, 0xd0, 0x3d, 0xc6, 0x4f, 0xda, 0x00, 0x70, 0xa9, 0xc3, 0x65, 0x3f, 0x9f, 0xb1,
+	0xf1, 0xad, 0x4c, 0x14, 0x8a, 0xbe, 0x01, 0x25, 0xf7, 0x75, 0x1c, 0xda, 0x4e, 0xdc, 0xc8, 0x29,
+	0x29, 0x0b, 0x22, 0xc6, 0xea, 0xd8, 0xce, 0x81, 0x4b, 0x1b, 0x57, 0x99, 0xb0, 0x80, 0x7e, 0x15,
+	0xaa, 0xc1, 0x32, 0x5e, 0x2c, 0x63, 0x6b, 0xcf, 0x9b, 0xb9, 0x3c, 0x23, 0xc0, 0x48, 0xdb, 0xde,
+	0xcc, 0x35, 0xfe, 0x41, 0x1e, 0x6a, 0xd2, 0xd1, 0xc3, 0xb2, 0xde, 0x82, 0xaa, 0xcb, 0xc3, 0x96,
+	0x1c, 0x8c, 0xcf, 0x88, 0xf2, 0x93, 0x2e, 0xa1, 0xd0, 0x2d, 0x08, 0xbb, 0x53, 0x2c, 0xa7, 0xa9,
+	0x1d, 0xdb, 0x34, 0xe9, 0x1a, 0xa1, 0xff, 0xfa, 0xa7, 0x50, 0x09, 0xdd, 0x28, 0x58, 0x86, 0x0e,
+	0x6d, 0xbe, 0x39, 0xc5, 0xe1, 0x20, 0x9c, 0x4e, 0x12, 0xc4, 0x3b, 0x36, 0xc6, 0x07, 0x50, 0xdb,
+	0x13, 0xbd, 0x35, 0x6a, 0x59, 0x3e, 0x76, 0x08, 0xad, 0x4a, 0x1c, 0xd3, 0x93, 0x8e, 0x6d, 0x6c,
+	0x80, 0xa2, 0xff, 0x7a, 0x13, 0x8a, 0x4c, 0x87, 0x06, 0x20, 0xf5, 0x51, 0xb6, 0x91, 0x21, 0x9c,
+	0xa2, 0x6f, 0x41, 0xc5, 0x11, 0x6e, 0x2a, 0x1f, 0x76, 0xce, 0x1d, 0x72, 0x5f, 0x51, 0xb1, 0x04,
+	0x86, 0x32, 0x53, 0x2f, 0x72, 0x70, 0x50, 0x7e, 0xd3, 0xa8, 0xa5, 0x64, 0x3a, 0x82, 0xce, 0x64,
+	0x24, 0x4c, 0xdf, 0x81, 0xf3, 0xd1, 0xc1, 0x32, 0x9e, 0x06, 0xaf, 0x7c, 0x2b, 0xd5, 0x60, 0xea,
+	0xc7, 0x37, 0x98, 0xb3, 0x42, 0x62, 0x27, 0x69, 0x38, 0xca, 0x20, 0xb9, 0x76, 0xd2, 0x20, 0xf9,
+	0x09, 0xef, 0xcb, 0xd6, 0x69, 0x5f, 0xd6, 0x58, 0xad, 0x5c, 0x6c, 0x50, 0xca, 0x98, 0xf7, 0xc9,
+	0x31, 0x1d, 0x41, 0xc7, 0xdc, 0x6e, 0x4d, 0x76, 0xc7, 0x5a, 0x46, 0x07, 0x28, 0xb6, 0x27, 0xa3,
+	0xf1, 0xa0, 0xa7, 0x65, 0x8d, 0xbf, 0x99, 0x01, 0xe8, 0xd9, 0x51, 0xcc, 0x4a, 0x86, 0xba, 0x7c,
+	0x53, 0x6e, 0xe9, 0xe8, 0xce, 0x08, 0x17, 0x10, 0xfb, 0x19, 0xf4, 0xad, 0x1b, 0x8a, 0x6f, 0x5d,
+	0x7f, 0x98, 0x7f, 0x70, 0xe7, 0xc1, 0x1d, 0xde, 0xd7, 0x69, 0x90, 0x5b, 0x78, 0x53, 0x6a, 0x53,
+	0x15, 0x82, 0xbf, 0x29, 0xf7, 0xa4, 0xb0, 0xe2, 0x9e, 0x34, 0xa0, 0xf4, 0xd2, 0x0d, 0x23, 0xf4,
+	0x59, 0xa9, 0x53, 0x43, 0x44, 0x50, 0xf5, 0xc4, 0x99, 0x65, 0x1d, 0xe7, 0x89, 0x1b, 0x7f, 0x27,
+	0x03, 0x15, 0x36, 0x6b, 0x42, 0xcd, 0xd3, 0x8e, 0x6c, 0x36, 0x95, 0x5a, 0xca, 0xb8, 0x73, 0xa7,
+	0x1a, 0xf7, 0x1d, 0x00, 0x3b, 0x8e, 0x43, 0xef, 0xf9, 0x32, 0x96, 0x8d, 0x41, 0xf8, 0xb7, 0x2d,
+	0xc1, 0x20, 0x0a, 0x46, 0xdf, 0xa0, 0xc5, 0x56, 0x4c, 0xeb, 0xcb, 0x26, 0x74, 0xb4, 0x18, 0x45,
+	0xb1, 0xa1, 0xe1, 0x17, 0x68, 0xb1, 0xdd, 0xe5, 0xdd, 0xf6, 0xdf, 0xcb, 0x41, 0xe1, 0x29, 0xed,
+	0x51, 0xae, 0x4b, 0x2f, 0x27, 0x7b, 0x73, 0x4d, 0x36, 0x61, 0xca, 0x53, 0xc7, 0xaa, 0x8f, 0x71,
+	0xfc, 0xb0, 0x67, 0x76, 0xb8, 0xe2, 0xec, 0x33, 0xe0, 0x88, 0xb2, 0x08, 0x87, 0x20, 0x38, 0xb4,
+	0xfd, 0x7d, 0x37, 0x6a, 0xe4, 0x8e, 0x00, 0x13, 0xca, 0x22, 0x1c, 0xa2, 0x1b, 0x90, 0x8b, 0x5c,
+	0xe6, 0xad, 0x26, 0xf9, 0xe5, 0xd1, 0xba, 0x31, 0x41, 0x26, 0x55, 0xd2, 0x7d, 0xcd, 0x5c, 0xd5,
+	0xea, 0xaa, 0x92, 0xee, 0xeb, 0x98, 0x50, 0x76, 0x73, 0x03, 0x8a, 0x4c, 0x93, 0x74, 0xef, 0x99,
+	0xe1, 0xbd, 0x67, 0xf3, 0x33, 0x28, 0xd0, 0xc4, 0x91, 0xfd, 0xdc, 0xdd, 0xf7, 0x7c, 0xca, 0xce,
+	0x13, 0x16, 0x40, 0x5b, 0x72, 0xfd, 0x29, 0x35, 0xbb, 0x3c, 0xc1, 0xdf, 0xe6, 0x16, 0x14, 0x99,
+	0xb6, 0xfa, 0x4d, 0x28, 0x50, 0x7d, 0xf9, 0xe8, 0xa2, 0x1f, 0xce, 0x11, 0x61, 0x80, 0xe6, 0x45,
+	0xc8, 0x8d, 0x5c, 0x3a, 0x08, 0x7b, 0xb1, 0x3b, 0xa7, 0xf8, 0x0a, 0xa1, 0xff, 0xcd, 0xcb, 0x90,
+	0x47, 0x6d, 0x8f, 0x99, 0x28, 0xdf, 0xe5, 0x2d, 0x08, 0xa0, 0x38, 0x6a, 0xb7, 0x76, 0x5b, 0x44,
+	0x7b, 0x0f, 0xff, 0x49, 0xab, 0xbf, 0x43, 0xdd, 0xc4, 0x12, 0xe4, 0x46, 0xe6, 0x98, 0x4d, 0x2a,
+	0xc6, 0xe6, 0xd7, 0x63, 0x2d, 0x67, 0xfc, 0x01, 0xda, 0xa2, 0xb0, 0x07, 0xd9, 0x71, 0x65, 0x94,
+	0xc9, 0x83, 0xa8, 0xde, 0xec, 0xdb, 0x56, 0x6f, 0xee, 0x5d, 0xaa, 0x37, 0x7f, 0x7a, 0xf5, 0xbe,
+	0x5d, 0xd5, 0x09, 0x2b, 0x28, 0x9e, 0x60, 0x05, 0xc6, 0x5f, 0xad, 0x40, 0x59, 0x34, 0x9c, 0xff,
+	0x3f, 0x33, 0xcb, 0x73, 0x51, 0x38, 0xc9, 0x96, 0x8f, 0x99, 0x55, 0x7d, 0x02, 0xf9, 0xa9, 0x17,
+	0xbd, 0xe0, 0xbd, 0x4f, 0x63, 0xa5, 0x9f, 0xc0, 0x51, 0x81, 0xbb, 0xe1, 0x88, 0xd2, 0x5b, 0x50,
+	0x0d, 0xdd, 0x08, 0x3d, 0x68, 0x3a, 0x01, 0x2f, 0xa7, 0x5c, 0x48, 0x29, 0x44, 0x12, 0x08, 0x95,
+	0x55, 0x65, 0xf4, 0xef, 0x62, 0xef, 0xf4, 0x32, 0x70, 0xa8, 0x27, 0xc1, 0x7c, 0xd0, 0x2b, 0x87,
+	0x23, 0xe0, 0x00, 0x36, 0x16, 0x49, 0xbc, 0xbe, 0x05, 0xc5, 0xe8, 0xc0, 0x0e, 0xdd, 0x29, 0x1d,
+	0x0f, 0xab, 0x5b, 0xcd, 0x55, 0xc9, 0x11, 0xe5, 0x52, 0x31, 0x8e, 0xd4, 0x77, 0x60, 0xdd, 0x9e,
+	0xcd, 0x02, 0x87, 0x26, 0x6f, 0x79, 0xfe, 0x5e, 0xc0, 0x47, 0xcb, 0x8d, 0x55, 0xe1, 0x96, 0x84,
+	0xd1, 0x08, 0xd6, 0xec, 0x54, 0xb8, 0xf9, 0x14, 0xd6, 0x57, 0x72, 0x96, 0x9e, 0x77, 0x66, 0x56,
+	0xe7, 0x9d, 0xc9, 0x80, 0x97, 0x3d, 0x61, 0xc0, 0x6b, 0xfe, 0x20, 0x0f, 0x65, 0x51, 0xce, 0xfa,
+	0x36, 0x54, 0x17, 0x38, 0x36, 0x44, 0xb1, 0xeb, 0x3b, 0x2e, 0x77, 0x4a, 0xaf, 0x1d, 0x57, 0x2d,
+	0xb7, 0x87, 0x09, 0x96, 0xa8, 0x82, 0x98, 0xf6, 0xcb, 0x60, 0xb6, 0x9c, 0xbb, 0x2b, 0x69, 0x3f,
+	0xa5, 0x44, 0xc2, 0x99, 0xfa, 0x17, 0xd2, 0xc1, 0xc8, 0x1d, 0x5d, 0x97, 0x32, 0xa5, 0x11, 0x0d,
+	0x0b, 0xef, 0xa3, 0xf9, 0x5d, 0xa8, 0x2a, 0x69, 0x1f, 0x1a, 0x49, 0x53, 0x05, 0x93, 0x5d, 0x29,
+	0x98, 0xe6, 0x6f, 0x66, 0xa1, 0xc8, 0xe2, 0x53, 0x66, 0xbb, 0x59, 0x65, 0xb6, 0x7b, 0x5c, 0xf2,
+	0x6a, 0x73, 0xfa, 0x52, 0x99, 0x6e, 0x54, 0x4f, 0x97, 0x1c, 0xda, 0xf1, 0x01, 0x9f, 0x94, 0x7c,
+	0x17, 0x0a, 0xf3, 0x60, 0xe9, 0xc7, 0x3c, 0xcf, 0xd7, 0x4f, 0x13, 0xed, 0x21, 0x98, 0x30, 0x99,
+	0x66, 0x13, 0xf2, 0x18, 0x15, 0x76, 0x04, 0x61, 0x10, 0xc4, 0xa2, 0x23, 0xc0, 0xff, 0xe6, 0x25,
+	0x28, 0x50, 0xec, 0x51, 0x4c, 0xe3, 0x12, 0xef, 0x67, 0xcb, 0x90, 0x1f, 0xb6, 0xc6, 0x8f, 0xb5,
+	0x8c, 0x5e, 0x81, 0x42, 0x6f, 0x30, 0xe9, 0x8f, 0xb5, 0x6c, 0x73, 0x1d, 0xea, 0x29, 0xa3, 0x6f,
+	0xd6, 0x00, 0x12, 0x5b, 0x6e, 0x5e, 0x83, 0xb5, 0xb4, 0x71, 0xb2, 0x14, 0x66, 0x62, 0x15, 0x8b,
+	0xfe, 0x1b, 0x7f, 0x2e, 0x0b, 0x8d, 0x71, 0x68, 0xef, 0xed, 0x79, 0x0e, 0x7a, 0x80, 0x61, 0x30,
+	0x1b, 0xc5, 0x76, 0xec, 0x45, 0xb1, 0xe7, 0x44, 0x87, 0x6a, 0xa8, 0x01, 0xa5, 0xe7, 0xb6, 0xf3,
+	0x62, 0x16, 0xec, 0xd3, 0x12, 0xcc, 0x13, 0x11, 0xa4, 0xa3, 0xd4, 0x9b, 0x98, 0x8f, 0xa2, 0x79,
+	0xc2, 0x02, 0x48, 0x9d, 0x86, 0xc1, 0x82, 0xf5, 0x47, 0x79, 0xc2, 0x02, 0x38, 0x31, 0x40, 0x2f,
+	0x71, 0xe6, 0xcd, 0xbd, 0x98, 0x4d, 0x84, 0xf3, 0x44, 0xa1, 0x60, 0x2a, 0x0b, 0xdb, 0x79, 0xe1,
+	0xc6, 0x6c, 0xbe, 0x9b, 0x27, 0x22, 0x88, 0x19, 0xf8, 0x76, 0xe6, 0xfa, 0xb4, 0xe3, 0xc9, 0x13,
+	0xfa, 0x8f, 0xe8, 0xd0, 0x8e, 0xdd, 0xe7, 0x8b, 0x88, 0x76, 0x2d, 0x79, 0x22, 0x82, 0x82, 0xb3,
+	0x58, 0x44, 0x8d, 0x4a, 0xc2, 0x59, 0x2c, 0xe8, 0x5a, 0x69, 0xe8, 0x7e, 0xbb, 0x74, 0x97, 0x74,
+	0xed, 0x06, 0x59, 0x32, 0x6c, 0xfc, 0x6a, 0x01, 0x6a, 0xdd, 0x85, 0x52, 0x08, 0x1b, 0x00, 0xdb,
+	0x41, 0xf8, 0xca, 0x0e, 0xa7, 0x9e, 0xbf, 0x4f, 0xcb, 0x2e, 0x47, 0x14, 0x0a, 0xf2, 0x3b, 0xee,
+	0x9e, 0xbd, 0x9c, 0xc5, 0xe3, 0xf1, 0x2e, 0x2d, 0x97, 0x1c, 0x51, 0x28, 0xc8, 0xef, 0xfa, 0xc4,
+	0x75, 0x5c, 0xef, 0x25, 0x2f, 0x9f, 0x1c, 0x51, 0x28, 0xb8, 0xca, 0xdb, 0xf5, 0x1f, 0x4f, 0x43,
+	0x33, 0x0c, 0x83, 0x90, 0x15, 0x55, 0x8e, 0xa8, 0x24, 0xdd, 0x80, 0x5a, 0xd7, 0x6f, 0x4d, 0x45,
+	0x98, 0x16, 0x59, 0x8e, 0xa4, 0x68, 0xfa, 0x35, 0xa8, 0xa3, 0x4e, 0x1d, 0x3b, 0xb6, 0xf7, 0x43,
+	0x7b, 0xce, 0x8a, 0x2e, 0x47, 0xd2, 0x44, 0xfd, 0x26, 0xac, 0x77, 0xfd, 0x89, 0xff, 0xc2, 0x0f,
+	0x5e, 0xf9, 0x43, 0x5c, 0xa4, 0x67, 0x2e, 0x64, 0x8e, 0xac, 0x92, 0x99, 0xd6, 0xe8, 0xdf, 0xdb,
+	0xe1, 0x94, 0x95, 0x6c, 0x8e, 0x28, 0x14, 0xce, 0x77, 0x67, 0x1e, 0x7a, 0xa5, 0x8d, 0x8a, 0xe4,
+	0x73, 0x0a, 0xe6, 0x6a, 0xb0, 0x8c, 0x09, 0x96, 0x6a, 0x14, 0xb3, 0x52, 0xce, 0x11, 0x95, 0xc4,
+	0x11, 0x32, 0x89, 0xaa, 0x44, 0xc8, 0x34, 0x18, 0xa2, 0x1f, 0x90, 0x80, 0xba, 0x99, 0x35, 0x89,
+	0x10, 0x24, 0x2c, 0x19, 0xe2, 0xda, 0xd1, 0x9c, 0xaf, 0x16, 0xd2, 0xe9, 0x45, 0x8e, 0xa4, 0x68,
+	0xa8, 0x29, 0x0d, 0x13, 0xf7, 0xdb, 0x29, 0x9b, 0x46, 0xe4, 0x88, 0x42, 0x41, 0x63, 0xa0, 0xa1,
+	0xc1, 0x93, 0x88, 0xce, 0x1f, 0x72, 0x44, 0x86, 0xa5, 0x2c, 0x2e, 0xcc, 0x44, 0x0d, 0x4d, 0x91,
+	0xa5, 0x14, 0x34, 0xb1, 0xed, 0xd0, 0xde, 0x47, 0xd1, 0x33, 0x94, 0x29, 0x82, 0xd8, 0x99, 0xe1,
+	0x2f, 0x13, 0xd4, 0x29, 0x2f, 0x21, 0x60, 0xce, 0x30, 0xd0, 0x0e, 0x5d, 0x1b, 0x73, 0x76, 0x96,
+	0xe5, 0x4c, 0x21, 0x19, 0x7f, 0xab, 0x04, 0x6b, 0x5d, 0x67, 0xae, 0x1a, 0xe2, 0x05, 0x28, 0x76,
+	0xfd, 0x5e, 0xb4, 0x1f, 0x71, 0x23, 0xe4, 0x21, 0xcc, 0x40, 0xd7, 0xe7, 0xa6, 0xc1, 0xcc, 0x4f,
+	0x86, 0x99, 0xe9, 0xb4, 0xa3, 0xe5, 0x9c, 0xf3, 0x73, 0xc2, 0x74, 0x12, 0x9a, 0x7e, 0x03, 0xd6,
+	0xb0, 0xe2, 0xa2, 0x78, 0xe2, 0x87, 0xae, 0xed, 0x1c, 0x08, 0x1b, 0x5c, 0xa1, 0x32, 0x43, 0xc5,
+	0x52, 0x35, 0x5f, 0x3b, 0x53, 0x61, 0x85, 0x2a, 0x89, 0x21, 0x86, 0x76, 0x38, 0x1f, 0x86, 0xc1,
+	0x73, 0x61, 0x82, 0x2a, 0x89, 0xe9, 0x33, 0x0a, 0x9d, 0xef, 0x2d, 0x5d, 0xdf, 0x39, 0x10, 0xd6,
+	0x97, 0xa2, 0xb1, 0x58, 0x88, 0x3b, 0xf5, 0x42, 0xd7, 0x89, 0x85, 0xed, 0xa9, 0x24, 0x2c, 0xf6,
+	0xae, 0x6f, 0x3a, 0x07, 0x81, 0xb0, 0x3c, 0x11, 0x64, 0x66, 0x89, 0xbf, 0xc4, 0x5d, 0x08, 0xab,
+	0x53, 0x28, 0x2c, 0x7d, 0x54, 0x38, 0x8a, 0xed, 0xf9, 0x42, 0x58, 0x5d, 0x8a, 0xc6, 0x1a, 0x89,
+	0x0c, 0xd3, 0x88, 0x6a, 0xa2, 0x91, 0xa4, 0xc8, 0x4c, 0x53, 0x6c, 0x84, 0x3d, 0x3b, 0x7a, 0x11,
+	0x71, 0xeb, 0x53, 0x49, 0xac, 0x6c, 0x45, 0x90, 0x46, 0xb5, 0x26, 0xca, 0x56, 0xa5, 0x62, 0x8e,
+	0x06, 0xcb, 0x98, 0x56, 0x2e, 0xb3, 0x41, 0x11, 0x44, 0x43, 0x1a, 0x2c, 0x63, 0x5e, 0x7d, 0xcc,
+	0x02, 0x13, 0x02, 0xea, 0x8a, 0x2d, 0x46, 0xad, 0x3c, 0x66, 0x88, 0xab, 0x64, 0xcc, 0xf9, 0x60,
+	0x19, 0x27, 0xd5, 0xc7, 0x6c, 0x32, 0x45, 0xe3, 0x98, 0xa4, 0x02, 0xcf, 0x4a, 0x4c, 0x52, 0x83,
+	0xd7, 0xa0, 0x3e, 0x58, 0xc6, 0x4a, 0x15, 0x9e, 0x63, 0x1d, 0x4d, 0x8a, 0xc8, 0x63, 0x4a, 0x2a,
+	0xf1, 0xbc, 0x8c, 0x29, 0xa9, 0xc5, 0x26, 0x94, 0x31, 0x23, 0xb4, 0x1a, 0x2f, 0x30, 0xbb, 0x15,
+	0x61, 0xde, 0xf4, 0x65, 0x45, 0xbe, 0x2f, 0x9b, 0xbe, 0xac, 0x49, 0xa6, 0x87, 0x52, 0x95, 0x0d,
+	0xa9, 0x47, 0x42, 0xd4, 0x6f, 0x81, 0xa6, 0x12, 0x68, 0x64, 0x17, 0x29, 0xf0, 0x10, 0x9d, 0xeb,
+	0x9c, 0x54, 0x67, 0x53, 0xea, 0x9c, 0xd4, 0x27, 0x2b, 0xef, 0x54, 0x85, 0x5e, 0x92, 0xe5, 0xad,
+	0x92, 0x8d, 0x7f, 0x94, 0x83, 0xfa, 0xd8, 0x51, 0xdb, 0x2f, 0x76, 0x56, 0x71, 0xd0, 0x9a, 0xed,
+	0x07, 0xa1, 0x17, 0x1f, 0xcc, 0x79, 0x2b, 0x4e, 0xd1, 0xb0, 0x8d, 0x93, 0x38, 0xe8, 0x79, 0x3e,
+	0x6f, 0xc9, 0x3c, 0x24, 0xe8, 0xf6, 0x6b, 0xde, 0x82, 0x79, 0x08, 0xed, 0xa6, 0x67, 0xbf, 0x6e,
+	0x07, 0xbe, 0xcf, 0x1b, 0xad, 0x08, 0x62, 0x09, 0xb6, 0x1c, 0x5c, 0x54, 0x1e, 0x2c, 0x5c, 0x5f,
+	0xb6, 0x56, 0x85, 0x84, 0xfa, 0x0c, 0xed, 0x28, 0x92, 0x10, 0xd6, 0x5c, 0x53, 0x34, 0xc4, 0xb4,
+	0xe2, 0xd8, 0x9d, 0x2f, 0x62, 0xd6, 0x93, 0xf1, 0xf6, 0xaa, 0xd2, 0x30, 0x25, 0x33, 0x8a, 0xed,
+	0xe7, 0xc4, 0x8d, 0xdc, 0xa4, 0xbd, 0x2a, 0x24, 0xb4, 0xe1, 0xf6, 0x32, 0x0c, 0x29, 0x89, 0xb7,
+	0xd8, 0x84, 0xc0, 0xfa, 0xb5, 0x91, 0xbb, 0x2f, 0xda, 0x2b, 0x0f, 0xf1, 0x36, 0x41, 0x19, 0x55,
+	0xd9, 0x26, 0x28, 0x67, 0x13, 0xaa, 0xc4, 0x8d, 0x43, 0xdb, 0x8f, 0x28, 0x97, 0x0f, 0x0c, 0x0a,
+	0x89, 0xc5, 0x69, 0x86, 0xa1, 0x68, 0x94, 0x3c, 0xc4, 0xe3, 0x24, 0x51, 0x2c, 0x1a, 0xa2, 0x08,
+	0x1e, 0xea, 0x29, 0xd7, 0x0f, 0xf7, 0x94, 0xc6, 0x6f, 0x67, 0xa1, 0x3e, 0x99, 0xaa, 0x75, 0x4a,
+	0x7b, 0x80, 0x64, 0xd0, 0xcd, 0x88, 0x1e, 0x40, 0x92, 0x30, 0xc5, 0x7e, 0x30, 0x0c, 0xc2, 0x58,
+	0x74, 0xce, 0x22, 0x98, 0xea, 0xb7, 0x73, 0x87, 0xfb, 0x6d, 0x6c, 0xc0, 0x32, 0xe2, 0xbc, 0xb4,
+	0xc5, 0x24, 0x66, 0xb4, 0x27, 0xe7, 0xe5, 0xf3, 0xe5, 0x5e, 0xda, 0x2d, 0x50, 0x69, 0x88, 0x19,
+	0xf9, 0xd3, 0x04, 0xc3, 0xeb, 0x58, 0xa5, 0x1d, 0xca, 0x79, 0xe9, 0x88, 0x31, 0x02, 0x31, 0xfb,
+	0x7e, 0x10, 0xba, 0xd3, 0xde, 0x72, 0x16, 0x7b, 0xbc, 0x92, 0x53, 0x34, 0xe3, 0x47, 0x19, 0x58,
+	0x1b, 0xf5, 0x7b, 0x43, 0xa5, 0x78, 0x6e, 0x43, 0xd9, 0x5b, 0x58, 0xb8, 0x4a, 0x1f, 0xad, 0xac,
+	0x95, 0xab, 0x2e, 0x16, 0x29, 0x79, 0x34, 0x84, 0x6b, 0xdd, 0xe0, 0x39, 0x73, 0x21, 0x91, 0x4d,
+	0x6d, 0x6e, 0xa6, 0x47, 0x43, 0x52, 0xf1, 0x78, 0x38, 0xd2, 0xef, 0x42, 0x25, 0x76, 0x84, 0x50,
+	0x2e, 0xb5, 0x42, 0x99, 0x6a, 0x81, 0xa4, 0x1c, 0x3b, 0x89, 0xc8, 0x72, 0x2a, 0x44, 0xf2, 0x29,
+	0x91, 0x54, 0x05, 0x93, 0xf2, 0x92, 0x05, 0x23, 0xe3, 0x6f, 0xaf, 0x83, 0x2e, 0xdc, 0x7d, 0x25,
+	0x8b, 0x97, 0xa1, 0x12, 0x8b, 0x6e, 0x84, 0x2f, 0xe1, 0x24, 0x04, 0xfd, 0x53, 0x38, 0xeb, 0x2c,
+	0x96, 0x91, 0xb5, 0x8c, 0xf8, 0x6e, 0x27, 0x6e, 0xe5, 0xb0, 0x9c, 0x65, 0x88, 0x86, 0xac, 0x49,
+	0xc4, 0x36, 0x3b, 0x47, 0xae, 0x83, 0x6a, 0x9d, 0xa7, 0xf0, 0xe8, 0x4d, 0x14, 0xbb, 0x73, 0x45,
+	0x20, 0x47, 0x05, 0x74, 0x64, 0x8e, 0x28, 0x4f, 0x8a, 0x5c, 0x01, 0xa0, 0x22, 0xd4, 0x79, 0xa6,
+	0x59, 0xc9, 0x90, 0x0a, 0x52, 0x76, 0x91, 0xa0, 0x1b, 0x50, 0x9f, 0xbb, 0x73, 0x2b, 0x8c, 0x22,
+	0x8b, 0x39, 0xe8, 0xcc, 0xdf, 0xae, 0xce, 0xdd, 0x39, 0x89, 0xa2, 0x47, 0x48, 0xd2, 0x6f, 0xc0,
+	0x3a, 0x62, 0x68, 0x0c, 0x1c, 0xc5, 0x1c, 0x6f, 0x14, 0xa5, 0xd1, 0x48, 0x1c, 0x4d, 0xca, 0x0f,
+	0xf9, 0x72, 0x2e, 0xb3, 0x95, 0x3a, 0xa9, 0x23, 0xb9, 0x1f, 0xb2, 0x35, 0x5b, 0xec, 0x74, 0xcf,
+	0x08, 0x5c, 0x7c, 0x10, 0x06, 0x71, 0x3c, 0x73, 0xd9, 0x8a, 0x76, 0x9d, 0xac, 0x33, 0xe4, 0x58,
+	0x90, 0xf5, 0x2f, 0xa0, 0x41, 0xb1, 0x12, 0xa8, 0x64, 0xba, 0x42, 0x33, 0x43, 0x4b, 0x44, 0x0a,
+	0xc8, 0x7c, 0x5f, 0x83, 0x35, 0x54, 0x1a, 0x77, 0x01, 0xb8, 0xce, 0xcc, 0x93, 0xaf, 0xcd, 0xdd,
+	0x39, 0x6e, 0x04, 0x30, 0x95, 0x39, 0xca, 0xf6, 0x03, 0x9f, 0xa3, 0xaa, 0x12, 0xd5, 0xf2, 0x03,
+	0x9f, 0xa1, 0xee, 0xc2, 0x79, 0x44, 0xcd, 0xed, 0xc5, 0xc2, 0x9d, 0xaa, 0x51, 0xd6, 0x28, 0x58,
+	0x9f, 0xbb, 0xf3, 0x1e, 0xe5, 0x25, 0x11, 0x7f, 0x04, 0xf9, 0x85, 0x1b, 0xee, 0x35, 0xea, 0x29,
+	0x1b, 0x1d, 0xba, 0xe1, 0x9e, 0x62, 0x3c, 0x14, 0x82, 0x3a, 0xf8, 0x6e, 0x6c, 0x85, 0xaf, 0x2d,
+	0x31, 0xad, 0x59, 0x63, 0x3a, 0xf8, 0x6e, 0x4c, 0x5e, 0x0f, 0x19, 0x4d, 0xdf, 0x84, 0x1a, 0x47,
+	0xb1, 0xa4, 0xd7, 0x29, 0x06, 0x28, 0x86, 0x25, 0x69, 0x40, 0x9d, 0x23, 0xdc, 0xc4, 0x1b, 0xc8,
+	0x93, 0x2a, 0x85, 0xc8, 0x69, 0x80, 0x48, 0x0b, 0xe7, 0x5a, 0x0b, 0x77, 0xda, 0x38, 0xa3, 0xa4,
+	0xd5, 0x61, 0x34, 0x81, 0x8a, 0x13, 0x8d, 0x74, 0x89, 0x1a, 0xaf, 0x6a, 0x14, 0x0b, 0x8d, 0xce,
+	0x4a, 0x8d, 0xc6, 0x69, 0x8d, 0x62, 0xa9, 0xd1, 0x39, 0xa9, 0xd1, 0x78, 0x45, 0xa3, 0x38, 0xd1,
+	0xe8, 0xbc, 0x92, 0x96, 0xd0, 0xe8, 0x4b, 0xb8, 0x48, 0x51, 0xce, 0xc2, 0x0a, 0xe3, 0xd8, 0x9a,
+	0x7b, 0x4e, 0x18, 0xa0, 0x0d, 0x58, 0x8b, 0x07, 0x77, 0xa8, 0x73, 0x90, 0x21, 0xe7, 0x51, 0xc0,
+	0x59, 0x90, 0x38, 0xee, 0x09, 0xee, 0xf0, 0xc1, 0x9d, 0x13, 0x24, 0xbf, 0xba, 0xd3, 0x78, 0xff,
+	0x58, 0xc9, 0xaf, 0x4e, 0x94, 0x7c, 0xd0, 0x68, 0x1c, 0x2f, 0xf9, 0xe0, 0x24, 0xc9, 0xaf, 0x1a,
+	0x17, 0x8f, 0x97, 0xfc, 0x4a, 0xbf, 0x09, 0x1a, 0x2e, 0x8a, 0xa5, 0xda, 0x5a, 0x93, 0x96, 0xc7,
+	0x1a, 0xd2, 0xd3, 0x8d, 0x8d, 0x22, 0x97, 0x91, 0x3b, 0xe5, 0xc0, 0x4b, 0xac, 0x51, 0x22, 0x79,
+	0x12, 0xb9, 0x53, 0x86, 0xfb, 0x2e, 0x34, 0x85, 0x2e, 0x36, 0x1d, 0xdc, 0x2d, 0x27, 0xf0, 0x7d,
+	0xd7, 0xc1, 0x59, 0x7f, 0xd4, 0xb8, 0x4c, 0x95, 0x79, 0x9f, 0x29, 0xc3, 0x06, 0xff, 0x76, 0xc2,
+	0xd6, 0x7f, 0x01, 0xae, 0x08, 0x61, 0xda, 0xec, 0x5e, 0xd9, 0x5e, 0x9c, 0x92, 0xbf, 0x42, 0xe5,
+	0x2f, 0x32, 0x79, 0x6c, 0x7b, 0xcf, 0x6c, 0x2f, 0x56, 0x63, 0xa0, 0x8b, 0x36, 0x81, 0xe3, 0x46,
+	0x91, 0x1b, 0x35, 0x36, 0x68, 0x1b, 0x4f, 0x08, 0x38, 0xf8, 0xc5, 0x07, 0xa1, 0x6b, 0x4f, 0xa3,
+	0xc6, 0x55, 0xca, 0x13, 0x41, 0x6c, 0xf7, 0xb4, 0xcf, 0x09, 0x5e, 0x59, 0x8b, 0xd0, 0x8d, 0xa2,
+	0x65, 0x88, 0x8a, 0x2f, 0x71, 0xab, 0xbd, 0xb1, 0x49, 0xf3, 0x89, 0x4d, 0x72, 0x37, 0x78, 0x35,
+	0xe4, 0xdc, 0x36, 0x63, 0xea, 0x3f, 0x0b, 0x97, 0x68, 0x5b, 0x75, 0xa7, 0xde, 0x72, 0x7e, 0x58,
+	0xf6, 0x03, 0x2a, 0x8b, 0x71, 0xf7, 0x28, 0x62, 0x55, 0xbc, 0x05, 0x57, 0x50, 0xdc, 0x09, 0xbd,
+	0xd8, 0x73, 0xec, 0xd9, 0xe1, 0x08, 0x0c, 0x1a, 0x41, 0x73, 0xee, 0xce, 0xdb, 0x1c, 0xb3, 0x1a,
+	0xc5, 0x14, 0x36, 0x68, 0xa1, 0xb1, 0x55, 0x13, 0xcb, 0x61, 0xcb, 0x26, 0x56, 0x24, 0xdb, 0x7d,
+	0xe3, 0x3b, 0xa9, 0xf3, 0x20, 0xc7, 0x2d, 0xaf, 0x90, 0x4b, 0x58, 0xac, 0xc7, 0x30, 0x45, 0xa7,
+	0x1c, 0x07, 0xb1, 0x3d, 0xe3, 0xf5, 0x7f, 0x4d, 0x76, 0xca, 0x63, 0xa4, 0xa6, 0xfa, 0x2e, 0x86,
+	0x9b, 0xbb, 0xf3, 0xe8, 0x15, 0x47, 0x5f, 0x97, 0x7d, 0x17, 0x45, 0xf7, 0x90, 0xc5, 0x44, 0x3e,
+	0x83, 0x73, 0x28, 0x12, 0x05, 0x7b, 0x71, 0xca, 0x10, 0x6f, 0x50, 0x89, 0x33, 0x73, 0x77, 0x3e,
+	0x0a, 0xf6, 0xe2, 0xb4, 0x2d, 0xd2, 0x42, 0xc3, 0x1d, 0x58, 0x8e, 0xfd, 0x50, 0xea, 0xd2, 0x46,
+	0x6a, 0xaa, 0xb7, 0x8d, 0x5e, 0xd9, 0x0b, 0x0e, 0xbb, 0x29, 0x7b, 0xdb, 0xd1, 0x2b, 0x7b, 0xc1,
+	0x50, 0x5b, 0x4c, 0xe3, 0xa5, 0xef, 0xbe, 0xf4, 0x1c, 0xba, 0x17, 0xcc, 0xc1, 0x1f, 0x51, 0xf0,
+	0xd9, 0xb9, 0x3b, 0x9f, 0x24, 0x3c, 0x26, 0x63, 0xc2, 0x59, 0x2c, 0xf3, 0xc8, 0xe7, 0xce, 0x01,
+	0x2f, 0xe8, 0x5b, 0xa9, 0xde, 0x37, 0xed, 0x7c, 0x90, 0x33, 0xbe, 0x1b, 0x8f, 0x7c, 0xd5, 0x69,
+	0x30, 0x7e, 0x27, 0x0f, 0x75, 0x31, 0x86, 0x4f, 0x22, 0x7b, 0xdf, 0xc5, 0xa5, 0x65, 0xb1, 0xef,
+	0x2b, 0xb6, 0xe3, 0x57, 0x97, 0x96, 0x29, 0x50, 0x6e, 0x26, 0x92, 0x04, 0x8f, 0xe7, 0xcf, 0x68,
+	0xb9, 0x37, 0xb2, 0x47, 0xef, 0x98, 0x31, 0x6e, 0xf3, 0xaf, 0xe7, 0xa0, 0x2c, 0xc4, 0xf5, 0x2f,
+	0xa1, 0x9e, 0x6c, 0x47, 0xe3, 0xc2, 0x32, 0xdb, 0x90, 0x3e, 0x7b, 0xc4, 0x9e, 0x25, 0xa9, 0xb9,
+	0x4a, 0x08, 0xf7, 0xe8, 0xf8, 0xea, 0xb2, 0x3b, 0x3d, 0x2e, 0xc5, 0x04, 0xa1, 0x7f, 0x05, 0xa0,
+	0x94, 0x14, 0x73, 0x8b, 0x2e, 0xae, 0xe0, 0x95, 0xd2, 0x52, 0xc0, 0xb8, 0x1b, 0x2d, 0xf7, 0x7f,
+	0x2d, 0xba, 0x65, 0x99, 0x3d, 0xe6, 0xa0, 0x63, 0x55, 0xe2, 0xba, 0xd8, 0x89, 0x17, 0x62, 0x3a,
+	0x73, 0x62, 0x1b, 0x82, 0xc6, 0x89, 0xe5, 0x78, 0x1b, 0x4f, 0x6b, 0x12, 0x26, 0xd0, 0xfc, 0x4d,
+	0x3c, 0x85, 0x64, 0x47, 0x2f, 0x8e, 0xdc, 0x2a, 0xb9, 0x42, 0x57, 0x21, 0xd9, 0xa1, 0x43, 0xb1,
+	0x24, 0xcd, 0x8e, 0x7a, 0xd2, 0x45, 0xc9, 0x77, 0xdc, 0xba, 0x4c, 0x16, 0xd8, 0xf3, 0x27, 0x2c,
+	0xb0, 0x1b, 0x3f, 0xd2, 0x60, 0x2d, 0x3d, 0x9a, 0x9f, 0xe2, 0xe9, 0x35, 0x53, 0x87, 0x0c, 0x91,
+	0x29, 0xc3, 0x38, 0x1b, 0x71, 0xde, 0x38, 0x33, 0xb9, 0x3c, 0xca, 0x43, 0xfa, 0xe7, 0xf0, 0x7e,
+	0x14, 0xdb, 0x33, 0xf4, 0x7a, 0x18, 0xc5, 0xda, 0x0b, 0x03, 0x3f, 0xc6, 0x9d, 0x3d, 0xb6, 0x62,
+	0x7a, 0x9e, 0xb3, 0xdb, 0x94, 0xbb, 0xcd, 0x99, 0xfa, 0x7d, 0xb8, 0xb0, 0x22, 0x87, 0xeb, 0xb0,
+	0x28, 0xc6, 0xbc, 0xbb, 0x73, 0x29, 0xb1, 0x47, 0x8c, 0x87, 0x3e, 0xbc, 0xe7, 0x47, 0x71, 0xb8,
+	0xe4, 0x7d, 0x3b, 0xf3, 0xf1, 0x52, 0x34, 0xfd, 0x23, 0xd0, 0x58, 0x2b, 0x0f, 0xdd, 0x3d, 0x37,
+	0x74, 0x7d, 0xc7, 0x65, 0x3e, 0x5e, 0x9e, 0xac, 0x53, 0x3a, 0x91, 0x64, 0xfd, 0x03, 0xa8, 0x31,
+	0xe8, 0xdc, 0xa3, 0x9d, 0x3f, 0x5b, 0x7d, 0xad, 0x52, 0x5a, 0x8f, 0x92, 0xb0, 0x4c, 0x9e, 0x87,
+	0xb6, 0xef, 0x1c, 0xb8, 0x62, 0x09, 0x56, 0x86, 0xf5, 0xef, 0x40, 0x9d, 0xfd, 0x0b, 0x79, 0xee,
+	0xbe, 0x31, 0x22, 0x8f, 0xe0, 0x0a, 0xc0, 0xf3, 0x65, 0xc4, 0x33, 0xc9, 0x5d, 0xb7, 0xca, 0xf3,
+	0x65, 0xc4, 0x32, 0x86, 0xec, 0xd0, 0xdd, 0x13, 0x6c, 0xe6, 0xac, 0x55, 0x42, 0x77, 0x8f, 0xb3,
+	0x2f, 0x01, 0x3a, 0xc2, 0x96, 0x33, 0x0b, 0x9c, 0x17, 0xd4, 0x51, 0xcb, 0x90, 0xb2, 0xb3, 0x58,
+	0xb6, 0x31, 0x8c, 0xb2, 0x68, 0x7b, 0x9c, 0xbb, 0x46, 0xb9, 0x15, 0xa4, 0x30, 0xf6, 0x55, 0xa8,
+	0x2e, 0xec, 0x7d, 0x3c, 0xb0, 0xb5, 0x9c, 0xc5, 0xd2, 0x1b, 0x43, 0xd2, 0x36, 0xa5, 0x60, 0xf6,
+	0xe7, 0x9e, 0x1f, 0x84, 0x02, 0xc1, 0x9d, 0x31, 0x4a, 0x53, 0x20, 0xf6, 0x2f, 0x26, 0x90, 0x33,
+	0x1c, 0x62, 0xff, 0xa2, 0x84, 0x60, 0x79, 0x63, 0xa5, 0xbe, 0x8e, 0xad, 0xe8, 0x95, 0x17, 0xd3,
+	0x92, 0xd2, 0x79, 0x79, 0x33, 0xfa, 0x88, 0x93, 0xf5, 0xeb, 0xb0, 0x86, 0xb9, 0x99, 0x7b, 0xfb,
+	0xcc, 0xaa, 0x84, 0x43, 0x86, 0xce, 0x77, 0x4f, 0x12, 0x31, 0x46, 0x7b, 0xe6, 0xed, 0xd3, 0x53,
+	0x40, 0x22, 0x61, 0xe6, 0x96, 0xad, 0x4b, 0x7a, 0x92, 0xb8, 0x3b, 0x5f, 0xce, 0xa8, 0xa0, 0x80,
+	0x32, 0xe7, 0x6c, 0x5d, 0xd2, 0x39, 0xf4, 0x06, 0xac, 0xcf, 0xee, 0x5a, 0x53, 0x56, 0xe1, 0xb3,
+	0x00, 0x07, 0xf4, 0x0b, 0x2c, 0xf5, 0xd9, 0xdd, 0x0e, 0xa5, 0xee, 0x22, 0x11, 0x47, 0xa3, 0x34,
+	0x4e, 0xd4, 0xee, 0xfb, 0x14, 0xad, 0xab, 0x68, 0x5e, 0xc7, 0x37, 0x41, 0x4b, 0x44, 0xa2, 0x38,
+	0x08, 0x5d, 0xb6, 0x96, 0x93, 0x27, 0x6b, 0x02, 0x3d, 0xa2, 0x54, 0xfd, 0x1e, 0x5c, 0x58, 0x41,
+	0x8a, 0xd8, 0x2f, 0xb2, 0x91, 0x23, 0x85, 0xe7, 0xd1, 0xdf, 0x81, 0x73, 0x89, 0xd0, 0x02, 0xcd,
+	0x9a, 0x95, 0x72, 0x33, 0xad, 0xd0, 0x50, 0x72, 0xf4, 0xaf, 0xe0, 0xe2, 0x61, 0x09, 0x91, 0x12,
+	0xf3, 0xc1, 0x2e, 0xac, 0x8a, 0xf1, 0xc4, 0x58, 0x31, 0x79, 0x6a, 0x31, 0x5d, 0x16, 0xc5, 0xd4,
+	0x3d, 0x54, 0x4c, 0xde, 0xe1, 0x62, 0xba, 0x22, 0xb4, 0xea, 0xae, 0x16, 0x13, 0xcb, 0x87, 0x77,
+	0x28, 0x1f, 0x1b, 0x69, 0x89, 0x43, 0xf9, 0xf0, 0x8e, 0xce, 0xc7, 0x55, 0x91, 0x8f, 0xee, 0x51,
+	0xf9, 0xb8, 0x04, 0x95, 0xd9, 0xcc, 0xe1, 0x39, 0x60, 0xee, 0x58, 0x79, 0x36, 0x73, 0x98, 0xf2,
+	0x98, 0x49, 0xce, 0x14, 0xb1, 0x7d, 0xc0, 0x33, 0xc9, 0x20, 0x49, 0xe3, 0x45, 0x1c, 0xaf, 0x52,
+	0xe6, 0x57, 0x61, 0xb4, 0xbc, 0x36, 0xb1, 0xde, 0x05, 0x5b, 0xc4, 0xf3, 0x1d, 0x5e, 0xef, 0x1c,
+	0xc4, 0x23, 0xba, 0x0e, 0x48, 0x51, 0x33, 0x7d, 0x4d, 0xa6, 0xa7, 0xe4, 0xf7, 0x36, 0x9c, 0x55,
+	0x61, 0x22, 0x4e, 0xe6, 0x07, 0x9d, 0x51, 0xb0, 0x89, 0x7e, 0xd3, 0x78, 0xf6, 0x9c, 0xe7, 0x92,
+	0x39, 0x3f, 0x15, 0xa4, 0xb0, 0x6c, 0xa2, 0xab, 0x2e, 0xd8, 0x22, 0xae, 0x0f, 0xb9, 0xab, 0xce,
+	0x41, 0x3c, 0xa2, 0xab, 0x50, 0xa5, 0x48, 0x9e, 0x53, 0xe6, 0xf3, 0xd0, 0xb8, 0x79, 0x56, 0x6f,
+	0xc1, 0x99, 0x04, 0x20, 0xe2, 0x62, 0xde, 0xce, 0xba, 0x84, 0xf1, 0xc8, 0x3e, 0x04, 0x4a, 0x52,
+	0x73, 0x7b, 0x2b, 0x49, 0x55, 0xc9, 0xee, 0x1d, 0x38, 0x97, 0x02, 0x8a, 0x78, 0x3f, 0x66, 0x06,
+	0xa1, 0xa2, 0x93, 0x0c, 0x7b, 0x49, 0x86, 0x3f, 0x61, 0x19, 0xf6, 0xd4, 0x0c, 0x7b, 0xab, 0x19,
+	0xfe, 0x94, 0x25, 0xed, 0xa5, 0x33, 0xfc, 0x01, 0xf0, 0x6e, 0x9a, 0x47, 0x75, 0x9b, 0x75, 0x6c,
+	0x8c, 0xc6, 0x22, 0xfb, 0x04, 0x74, 0x05, 0x22, 0xa2, 0xfb, 0x8c, 0x02, 0xb5, 0x04, 0x98, 0x68,
+	0xe6, 0x07, 0x53, 0xd1, 0x64, 0xee, 0x30, 0xcd, 0x90, 0x22, 0x35, 0x93, 0x6c, 0x11, 0xd5, 0x5d,
+	0xa6, 0x99, 0x00, 0x25, 0x55, 0x41, 0x91, 0xbc, 0x2a, 0xb6, 0xf8, 0x94, 0x35, 0x98, 0xba, 0x49,
+	0x55, 0x24, 0x00, 0x11, 0xd7, 0x3d, 0x56, 0x15, 0x12, 0x96, 0x54, 0x05, 0xc5, 0x2a, 0x55, 0x71,
+	0x3f, 0x49, 0x35, 0x5d, 0x15, 0x29, 0xa0, 0x88, 0xf7, 0x01, 0xab, 0x0a, 0x15, 0xcd, 0xa2, 0x36,
+	0x1c, 0x28, 0xf1, 0x8d, 0x30, 0xfd, 0x23, 0x28, 0xdb, 0x78, 0x10, 0x8a, 0x1d, 0x4e, 0x3c, 0xea,
+	0x7c, 0x54, 0x89, 0xf2, 0xbb, 0x2b, 0xae, 0x4e, 0xf6, 0x34, 0x57, 0xc7, 0xf8, 0xe5, 0x2a, 0x14,
+	0xe8, 0xad, 0x1a, 0x7e, 0xfa, 0x2a, 0x93, 0xba, 0xb7, 0xc1, 0xef, 0xdb, 0x50, 0x1f, 0x6a, 0xf5,
+	0xf8, 0x61, 0x76, 0x33, 0xfb, 0x36, 0xc7, 0x0f, 0x55, 0xd5, 0x73, 0x9b, 0xd9, 0x93, 0x54, 0x57,
+	0x0f, 0x9f, 0xe5, 0x4f, 0x3a, 0x7c, 0x76, 0xfa, 0xc9, 0xca, 0xfb, 0x50, 0x53, 0x0e, 0x74, 0xa2,
+	0x1f, 0x93, 0x3b, 0xfa, 0x44, 0x67, 0x35, 0x39, 0xd1, 0xb9, 0x7a, 0x64, 0xad, 0xf4, 0x16, 0x47,
+	0xd6, 0x2e, 0x43, 0x0e, 0x0f, 0xff, 0xb3, 0x03, 0x2b, 0x20, 0x56, 0x07, 0xc9, 0x2e, 0x41, 0xf2,
+	0x11, 0x77, 0x31, 0x2a, 0xef, 0x70, 0x17, 0xe3, 0xa8, 0x13, 0x26, 0xf0, 0x53, 0x9d, 0x30, 0xf9,
+	0xcb, 0x25, 0xa8, 0x0c, 0x16, 0x2e, 0xf7, 0x34, 0x3f, 0x4b, 0x5d, 0x04, 0xb8, 0xa4, 0x56, 0xf5,
+	0x6d, 0x89, 0x52, 0x4f, 0x44, 0x3c, 0x40, 0x77, 0x78, 0xe9, 0x3b, 0xe2, 0x4c, 0xc4, 0x95, 0x63,
+	0x44, 0x76, 0x29, 0x88, 0x70, 0xb0, 0xfe, 0x25, 0x94, 0xd8, 0x01, 0x1d, 0x71, 0x08, 0x64, 0xe3,
+	0x18, 0x39, 0x76, 0xfa, 0xc5, 0x25, 0x02, 0xae, 0xff, 0x1c, 0x54, 0x96, 0xbe, 0x90, 0xcd, 0xa7,
+	0x0e, 0xf4, 0xaf, 0xca, 0x4e, 0x04, 0x8e, 0x24, 0x22, 0xa8, 0xb0, 0x43, 0xf7, 0x48, 0x1b, 0x85,
+	0x13, 0x15, 0x66, 0x1b, 0xa9, 0x84, 0x83, 0x51, 0xe1, 0xa9, 0x1b, 0xc5, 0x61, 0xf0, 0xa6, 0x51,
+	0x3c, 0x51, 0xe1, 0x0e, 0x43, 0x11, 0x01, 0xd7, 0x4d, 0xa8, 0xb1, 0x4c, 0x5b, 0xfb, 0x61, 0xb0,
+	0x5c, 0xf0, 0x53, 0x4f, 0xc6, 0x89, 0xe5, 0xb4, 0x83, 0x48, 0x52, 0x9d, 0x25, 0x81, 0xe6, 0x97,
+	0x50, 0x64, 0x3c, 0xfd, 0x36, 0xf7, 0x3c, 0xb1, 0xd2, 0xc5, 0x9c, 0x73, 0x5d, 0x9d, 0xd7, 0xd0,
+	0x03, 0x4c, 0x31, 0xff, 0x8b, 0x9a, 0x11, 0x54, 0x95, 0x58, 0xf1, 0x36, 0x93, 0xb0, 0xeb, 0x93,
+	0xe6, 0x8e, 0x12, 0xa4, 0xdf, 0xe3, 0xe9, 0x31, 0xf5, 0x59, 0xd3, 0x3e, 0xa7, 0xa4, 0x47, 0xa3,
+	0x4d, 0x12, 0x15, 0xea, 0x96, 0x78, 0xd5, 0xa5, 0x9b, 0x67, 0xe6, 0xb4, 0xe6, 0xd9, 0x7c, 0x08,
+	0x15, 0x59, 0x71, 0xef, 0x2a, 0x7b, 0x0f, 0x8a, 0xac, 0xde, 0xf4, 0x8f, 0xa0, 0xc4, 0x8e, 0x1b,
+	0x1d, 0x2b, 0x26, 0xf8, 0xcd, 0xfb, 0x50, 0xe2, 0x95, 0xf6, 0x0e, 0x52, 0xc6, 0xde, 0x51, 0x87,
+	0x80, 0x01, 0x8a, 0xbb, 0xad, 0x49, 0xbf, 0x8d, 0x27, 0x6d, 0x34, 0xa8, 0xb1, 0x7f, 0x6b, 0x87,
+	0x0c, 0x26, 0x43, 0xad, 0x88, 0x50, 0x62, 0x8e, 0x4c, 0xf2, 0x14, 0x6f, 0xbc, 0xd4, 0xa1, 0x32,
+	0xe9, 0x8b, 0x60, 0x0e, 0x25, 0xdb, 0xc4, 0xc4, 0xcb, 0x30, 0x79, 0x76, 0x94, 0x78, 0x34, 0x26,
+	0x83, 0x6f, 0xb4, 0x82, 0xf1, 0x97, 0xb2, 0xb8, 0xbd, 0x82, 0x67, 0x22, 0xdc, 0xb7, 0xeb, 0x8b,
+	0x79, 0xb7, 0x93, 0x3d, 0xba, 0xdb, 0x59, 0xed, 0xa9, 0x73, 0xef, 0xde, 0x53, 0xe7, 0x4f, 0x1e,
+	0x64, 0x0e, 0x77, 0x6c, 0x85, 0xcd, 0xec, 0xdb, 0x77, 0x6c, 0xa9, 0x1a, 0x2f, 0x9e, 0x3a, 0x46,
+	0xfd, 0x30, 0x0f, 0x65, 0x61, 0xf4, 0x47, 0xce, 0xfe, 0x6f, 0x40, 0x89, 0xb5, 0x96, 0x63, 0x96,
+	0x00, 0x8a, 0xb4, 0xa1, 0xbc, 0xd3, 0x58, 0x94, 0x52, 0x31, 0x7f, 0xea, 0x78, 0xa3, 0x36, 0xb8,
+	0xc2, 0x66, 0xe6, 0xf4, 0x06, 0x27, 0x6e, 0x0f, 0x14, 0x95, 0xdb, 0x03, 0xef, 0x7c, 0x1d, 0xe0,
+	0x80, 0x5e, 0xae, 0xb1, 0xe8, 0x1d, 0xb7, 0x95, 0xeb, 0x00, 0xca, 0xbd, 0x1b, 0x52, 0x3d, 0x48,
+	0x02, 0xe9, 0xe3, 0xfd, 0x95, 0xb7, 0x3b, 0xde, 0x9f, 0xac, 0x87, 0xc0, 0x49, 0x27, 0xec, 0x53,
+	0xb7, 0x00, 0xaa, 0x6f, 0x77, 0x0b, 0x60, 0x0b, 0xaa, 0x2f, 0xbc, 0xd9, 0xcc, 0x5a, 0xd0, 0xcb,
+	0x33, 0xfc, 0xee, 0x80, 0x18, 0xa7, 0x93, 0x5b, 0x35, 0x04, 0x5e, 0xc8, 0x7f, 0xe3, 0x73, 0xa8,
+	0xa7, 0xfa, 0x24, 0xba, 0xc6, 0x46, 0x17, 0x95, 0x8e, 0xe9, 0x28, 0x19, 0xd7, 0xf8, 0xc3, 0xfc,
+	0x09, 0x2b, 0x48, 0x6f, 0x6b, 0x43, 0x3f, 0x65, 0xe3, 0x5a, 0xb9, 0x61, 0x92, 0x4f, 0xe5, 0xf3,
+	0x98, 0x1b, 0x26, 0xaa, 0xb9, 0x16, 0x4e, 0x36, 0xd7, 0x1b, 0xf4, 0x96, 0x6b, 0x8c, 0xc7, 0x70,
+	0xf1, 0xbc, 0xa3, 0xa6, 0xe8, 0x8e, 0xab, 0x53, 0x2e, 0x61, 0xec, 0xb4, 0x59, 0x97, 0x4e, 0x35,
+	0xeb, 0x4f, 0x95, 0xeb, 0x54, 0xe5, 0x94, 0x0b, 0x25, 0x62, 0x5e, 0x46, 0xc9, 0x0d, 0x2b, 0xfd,
+	0x17, 0xe0, 0x2c, 0xfb, 0xb7, 0x96, 0x8b, 0xa9, 0x1d, 0xbb, 0x16, 0xd3, 0xa9, 0xb2, 0x99, 0x39,
+	0x52, 0xa7, 0x33, 0x0c, 0x3c, 0xa1, 0x58, 0x4a, 0xc2, 0x29, 0x41, 0x3a, 0x86, 0xe5, 0xd2, 0x63,
+	0x87, 0x72, 0x6b, 0x44, 0x53, 0xe1, 0x93, 0xa5, 0x37, 0x7d, 0xdb, 0xeb, 0x91, 0x3f, 0xcd, 0xed,
+	0x94, 0x54, 0x33, 0xa9, 0xbf, 0x5d, 0x33, 0x11, 0x37, 0xa7, 0xd6, 0x92, 0x9b, 0x53, 0xc6, 0xef,
+	0x57, 0x01, 0x92, 0xb2, 0x52, 0xad, 0x2c, 0x73, 0x92, 0x95, 0xc9, 0xfa, 0xcc, 0x9e, 0x5c, 0x9f,
+	0xa2, 0x1b, 0xc9, 0x29, 0xdd, 0x48, 0x03, 0x4a, 0x73, 0x37, 0xc2, 0x85, 0x54, 0x7e, 0x8f, 0x44,
+	0x04, 0x57, 0x0c, 0xea, 0xc4, 0x1e, 0x3e, 0xb5, 0x90, 0x59, 0xe4, 0x2b, 0x5f, 0x82, 0xb0, 0x6a,
+	0xcd, 0xa5, 0xb7, 0xb1, 0xe6, 0x06, 0x94, 0x58, 0x3f, 0xf4, 0x86, 0x76, 0x55, 0x65, 0x22, 0x82,
+	0xfa, 0x1d, 0x79, 0x58, 0xb8, 0x92, 0xba, 0x9b, 0x93, 0x94, 0xdb, 0xca, 0x29, 0x61, 0x94, 0x08,
+	0x5d, 0x3b, 0x0a, 0xfc, 0x06, 0x1c, 0x27, 0x41, 0x28, 0x9f, 0x70, 0x1c, 0xad, 0x1b, 0x34, 0xa5,
+	0x2a, 0x2b, 0xa8, 0x65, 0xda, 0x7c, 0x6a, 0x27, 0x99, 0x4f, 0x0b, 0x34, 0x59, 0xc7, 0x16, 0xb3,
+	0x41, 0x6e, 0x11, 0x17, 0x56, 0x2d, 0x82, 0xb7, 0x88, 0x75, 0x27, 0x4d, 0xd0, 0x1f, 0x82, 0xb6,
+	0x64, 0x87, 0xac, 0xe8, 0x56, 0x06, 0x16, 0x24, 0xbf, 0xac, 0x74, 0xe8, 0x3e, 0xfa, 0xba, 0x02,
+	0x44, 0xa2, 0xf1, 0x48, 0x9e, 0x69, 0x3e, 0x03, 0x75, 0x76, 0x49, 0xd6, 0xea, 0xb5, 0x46, 0x63,
+	0x13, 0x2f, 0x54, 0x68, 0x50, 0xe3, 0xa4, 0xd6, 0x8e, 0xd9, 0xc7, 0x6b, 0x49, 0x67, 0x61, 0x9d,
+	0x53, 0xcc, 0xaf, 0xcd, 0xf6, 0x64, 0x3c, 0x20, 0x5a, 0xd6, 0xf8, 0x57, 0x45, 0x28, 0xb2, 0x02,
+	0xd1, 0x0d, 0xd8, 0x20, 0x66, 0x6b, 0x34, 0xe8, 0x5b, 0xfc, 0x4e, 0xa3, 0xc4, 0x59, 0xdb, 0xad,
+	0xee, 0xae, 0xd9, 0xd1, 0xde, 0x4b, 0x61, 0xfa, 0xe3, 0x56, 0xb7, 0x6f, 0x12, 0x8b, 0xfb, 0x39,
+	0x1c, 0x73, 0x5e, 0xbf, 0x0a, 0x97, 0x0e, 0x63, 0xba, 0xbd, 0xee, 0xb8, 0x85, 0xd7, 0x76, 0xb5,
+	0xb3, 0xfa, 0x35, 0xd8, 0x3c, 0x01, 0x60, 0x75, 0xba, 0xa3, 0x27, 0xda, 0x39, 0xfd, 0x06, 0x18,
+	0x27, 0xa1, 0x7a, 0x66, 0x6f, 0x40, 0xbe, 0xd1, 0xca, 0xfa, 0x06, 0x34, 0x0f, 0xe1, 0x86, 0xc4,
+	0x34, 0x7b, 0xc3, 0xb1, 0xd9, 0xd1, 0xce, 0x1c, 0xa9, 0xf2, 0x64, 0xd8, 0x69, 0x8d, 0x4d, 0xa1,
+	0xf2, 0x05, 0xfd, 0x26, 0x5c, 0xe3, 0x18, 0x99, 0x65, 0x62, 0xee, 0x74, 0x47, 0x63, 0xc2, 0x12,
+	0x1b, 0x77, 0x7b, 0xe6, 0x60, 0x32, 0xd6, 0xde, 0xd7, 0x6f, 0xc1, 0x8d, 0xc3, 0xc8, 0x23, 0xb1,
+	0x0d, 0x45, 0x33, 0x89, 0x1d, 0x9b, 0xa4, 0xd7, 0xed, 0xb7, 0x50, 0xb3, 0x8c, 0xbe, 0x09, 0x97,
+	0x57, 0xf9, 0x93, 0x3e, 0x8b, 0xcb, 0x24, 0x66, 0x47, 0xcb, 0xea, 0x97, 0xa1, 0xc1, 0x11, 0xdb,
+	0xa4, 0xd5, 0x33, 0x9f, 0x0d, 0xc8, 0x13, 0x8b, 0x98, 0xbd, 0xc1, 0x53, 0xb3, 0xa3, 0xe5, 0xb0,
+	0x42, 0x39, 0x77, 0xa7, 0x6d, 0x99, 0x84, 0x0c, 0x88, 0x96, 0x57, 0x12, 0xed, 0xf6, 0x9f, 0xb6,
+	0x76, 0xbb, 0x9d, 0x44, 0xb4, 0xdb, 0xd1, 0x0a, 0xfa, 0x45, 0x38, 0xbf, 0xc2, 0x1f, 0x6c, 0x6f,
+	0x9b, 0x64, 0xa4, 0x15, 0x15, 0x51, 0x66, 0x45, 0x58, 0x13, 0xed, 0x41, 0xbf, 0x6f, 0xb6, 0x51,
+	0xdf, 0x92, 0x22, 0x4a, 0xcc, 0xf6, 0xa0, 0xdf, 0xee, 0xee, 0x76, 0x59, 0x95, 0x56, 0x14, 0x45,
+	0xe5, 0x75, 0x6d, 0x4b, 0x38, 0xc6, 0xba, 0x7e, 0x05, 0x2e, 0x72, 0x2e, 0xb5, 0xc5, 0x74, 0xbc,
+	0xa0, 0x37, 0xe0, 0x5c, 0x8a, 0x2d, 0x72, 0x58, 0xd5, 0x9b, 0x70, 0x61, 0x85, 0x33, 0x1a, 0xb7,
+	0x08, 0x4a, 0xd5, 0x0e, 0x49, 0x89, 0xe4, 0xea, 0x4a, 0x72, 0xf4, 0xb6, 0x39, 0x75, 0xc0, 0x45,
+	0x6e, 0xb5, 0x8b, 0x8a, 0x41, 0x28, 0xec, 0x49, 0xbf, 0x35, 0x19, 0x3f, 0x1e, 0x90, 0xee, 0xf7,
+	0xcd, 0x8e, 0xd6, 0x64, 0x57, 0xd8, 0x13, 0x8c, 0x10, 0x5e, 0x53, 0x32, 0x4a, 0x19, 0x29, 0xb1,
+	0xf5, 0x55, 0x31, 0xa1, 0x92, 0x66, 0xdc, 0x83, 0xd2, 0xb6, 0x37, 0x8b, 0x5d, 0x7a, 0xe8, 0x72,
+	0x2d, 0x74, 0xf7, 0x96, 0x91, 0x6b, 0x25, 0x4f, 0x73, 0xd0, 0xf7, 0x0b, 0x1e, 0x90, 0x3a, 0x63,
+	0xf0, 0x1b, 0xd9, 0xc6, 0x1f, 0x87, 0xaa, 0x72, 0x43, 0x57, 0xff, 0x0a, 0x2a, 0x2f, 0xed, 0xd0,
+	0xc3, 0xe6, 0x2f, 0xfc, 0x99, 0x4b, 0x87, 0x2f, 0xf2, 0xde, 0x7e, 0xca, 0x31, 0x24, 0x41, 0x37,
+	0xef, 0x43, 0x59, 0x90, 0x8f, 0x74, 0x71, 0xe4, 0x3d, 0xad, 0xac, 0x7a, 0x4f, 0xeb, 0x1e, 0x54,
+	0xe4, 0x13, 0x1b, 0x78, 0x67, 0xec, 0x85, 0xfb, 0x86, 0x4b, 0xe1, 0xef, 0x31, 0x42, 0x3f, 0x03,
+	0x20, 0x85, 0x70, 0x55, 0xb5, 0xb2, 0x10, 0x21, 0xae, 0xf3, 0xe1, 0xd7, 0x3b, 0x12, 0x88, 0xf1,
+	0x08, 0xa0, 0x1d, 0xba, 0x53, 0xd7, 0x8f, 0x3d, 0x7b, 0xb6, 0x7a, 0xd9, 0x25, 0x9b, 0xbe, 0xec,
+	0x82, 0x37, 0xbd, 0x5d, 0x27, 0x74, 0x63, 0x7e, 0xdd, 0x83, 0x87, 0x8c, 0x47, 0x50, 0x4d, 0xe2,
+	0xc0, 0x75, 0xff, 0xaa, 0x93, 0x04, 0xb9, 0x12, 0x62, 0x44, 0x4a, 0x80, 0x44, 0x45, 0x19, 0xcf,
+	0xa0, 0x42, 0xec, 0xd8, 0x65, 0xa7, 0xa0, 0x34, 0xc8, 0x7d, 0xbb, 0xe0, 0xd5, 0x44, 0xf0, 0x77,
+	0xf5, 0xb2, 0xc9, 0x8a, 0x62, 0x4d, 0x28, 0xe3, 0x5b, 0x0d, 0x8e, 0x78, 0x62, 0x23, 0x4f, 0x64,
+	0xd8, 0xf8, 0x6b, 0x19, 0x00, 0x19, 0x33, 0x1a, 0x43, 0x91, 0xdf, 0x64, 0x48, 0x17, 0x8e, 0x84,
+	0x10, 0xce, 0xc7, 0x7d, 0x6f, 0x7b, 0x7f, 0x3f, 0x74, 0xf7, 0xd1, 0xf7, 0x99, 0xb2, 0x0b, 0x02,
+	0x16, 0xaa, 0xc5, 0x4e, 0x83, 0x9d, 0x95, 0x4c, 0x7e, 0x79, 0xe0, 0x7b, 0x8b, 0x48, 0xff, 0x19,
+	0x68, 0x1e, 0x96, 0x59, 0x51, 0xad, 0xb1, 0x2a, 0xd8, 0x16, 0xaa, 0xfe, 0x38, 0x0b, 0x85, 0xee,
+	0xdc, 0xde, 0x4f, 0xae, 0x9b, 0xa5, 0xaf, 0x4e, 0x52, 0x9e, 0xba, 0x1a, 0x74, 0x1d, 0xf2, 0xf6,
+	0x62, 0xe1, 0xf0, 0xc9, 0x67, 0x1a, 0xd6, 0x5a, 0x2c, 0x1c, 0x42, 0xd9, 0x78, 0xd1, 0x6c, 0x1a,
+	0x38, 0x2f, 0xdc, 0xd5, 0x5b, 0x69, 0x0c, 0xd8, 0xa1, 0x2c, 0xc2, 0x21, 0xfa, 0x65, 0x28, 0xd2,
+	0x2d, 0x06, 0xe6, 0x18, 0x8b, 0x6b, 0xde, 0x9c, 0xd6, 0xfc, 0x1e, 0xe4, 0x31, 0xe2, 0x23, 0x6d,
+	0x7a, 0x8d, 0x6f, 0xfc, 0x66, 0xf8, 0xf5, 0x93, 0x64, 0x4c, 0xcf, 0x9d, 0x74, 0x37, 0x6a, 0x00,
+	0x45, 0xa6, 0xc2, 0x91, 0x91, 0xde, 0x05, 0x48, 0xcc, 0x64, 0x25, 0xa3, 0x8a, 0x2d, 0x29, 0x20,
+	0xe3, 0x72, 0x72, 0x0b, 0xa7, 0x35, 0x1c, 0xb6, 0xd9, 0xfd, 0xe0, 0xce, 0xa0, 0xfd, 0xc4, 0xc4,
+	0xf1, 0xf7, 0x9f, 0x17, 0xa0, 0xc8, 0x6e, 0x48, 0xd1, 0x2d, 0x3a, 0xe9, 0x4d, 0xd0, 0x8b, 0x46,
+	0x2c, 0xe5, 0xba, 0xa4, 0xd2, 0x6b, 0x40, 0x97, 0xa0, 0x82, 0x6b, 0x9f, 0x96, 0xf2, 0xf2, 0x01,
+	0x5d, 0x0c, 0x1d, 0xb2, 0x97, 0x72, 0xd8, 0x83, 0x30, 0x39, 0x5a, 0x53, 0x7a, 0xea, 0x0a, 0x96,
+	0xfa, 0x16, 0x8c, 0x01, 0x05, 0x6f, 0x2e, 0xfc, 0xc0, 0xea, 0x56, 0x4d, 0xad, 0x02, 0xc2, 0x58,
+	0xfa, 0x27, 0xd2, 0xf9, 0x2a, 0xa4, 0xbc, 0x5c, 0x1e, 0xdb, 0xca, 0xf5, 0xac, 0x9f, 0xe4, 0xa4,
+	0x37, 0xf2, 0x69, 0x6a, 0x19, 0xf1, 0xe2, 0x51, 0x62, 0xaa, 0xd9, 0x6c, 0x43, 0x9d, 0x55, 0xb6,
+	0x95, 0xba, 0x3f, 0xf6, 0xc1, 0x91, 0x72, 0xac, 0x6e, 0x18, 0x8d, 0xd4, 0xa6, 0x4a, 0x08, 0xdf,
+	0x55, 0x89, 0x6c, 0x7f, 0xfa, 0x3c, 0x78, 0x6d, 0xc9, 0x17, 0x84, 0x92, 0xe5, 0xc1, 0x74, 0x34,
+	0x23, 0x06, 0xc4, 0x32, 0x23, 0xd5, 0x28, 0x09, 0x34, 0x63, 0xa8, 0xa9, 0x49, 0x60, 0x27, 0x33,
+	0x0d, 0xf1, 0x52, 0x0a, 0xbf, 0xf7, 0xc4, 0x43, 0x47, 0xbe, 0x5f, 0xf3, 0x25, 0xac, 0x31, 0xae,
+	0x15, 0x2c, 0xd8, 0x1e, 0x6b, 0x2e, 0x65, 0x20, 0x49, 0xbf, 0x48, 0xea, 0x0c, 0x38, 0x60, 0xb8,
+	0xe6, 0xaf, 0x65, 0xa0, 0xaa, 0xa8, 0xa4, 0x7f, 0x95, 0x2a, 0xc1, 0xeb, 0xa7, 0x65, 0x41, 0x2d,
+	0xcd, 0xe4, 0x4d, 0x8c, 0xac, 0x78, 0x13, 0xc3, 0xf8, 0xe8, 0xa8, 0xd5, 0xaa, 0x32, 0xe4, 0x47,
+	0xe6, 0xee, 0x36, 0xb3, 0xc7, 0x61, 0x8b, 0xa0, 0x93, 0x98, 0x35, 0xbe, 0x3c, 0x0a, 0x7a, 0x06,
+	0xea, 0xcc, 0x60, 0xad, 0xa7, 0x83, 0xdd, 0x49, 0xcf, 0x64, 0xeb, 0x5b, 0xa3, 0x56, 0xbf, 0xf3,
+	0x68, 0xf0, 0xb5, 0x45, 0xef, 0x96, 0x65, 0x8d, 0x0b, 0xc9, 0xfb, 0x40, 0xe4, 0x99, 0x96, 0xa1,
+	0xdf, 0x81, 0x96, 0x35, 0xfe, 0x69, 0x0e, 0xaa, 0x7d, 0x37, 0x96, 0xaf, 0x01, 0x5d, 0x80, 0x22,
+	0x5d, 0x4b, 0x64, 0xc7, 0x2d, 0x2a, 0x84, 0x87, 0xde, 0xf2, 0x68, 0x85, 0xfe, 0xf3, 0x50, 0xf3,
+	0x16, 0x16, 0xbf, 0xa3, 0x2e, 0x57, 0xfc, 0x2f, 0x73, 0xb0, 0x92, 0xd0, 0xed, 0xee, 0x50, 0x5c,
+	0x69, 0xaf, 0x7a, 0x8b, 0x96, 0x10, 0x90, 0x35, 0x57, 0x54, 0x5e, 0x3d, 0x68, 0x43, 0x1d, 0x6f,
+	0x8b, 0xd3, 0x33, 0x9c, 0x9e, 0xbf, 0x2f, 0x26, 0xc0, 0x1b, 0x47, 0xc4, 0x8a, 0xa7, 0xaf, 0x7b,
+	0x0c, 0x46, 0x6a, 0x8b, 0x24, 0x10, 0x35, 0x1d, 0xa8, 0xc8, 0x24, 0xf5, 0x2f, 0xa0, 0x4c, 0xdf,
+	0x31, 0x73, 0x82, 0xd9, 0xca, 0x72, 0x7a, 0x2a, 0x32, 0x0e, 0x21, 0x12, 0x4c, 0xb7, 0xd9, 0x64,
+	0xfe, 0xc4, 0x45, 0x46, 0xa9, 0x7f, 0x73, 0x0e, 0x55, 0x45, 0x83, 0xa4, 0x33, 0x48, 0x9e, 0x2b,
+	0x61, 0x9d, 0x41, 0x10, 0xc6, 0x2b, 0x1d, 0x0a, 0x22, 0xd8, 0x43, 0x03, 0x4a, 0x87, 0x82, 0xb0,
+	0xa6, 0xa2, 0x2a, 0x7b, 0xef, 0x43, 0x86, 0x8d, 0x0d, 0x28, 0x0b, 0x1d, 0xd1, 0x60, 0xba, 0xc3,
+	0x97, 0xf7, 0xd9, 0x4b, 0x27, 0xdd, 0xe1, 0xcb, 0xcf, 0xb5, 0xac, 0xf1, 0xa3, 0x02, 0xac, 0x25,
+	0x4f, 0x03, 0xd1, 0xfa, 0xed, 0xac, 0x3c, 0x6f, 0x84, 0x03, 0xdb, 0x9a, 0x6c, 0x86, 0x69, 0xf0,
+	0xb1, 0xef, 0x1b, 0x19, 0xbf, 0x52, 0x48, 0x3d, 0x51, 0xb4, 0xb2, 0xce, 0x5a, 0x68, 0x3f, 0xc6,
+	0xdf, 0xdf, 0x2b, 0xe9, 0x67, 0xa0, 0xd6, 0x69, 0xb5, 0xad, 0xc1, 0x53, 0x93, 0x90, 0x6e, 0xc7,
+	0xd4, 0xfe, 0x75, 0x49, 0x3f, 0x07, 0xeb, 0x48, 0x22, 0x66, 0xab, 0x63, 0x8d, 0xcc, 0x16, 0x69,
+	0x3f, 0xd6, 0x7e, 0xbf, 0xa4, 0x57, 0xa1, 0xb8, 0x3d, 0x78, 0xd6, 0x37, 0x89, 0xf6, 0x07, 0x2c,
+	0x30, 0x32, 0xc7, 0xdd, 0x8e, 0xf6, 0x6f, 0x4a, 0x7a, 0x05, 0xf2, 0xf8, 0x20, 0x91, 0xf6, 0x6f,
+	0x29, 0x7d, 0x64, 0x8e, 0x77, 0xba, 0x1d, 0xed, 0xdf, 0x89, 0xc0, 0xa4, 0xdb, 0xd1, 0xfe, 0x7d,
+	0x49, 0xaf, 0x41, 0x69, 0x64, 0x8e, 0x87, 0xed, 0xd6, 0x50, 0xfb, 0x0f, 0x34, 0x89, 0xdd, 0x6e,
+	0x7f, 0xf2, 0xb5, 0xd5, 0xed, 0xf5, 0x26, 0x63, 0x7c, 0xe7, 0x48, 0xfb, 0xc3, 0x92, 0x7e, 0x1e,
+	0xb4, 0xbe, 0x39, 0xb6, 0x1e, 0x75, 0xfb, 0x98, 0x30, 0x79, 0xda, 0x6d, 0x9b, 0xda, 0x7f, 0x2c,
+	0xe9, 0x3a, 0xd4, 0x29, 0x99, 0x0c, 0x5a, 0x9d, 0x76, 0x6b, 0x34, 0xd6, 0xfe, 0x53, 0x49, 0x5f,
+	0x83, 0x0a, 0xd2, 0x5a, 0x9d, 0x5e, 0xb7, 0xaf, 0xfd, 0x67, 0x1a, 0x3d, 0x86, 0x49, 0xeb, 0x99,
+	0xf6, 0x5f, 0x4a, 0x7a, 0x1d, 0xca, 0xdd, 0x61, 0xdb, 0xda, 0x1d, 0xb4, 0x9f, 0x68, 0xff, 0x95,
+	0x82, 0x31, 0xc8, 0xb4, 0xff, 0x6f, 0x25, 0x7d, 0x1d, 0x60, 0xf4, 0xcd, 0xc8, 0xea, 0x0d, 0x3a,
+	0x93, 0x5d, 0x53, 0xfb, 0xef, 0x14, 0x80, 0x04, 0xd2, 0x7a, 0xd6, 0x1d, 0x68, 0xff, 0x43, 0x02,
+	0xda, 0x8f, 0xc9, 0x60, 0x30, 0xd6, 0xfe, 0xa7, 0x24, 0x0c, 0xc7, 0xa4, 0xd5, 0x36, 0xb5, 0xff,
+	0x25, 0x25, 0x86, 0xad, 0x76, 0x7b, 0xac, 0xfd, 0x91, 0x0c, 0x33, 0x7d, 0xfe, 0x37, 0xd5, 0x00,
+	0xc3, 0x8f, 0x50, 0xfe, 0x27, 0x32, 0xd8, 0xc7, 0x1c, 0xfd, 0x1f, 0x5a, 0xe8, 0x34, 0x3d, 0xee,
+	0xea, 0x6b, 0x7f, 0xa2, 0x2c, 0x10, 0x38, 0xf9, 0xd1, 0x7e, 0x50, 0xd6, 0xcf, 0xc2, 0x1a, 0x0d,
+	0x8e, 0xbf, 0xc1, 0x59, 0xd7, 0x76, 0x77, 0x47, 0xfb, 0xe5, 0x32, 0xd6, 0x5b, 0xef, 0x49, 0x7f,
+	0xd0, 0xd1, 0x7e, 0x85, 0xfe, 0xef, 0x9a, 0xad, 0x91, 0xa9, 0xfd, 0x6a, 0x59, 0xd7, 0xa0, 0xda,
+	0x9a, 0x74, 0xba, 0x63, 0xeb, 0x19, 0xe9, 0x8e, 0x4d, 0xed, 0x4f, 0x96, 0xb1, 0xc8, 0x18, 0x05,
+	0xa7, 0x6c, 0x64, 0xb0, 0xab, 0xfd, 0xa9, 0x32, 0xaf, 0x81, 0x6d, 0xac, 0x81, 0x5f, 0x2b, 0xa3,
+	0x0a, 0x3d, 0xb5, 0xde, 0x7f, 0xbd, 0x8c, 0x79, 0x40, 0x12, 0xcb, 0xc3, 0x9f, 0x2e, 0xd3, 0xfa,
+	0xfb, 0x66, 0xb4, 0x3b, 0xd8, 0xd1, 0xfe, 0x4c, 0x19, 0x4b, 0xe0, 0x59, 0xeb, 0x89, 0x69, 0xe1,
+	0x73, 0x03, 0x3d, 0xed, 0xcf, 0xd2, 0x24, 0x1e, 0x61, 0x01, 0x5b, 0xa3, 0xc9, 0x68, 0x68, 0xf6,
+	0x3b, 0xda, 0x6f, 0x50, 0x10, 0x4b, 0x16, 0x6d, 0x47, 0xfb, 0x61, 0xd9, 0x78, 0x02, 0x95, 0x5d,
+	0xcf, 0x5f, 0xbe, 0xa6, 0x86, 0xfd, 0x73, 0xb0, 0x2e, 0x4d, 0xf4, 0x8d, 0x38, 0x7d, 0xa7, 0x6e,
+	0xda, 0xa5, 0x6d, 0x9b, 0xac, 0x39, 0xa9, 0xb0, 0xf1, 0xbb, 0x39, 0x00, 0x42, 0x9d, 0x3a, 0xfe,
+	0xb2, 0x4c, 0x29, 0x4c, 0xf9, 0x7e, 0xf2, 0x2a, 0xbc, 0xc4, 0xf0, 0x5f, 0x22, 0x80, 0xcd, 0x3f,
+	0xca, 0x42, 0x91, 0xd1, 0xf4, 0xbb, 0xa9, 0x21, 0xe2, 0xca, 0x71, 0xb2, 0x2b, 0x43, 0xc3, 0x81,
+	0x1d, 0x4e, 0xf9, 0xed, 0x5b, 0xfa, 0x8f, 0x34, 0x3c, 0xc9, 0xc9, 0x9d, 0x41, 0xfa, 0x6f, 0xfc,
+	0x85, 0xec, 0x31, 0x4f, 0x9c, 0x90, 0xdd, 0xde, 0xd8, 0x6a, 0xe1, 0x13, 0x0d, 0x75, 0xa8, 0xd0,
+	0x40, 0x7b, 0x40, 0x70, 0x3b, 0xa3, 0x06, 0x65, 0x16, 0x1c, 0x4e, 0xb4, 0x9c, 0x64, 0x76, 0x5a,
+	0xe3, 0x96, 0x96, 0xc7, 0xf7, 0xb9, 0x68, 0x70, 0x7b, 0xd4, 0xfd, 0x3e, 0x7f, 0xaf, 0x8b, 0x86,
+	0xb1, 0xf4, 0x71, 0xda, 0xa9, 0x41, 0x8d, 0x86, 0x7b, 0x66, 0x8f, 0x5a, 0x3c, 0xda, 0x57, 0x9d,
+	0x51, 0x46, 0x3b, 0xdf, 0x9b, 0x98, 0x13, 0x53, 0x2b, 0xcb, 0x38, 0xa9, 0x09, 0x56, 0xf4, 0x75,
+	0xa8, 0xb2, 0xe0, 0x60, 0xbb, 0xbb, 0x6b, 0x6a, 0x20, 0x23, 0xed, 0x0f, 0xc9, 0xa0, 0xad, 0x55,
+	0xa5, 0x46, 0x64, 0x34, 0xd2, 0x6a, 0x12, 0x4e, 0xc6, 0x43, 0xd2, 0x1d, 0x68, 0x75, 0x85, 0x40,
+	0x2d, 0x76, 0x8d, 0xce, 0xa5, 0x91, 0x30, 0xea, 0xee, 0xa0, 0x35, 0xe0, 0xa3, 0x76, 0xeb, 0x32,
+	0xd2, 0xd1, 0xb8, 0xd5, 0x7e, 0xa2, 0x69, 0xc6, 0x0f, 0x32, 0x50, 0x1a, 0x8f, 0xbf, 0xa1, 0xd5,
+	0xf7, 0x10, 0xaa, 0xaf, 0x3c, 0x7f, 0x1a, 0xbc, 0xb2, 0x22, 0xef, 0x97, 0xc4, 0xb5, 0x79, 0xe1,
+	0xeb, 0x70, 0xd0, 0xed, 0x67, 0x14, 0x31, 0xf2, 0x7e, 0xc9, 0x25, 0xf0, 0x4a, 0xfe, 0x37, 0x1f,
+	0x02, 0x24, 0x1c, 0x76, 0xb1, 0xfa, 0x55, 0x24, 0x5e, 0x9b, 0xc2, 0x7f, 0x5c, 0x12, 0x73, 0x70,
+	0xb4, 0xf7, 0x23, 0xde, 0x67, 0x8b, 0xa0, 0xf1, 0xdb, 0x65, 0xa8, 0xa7, 0xd6, 0x19, 0x15, 0x77,
+	0x2b, 0xab, 0xb8, 0x5b, 0x29, 0x8c, 0x6a, 0x05, 0x1f, 0x26, 0xbb, 0x5c, 0xec, 0x10, 0xc0, 0xca,
+	0x45, 0x7d, 0xc1, 0xc5, 0x9b, 0xfa, 0x29, 0x3f, 0xfd, 0xea, 0x91, 0x31, 0x33, 0x6f, 0x89, 0xbd,
+	0x7f, 0xc0, 0xe0, 0x2b, 0xbb, 0xf5, 0xe9, 0x87, 0x69, 0xee, 0x03, 0x7b, 0xd8, 0x93, 0xfb, 0x94,
+	0x1b, 0x47, 0xc6, 0xd9, 0x43, 0x1a, 0x5b, 0x9f, 0xa7, 0x6c, 0xfd, 0x0b, 0x7a, 0x55, 0x80, 0x2d,
+	0xac, 0xd3, 0x7d, 0xcf, 0x52, 0xea, 0x71, 0x12, 0x65, 0x48, 0xa5, 0x37, 0x03, 0x44, 0x00, 0x77,
+	0x5f, 0x60, 0x86, 0x0d, 0x98, 0x35, 0xd7, 0x72, 0xea, 0xb9, 0x0a, 0xd9, 0xb2, 0x49, 0x65, 0x26,
+	0x7e, 0x71, 0xfd, 0x92, 0x35, 0x36, 0x26, 0x51, 0x49, 0x39, 0x70, 0x49, 0xeb, 0x22, 0x10, 0xce,
+	0xc4, 0x3f, 0x2e, 0x9e, 0xc6, 0xa2, 0x47, 0x80, 0xd4, 0xe2, 0x29, 0xb7, 0x03, 0x52, 0x8a, 0x59,
+	0x1f, 0xd0, 0xfc, 0x61, 0x1e, 0x20, 0x29, 0x31, 0x9c, 0x42, 0x33, 0x37, 0x9c, 0xbf, 0x8f, 0x42,
+	0x03, 0xfa, 0x0e, 0x94, 0x78, 0x26, 0xf8, 0xc3, 0x8e, 0x1f, 0x9e, 0x52, 0xf2, 0xa2, 0x00, 0x1e,
+	0xe6, 0x1f, 0x0f, 0x46, 0x63, 0x22, 0xa4, 0xf5, 0xe1, 0xaa, 0x5b, 0xc3, 0x0e, 0xb8, 0x7e, 0x7c,
+	0x5a, 0x74, 0xc7, 0xfa, 0x38, 0xf8, 0xa0, 0xe2, 0x22, 0xf4, 0x5e, 0x7a, 0x33, 0x77, 0x5f, 0x4e,
+	0xc9, 0xc4, 0x83, 0x8a, 0x09, 0x03, 0x8f, 0x4b, 0xc8, 0x39, 0xfd, 0xea, 0x0b, 0x3f, 0xc9, 0xbc,
+	0x5f, 0xc1, 0xe0, 0x21, 0x9d, 0xbd, 0x20, 0xc4, 0xa7, 0x9e, 0x96, 0xb3, 0x99, 0xc5, 0x0a, 0x85,
+	0x3e, 0x29, 0x46, 0xd6, 0x28, 0x7d, 0xb8, 0x9c, 0xcd, 0xd8, 0x64, 0xf4, 0x43, 0xa8, 0x33, 0x0b,
+	0xb5, 0xb8, 0x63, 0x5e, 0x92, 0x0f, 0x55, 0xd5, 0x18, 0xa3, 0x43, 0xe9, 0xff, 0xaf, 0x5d, 0xa5,
+	0x7b, 0x50, 0xe2, 0xd5, 0x40, 0x5f, 0x82, 0x1b, 0x8c, 0xf8, 0x53, 0x50, 0x8f, 0x48, 0xb7, 0xb3,
+	0x63, 0xb2, 0xd7, 0x6c, 0xfa, 0x83, 0x3e, 0x6e, 0xf7, 0x96, 0x21, 0x3f, 0x19, 0x99, 0x44, 0xcb,
+	0x37, 0x3f, 0x83, 0x8a, 0x34, 0xf6, 0x64, 0x52, 0x96, 0x39, 0x76, 0x52, 0x66, 0x5c, 0x49, 0xde,
+	0xce, 0xe1, 0x73, 0x48, 0xf6, 0xaa, 0x83, 0x39, 0x1a, 0x8c, 0xb4, 0xac, 0xf1, 0x4f, 0x32, 0xb0,
+	0xbe, 0xb2, 0xe6, 0x7c, 0xb8, 0xf1, 0x64, 0xde, 0xb2, 0xf1, 0x6c, 0x41, 0xd5, 0xa1, 0xce, 0x39,
+	0x33, 0xed, 0x95, 0xd9, 0xee, 0xbe, 0xdc, 0xf8, 0x07, 0x47, 0xfe, 0xe3, 0xe1, 0x2e, 0xb9, 0xfe,
+	0xbf, 0xe0, 0xaf, 0x79, 0xd6, 0x93, 0xb3, 0x34, 0x43, 0x76, 0x5c, 0x68, 0xe5, 0x7c, 0x78, 0xe6,
+	0x2d, 0xce, 0x87, 0x1b, 0x0e, 0x40, 0x92, 0xa6, 0x7e, 0x97, 0xb6, 0x11, 0xcb, 0x99, 0x89, 0xab,
+	0x81, 0x8d, 0x43, 0x7a, 0x61, 0xce, 0xda, 0x38, 0x73, 0xf0, 0xe9, 0xb7, 0x69, 0x40, 0x91, 0x51,
+	0x68, 0xef, 0x3a, 0xb3, 0xa3, 0x88, 0x9f, 0x99, 0xaa, 0x13, 0x11, 0x34, 0x6e, 0xe3, 0x39, 0x0b,
+	0x3a, 0xcf, 0xb8, 0x26, 0xa7, 0x23, 0xac, 0xb8, 0x6a, 0xea, 0x74, 0x44, 0x1e, 0xf4, 0xfe, 0x0c,
+	0x0a, 0x94, 0x70, 0xf2, 0xf2, 0x58, 0xf2, 0x86, 0x9e, 0xf1, 0x37, 0x32, 0x90, 0xa7, 0xa6, 0x74,
+	0x01, 0x8a, 0xfe, 0x72, 0xfe, 0x9c, 0x3f, 0x8b, 0x5a, 0x27, 0x3c, 0xa4, 0x4c, 0x2c, 0xd5, 0x47,
+	0xd9, 0x8e, 0x35, 0x3b, 0xfd, 0xe7, 0x01, 0x5e, 0x7a, 0x91, 0xc7, 0x37, 0xdb, 0xf3, 0xb4, 0xbf,
+	0xb8, 0x7a, 0xd4, 0x1e, 0xd6, 0xed, 0xa7, 0x12, 0x46, 0x14, 0x11, 0x65, 0xde, 0x55, 0x38, 0xe9,
+	0x48, 0xfb, 0x2d, 0x28, 0xb0, 0x8b, 0xa7, 0x1f, 0x40, 0x01, 0x1b, 0x88, 0x28, 0x97, 0xaa, 0x68,
+	0xd6, 0x41, 0x18, 0x13, 0xc6, 0x31, 0x7e, 0x37, 0x0b, 0xf5, 0x54, 0xda, 0x2b, 0x5a, 0xb2, 0x91,
+	0xea, 0x9d, 0xb4, 0x3c, 0xaa, 0x58, 0x36, 0xd3, 0x6f, 0x1b, 0xb2, 0x92, 0x51, 0x49, 0x58, 0x70,
+	0xe2, 0xb8, 0x93, 0x18, 0x89, 0x44, 0x58, 0x7d, 0x22, 0xad, 0x90, 0x7e, 0x22, 0xcd, 0x10, 0x39,
+	0x2c, 0xa6, 0xda, 0x21, 0xcd, 0x3e, 0xcf, 0xa2, 0x52, 0x6a, 0xa5, 0x93, 0x4a, 0xed, 0x73, 0x80,
+	0x24, 0x43, 0xe8, 0xc4, 0xc8, 0xc5, 0x78, 0xfe, 0x7a, 0xe4, 0xee, 0x84, 0xee, 0xd7, 0xd0, 0xe7,
+	0x74, 0xcd, 0xaf, 0xc7, 0x26, 0xe9, 0xb7, 0x76, 0xe9, 0x34, 0x1c, 0x9e, 0xb9, 0xde, 0xfe, 0x41,
+	0x2c, 0xa6, 0xcc, 0xaf, 0x68, 0x88, 0x5f, 0x1c, 0xe0, 0x21, 0xf9, 0x24, 0x4b, 0x56, 0x79, 0x92,
+	0xe5, 0x1f, 0x67, 0xa0, 0xfa, 0x94, 0x65, 0x84, 0xca, 0x2a, 0xd9, 0x64, 0xc6, 0x29, 0xb3, 0x49,
+	0x8f, 0xc7, 0x7b, 0xb3, 0xa9, 0x35, 0xb5, 0x63, 0x11, 0x47, 0x85, 0x52, 0x3a, 0xb8, 0x81, 0x28,
+	0xd9, 0x74, 0x4f, 0x8a, 0x5d, 0x21, 0x65, 0x6c, 0xdc, 0x7c, 0x4a, 0xd8, 0x74, 0x63, 0x33, 0xaf,
+	0x48, 0xe3, 0x9d, 0x54, 0xfd, 0x7d, 0x28, 0xed, 0x7b, 0xb1, 0x15, 0x1d, 0xd8, 0xbc, 0x74, 0x8b,
+	0xfb, 0x5e, 0x3c, 0x3a, 0xb0, 0x51, 0x0e, 0x19, 0xec, 0x10, 0x27, 0x9f, 0x84, 0x57, 0xf6, 0xbd,
+	0xf8, 0x11, 0x25, 0x08, 0xb9, 0xd8, 0xde, 0x6f, 0x94, 0xa4, 0xdc, 0xd8, 0xde, 0x37, 0xee, 0x40,
+	0x7e, 0x7b, 0x66, 0xef, 0x9f, 0xb6, 0x7c, 0xad, 0x34, 0xb5, 0x3f, 0x9f, 0x81, 0x3c, 0x09, 0x8e,
+	0x59, 0xf1, 0x4e, 0x8a, 0x34, 0x9b, 0x2a, 0xd2, 0x2d, 0x00, 0xb9, 0x39, 0x2f, 0xc6, 0xcb, 0xa3,
+	0xb6, 0xf0, 0x15, 0xd4, 0x3b, 0x9e, 0x08, 0x31, 0xb6, 0xa0, 0xd8, 0x73, 0xe3, 0xd0, 0x73, 0x4e,
+	0xcf, 0x8b, 0x78, 0xd0, 0xcd, 0xf8, 0x9d, 0x0c, 0x94, 0xf1, 0xfa, 0xa8, 0x7c, 0x9e, 0x34, 0x59,
+	0x1e, 0xa4, 0xff, 0x28, 0xe6, 0xcf, 0x3c, 0x9f, 0x79, 0x0c, 0x05, 0xc2, 0x02, 0x88, 0xa4, 0xde,
+	0xa9, 0xf0, 0xee, 0xd1, 0xdd, 0xbc, 0x0e, 0x85, 0x39, 0xad, 0xd2, 0xfc, 0xd1, 0xdb, 0x8c, 0x8c,
+	0x8b, 0xa2, 0x74, 0x25, 0x91, 0x3e, 0x35, 0xcb, 0x57, 0x0d, 0x35, 0xc8, 0x2d, 0xf9, 0x3b, 0x7c,
+	0x15, 0x82, 0xbf, 0x48, 0xd9, 0xe7, 0xdb, 0xbc, 0x15, 0x82, 0xbf, 0xb7, 0xfe, 0x18, 0x14, 0xf9,
+	0xb8, 0x73, 0x01, 0xf4, 0x0e, 0xe9, 0x3e, 0x35, 0x89, 0xd5, 0x1f, 0x8c, 0x2d, 0xb1, 0x71, 0x93,
+	0xd1, 0x75, 0x58, 0xe3, 0x74, 0x32, 0xe9, 0xf3, 0xe7, 0xa3, 0x13, 0x5a, 0xeb, 0xd1, 0x80, 0xe2,
+	0x72, 0x0a, 0x6d, 0x34, 0x1e, 0x0c, 0x87, 0x66, 0x47, 0xcb, 0xdf, 0xfa, 0x8d, 0x2c, 0x54, 0xe4,
+	0x56, 0x37, 0xce, 0x1c, 0xe8, 0x3e, 0xcb, 0x68, 0xdc, 0xda, 0xc1, 0x78, 0x8a, 0x38, 0x73, 0x10,
+	0x14, 0x32, 0x46, 0xd2, 0x7b, 0x12, 0x24, 0x12, 0xcb, 0x48, 0x0a, 0x7f, 0x86, 0x58, 0x2b, 0x4b,
+	0xb1, 0xed, 0x6e, 0xbf, 0x3b, 0x7a, 0x4c, 0xb7, 0xde, 0xd6, 0xa1, 0xca, 0x48, 0x6c, 0x8f, 0x30,
+	0x27, 0x09, 0x28, 0x85, 0xba, 0xe0, 0xec, 0x80, 0x12, 0xd8, 0xce, 0x1b, 0x4e, 0x92, 0x2b, 0x34,
+	0xbc, 0x8b, 0x43, 0x7f, 0x41, 0xa6, 0xd2, 0x21, 0x4c, 0xf9, 0x0a, 0xbe, 0x69, 0xcc, 0xb7, 0x85,
+	0x88, 0xd9, 0x6a, 0x3f, 0xa6, 0xcb, 0x06, 0x20, 0xc5, 0x76, 0xd0, 0x37, 0xa8, 0xe2, 0x26, 0x9b,
+	0x0c, 0x5a, 0x8f, 0xbe, 0xb1, 0x06, 0x43, 0x93, 0xb4, 0x70, 0xaf, 0xb6, 0x26, 0x63, 0x94, 0x3b,
+	0x5d, 0x8f, 0x2e, 0xff, 0xfd, 0x1f, 0x6f, 0x64, 0xfe, 0xe1, 0x8f, 0x37, 0x32, 0xff, 0xe2, 0xc7,
+	0x1b, 0x99, 0xbf, 0xf8, 0x2f, 0x37, 0xde, 0xfb, 0x3e, 0xd0, 0xda, 0xa4, 0xa3, 0xc3, 0xff, 0x1d,
+	0x00, 0xf8, 0xd4, 0xd5, 0x2f, 0x50, 0x5f, 0x00, 0x00,
 }
@@ -1,6 +1,5 @@
-// Code generated by protoc-gen-gogo.
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
 // source: scheduler.proto
-// DO NOT EDIT!
 
 package mesosproto
 
@@ -8,7 +7,7 @@ import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
 
-import github_com_golang_protobuf_proto "github.com/golang/protobuf/proto"
+import binary "encoding/binary"
 
 import io "io"
 
@@ -586,17 +585,17 @@ func (m *Call_Subscribe) GetFrameworkInfo() *FrameworkInfo {
 //
 // E.g. Launch a task with a newly reserved persistent volume:
 //
-//   Accept {
-//     offer_ids: [ ... ]
-//     operations: [
-//       { type: RESERVE,
-//         reserve: { resources: [ disk(role):2 ] } }
-//       { type: CREATE,
-//         create: { volumes: [ disk(role):1+persistence ] } }
-//       { type: LAUNCH,
-//         launch: { task_infos ... disk(role):1;disk(role):1+persistence } }
-//     ]
-//   }
+//	Accept {
+//	  offer_ids: [ ... ]
+//	  operations: [
+//	    { type: RESERVE,
+//	      reserve: { resources: [ disk(role):2 ] } }
+//	    { type: CREATE,
+//	      create: { volumes: [ disk(role):1+persistence ] } }
+//	    { type: LAUNCH,
+//	      launch: { task_infos ... disk(role):1;disk(role):1+persistence } }
+//	  ]
+//	}
 //
 // Note that any of the offer’s resources not used in the 'Accept'
 // call (e.g., to launch a task) are considered unused and might be
@@ -1022,7 +1021,7 @@ func (m *Event_Subscribed) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.FrameworkId == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -1036,7 +1035,8 @@ func (m *Event_Subscribed) MarshalTo(dAtA []byte) (int, error) {
 	if m.HeartbeatIntervalSeconds != nil {
 		dAtA[i] = 0x11
 		i++
-		i = encodeFixed64Scheduler(dAtA, i, uint64(math.Float64bits(float64(*m.HeartbeatIntervalSeconds))))
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.HeartbeatIntervalSeconds))))
+		i += 8
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -1105,7 +1105,7 @@ func (m *Event_Rescind) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.OfferId == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -1138,7 +1138,7 @@ func (m *Event_Update) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Status == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -1171,7 +1171,7 @@ func (m *Event_Message) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.AgentId == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -1183,7 +1183,7 @@ func (m *Event_Message) MarshalTo(dAtA []byte) (int, error) {
 		i += n11
 	}
 	if m.ExecutorId == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x12
 		i++
@@ -1195,7 +1195,7 @@ func (m *Event_Message) MarshalTo(dAtA []byte) (int, error) {
 		i += n12
 	}
 	if m.Data == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x1a
 		i++
@@ -1270,7 +1270,7 @@ func (m *Event_Error) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Message == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -1425,7 +1425,7 @@ func (m *Call_Subscribe) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.FrameworkInfo == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -1556,7 +1556,7 @@ func (m *Call_Kill) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.TaskId == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -1609,7 +1609,7 @@ func (m *Call_Shutdown) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.ExecutorId == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -1621,7 +1621,7 @@ func (m *Call_Shutdown) MarshalTo(dAtA []byte) (int, error) {
 		i += n31
 	}
 	if m.AgentId == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x12
 		i++
@@ -1654,7 +1654,7 @@ func (m *Call_Acknowledge) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.AgentId == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -1666,7 +1666,7 @@ func (m *Call_Acknowledge) MarshalTo(dAtA []byte) (int, error) {
 		i += n33
 	}
 	if m.TaskId == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x12
 		i++
@@ -1678,7 +1678,7 @@ func (m *Call_Acknowledge) MarshalTo(dAtA []byte) (int, error) {
 		i += n34
 	}
 	if m.Uuid == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x1a
 		i++
@@ -1740,7 +1740,7 @@ func (m *Call_Reconcile_Task) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.TaskId == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -1783,7 +1783,7 @@ func (m *Call_Message) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.AgentId == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0xa
 		i++
@@ -1795,7 +1795,7 @@ func (m *Call_Message) MarshalTo(dAtA []byte) (int, error) {
 		i += n37
 	}
 	if m.ExecutorId == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x12
 		i++
@@ -1807,7 +1807,7 @@ func (m *Call_Message) MarshalTo(dAtA []byte) (int, error) {
 		i += n38
 	}
 	if m.Data == nil {
-		return 0, new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return 0, new(proto.RequiredNotSetError)
 	} else {
 		dAtA[i] = 0x1a
 		i++
@@ -1853,24 +1853,6 @@ func (m *Call_Request) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeFixed64Scheduler(dAtA []byte, offset int, v uint64) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	dAtA[offset+4] = uint8(v >> 32)
-	dAtA[offset+5] = uint8(v >> 40)
-	dAtA[offset+6] = uint8(v >> 48)
-	dAtA[offset+7] = uint8(v >> 56)
-	return offset + 8
-}
-func encodeFixed32Scheduler(dAtA []byte, offset int, v uint32) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	return offset + 4
-}
 func encodeVarintScheduler(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -2660,15 +2642,8 @@ func (m *Event_Subscribed) Unmarshal(dAtA []byte) error {
 			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			v = uint64(dAtA[iNdEx-8])
-			v |= uint64(dAtA[iNdEx-7]) << 8
-			v |= uint64(dAtA[iNdEx-6]) << 16
-			v |= uint64(dAtA[iNdEx-5]) << 24
-			v |= uint64(dAtA[iNdEx-4]) << 32
-			v |= uint64(dAtA[iNdEx-3]) << 40
-			v |= uint64(dAtA[iNdEx-2]) << 48
-			v |= uint64(dAtA[iNdEx-1]) << 56
 			v2 := float64(math.Float64frombits(v))
 			m.HeartbeatIntervalSeconds = &v2
 		default:
@@ -2688,7 +2663,7 @@ func (m *Event_Subscribed) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -2890,7 +2865,7 @@ func (m *Event_Rescind) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -2979,7 +2954,7 @@ func (m *Event_Update) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -3134,13 +3109,13 @@ func (m *Event_Message) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 	if hasFields[0]&uint64(0x00000002) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 	if hasFields[0]&uint64(0x00000004) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -3363,7 +3338,7 @@ func (m *Event_Error) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -3853,7 +3828,7 @@ func (m *Call_Subscribe) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -4269,7 +4244,7 @@ func (m *Call_Kill) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -4392,10 +4367,10 @@ func (m *Call_Shutdown) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 	if hasFields[0]&uint64(0x00000002) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -4550,13 +4525,13 @@ func (m *Call_Acknowledge) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 	if hasFields[0]&uint64(0x00000002) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 	if hasFields[0]&uint64(0x00000004) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -4760,7 +4735,7 @@ func (m *Call_Reconcile_Task) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -4915,13 +4890,13 @@ func (m *Call_Message) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 	if hasFields[0]&uint64(0x00000002) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 	if hasFields[0]&uint64(0x00000004) == 0 {
-		return new(github_com_golang_protobuf_proto.RequiredNotSetError)
+		return new(proto.RequiredNotSetError)
 	}
 
 	if iNdEx > l {
@@ -5119,79 +5094,79 @@ var (
 func init() { proto.RegisterFile("scheduler.proto", fileDescriptorScheduler) }
 
 var fileDescriptorScheduler = []byte{
-	// 1176 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x97, 0xdf, 0x6e, 0xe3, 0x44,
-	0x14, 0xc6, 0xd7, 0x69, 0x62, 0x27, 0xc7, 0x6d, 0xd6, 0x0c, 0x68, 0x65, 0x99, 0xa5, 0xb4, 0xd5,
-	0x0a, 0x65, 0x41, 0x0a, 0x52, 0xd8, 0x65, 0x51, 0x81, 0x8b, 0x34, 0x99, 0x6c, 0xad, 0x66, 0x93,
-	0x32, 0x4e, 0x16, 0xc1, 0x4d, 0x71, 0xed, 0x49, 0x6b, 0xea, 0xda, 0xc1, 0x76, 0x5a, 0x2a, 0xed,
-	0x05, 0x3c, 0x00, 0xd7, 0x70, 0xcd, 0x3b, 0xf0, 0x0a, 0x68, 0x2f, 0x79, 0x04, 0x54, 0x5e, 0x04,
-	0xcd, 0x78, 0xec, 0xba, 0x7f, 0x92, 0x6e, 0x2f, 0x10, 0x77, 0xb6, 0xcf, 0xef, 0xf3, 0xf1, 0xcc,
-	0x39, 0xf3, 0x9d, 0x04, 0xee, 0xc7, 0xce, 0x21, 0x75, 0x67, 0x3e, 0x8d, 0x9a, 0xd3, 0x28, 0x4c,
-	0x42, 0x74, 0xff, 0x98, 0xc6, 0x61, 0xdc, 0xcc, 0x1f, 0x1b, 0x6a, 0xfa, 0x80, 0x47, 0x37, 0x5e,
-	0xd7, 0xa0, 0x82, 0x4f, 0x68, 0x90, 0xa0, 0x8f, 0xa1, 0x9c, 0x9c, 0x4d, 0xa9, 0x2e, 0xad, 0x49,
-	0x8d, 0x7a, 0xeb, 0xdd, 0xe6, 0x15, 0x59, 0x93, 0x53, 0xcd, 0xd1, 0xd9, 0x94, 0x12, 0x0e, 0xa2,
-	0x36, 0x40, 0x3c, 0xdb, 0x8f, 0x9d, 0xc8, 0xdb, 0xa7, 0xae, 0x5e, 0x5a, 0x93, 0x1a, 0x6a, 0x6b,
-	0x7d, 0x8e, 0xcc, 0xca, 0x41, 0x52, 0x10, 0xa1, 0xa7, 0x20, 0x87, 0x93, 0x09, 0x8d, 0x62, 0x7d,
-	0x89, 0xcb, 0xdf, 0x9b, 0x23, 0x1f, 0x72, 0x88, 0x08, 0x18, 0x7d, 0x06, 0x4a, 0x44, 0x63, 0xc7,
-	0x0b, 0x5c, 0xbd, 0xcc, 0x75, 0xab, 0x73, 0x74, 0x24, 0xa5, 0x48, 0x86, 0xb3, 0x84, 0xb3, 0xa9,
-	0x6b, 0x27, 0x54, 0xaf, 0x2c, 0x4c, 0x38, 0xe6, 0x10, 0x11, 0x30, 0x4b, 0x78, 0x4c, 0xe3, 0xd8,
-	0x3e, 0xa0, 0xba, 0xbc, 0x30, 0xe1, 0x8b, 0x94, 0x22, 0x19, 0xce, 0x94, 0x13, 0xdb, 0xf3, 0x67,
-	0x11, 0xd5, 0x95, 0x85, 0xca, 0x5e, 0x4a, 0x91, 0x0c, 0x47, 0x2d, 0xa8, 0xd0, 0x28, 0x0a, 0x23,
-	0xbd, 0xca, 0x75, 0x0f, 0xe7, 0xe8, 0x30, 0x63, 0x48, 0x8a, 0x1a, 0x3f, 0x4b, 0x00, 0x56, 0x71,
-	0x7b, 0x97, 0x27, 0x91, 0x7d, 0x4c, 0x4f, 0xc3, 0xe8, 0x68, 0xcf, 0x73, 0x75, 0x69, 0xad, 0xd4,
-	0x50, 0x5b, 0x48, 0xbc, 0xa9, 0x97, 0x85, 0xcc, 0x2e, 0x51, 0x73, 0xce, 0x74, 0xd1, 0x17, 0x60,
-	0x1c, 0x52, 0x3b, 0x4a, 0xf6, 0xa9, 0x9d, 0xec, 0x79, 0x41, 0x42, 0xa3, 0x13, 0xdb, 0xdf, 0x8b,
-	0xa9, 0x13, 0x06, 0x6e, 0xcc, 0x0b, 0x2d, 0x11, 0x3d, 0x27, 0x4c, 0x01, 0x58, 0x69, 0xdc, 0xf8,
-	0x1e, 0xe4, 0xb4, 0x5c, 0xe8, 0x51, 0x5e, 0x5d, 0x69, 0x6d, 0xa9, 0xa1, 0xb6, 0x96, 0x45, 0x62,
-	0x1e, 0xce, 0x8b, 0xb9, 0x09, 0x75, 0x2f, 0x38, 0xa1, 0x51, 0x4c, 0xf7, 0x04, 0x5d, 0xe2, 0xf4,
-	0xdb, 0x82, 0x36, 0xd3, 0x60, 0x2a, 0x5a, 0xf1, 0x0a, 0x77, 0xb1, 0xf1, 0x04, 0x14, 0x51, 0x62,
-	0xf4, 0x18, 0xaa, 0x5c, 0x7e, 0xb1, 0xce, 0x7a, 0x31, 0x9d, 0xd9, 0x25, 0x0a, 0x8f, 0x9b, 0xae,
-	0xf1, 0x09, 0xc8, 0x69, 0x7d, 0xd1, 0x63, 0x90, 0xe3, 0xc4, 0x4e, 0x66, 0xb1, 0x90, 0xbc, 0x25,
-	0x24, 0x23, 0x3b, 0x3e, 0xb2, 0x78, 0x80, 0x08, 0xc0, 0x78, 0x05, 0x8a, 0x28, 0x2e, 0x4b, 0x65,
-	0x1f, 0xd0, 0x20, 0xb9, 0x9e, 0xaa, 0xcd, 0x1e, 0xb3, 0x54, 0x3c, 0x6e, 0xba, 0xa8, 0x05, 0x2a,
-	0xfd, 0x91, 0x3a, 0xb3, 0x24, 0xe4, 0x1f, 0x56, 0xba, 0x94, 0x05, 0x8b, 0x88, 0xd9, 0x25, 0x90,
-	0x51, 0xa6, 0x8b, 0x10, 0x94, 0x5d, 0x3b, 0xb1, 0xf5, 0xa5, 0xb5, 0x52, 0x63, 0x99, 0xf0, 0x6b,
-	0xe3, 0x27, 0x09, 0x14, 0xd1, 0x21, 0x57, 0xd2, 0x4b, 0x77, 0x4a, 0x2f, 0xdd, 0x9e, 0xfe, 0x41,
-	0xbe, 0x27, 0xec, 0x4c, 0x56, 0xf2, 0x0d, 0x58, 0x87, 0x0a, 0xef, 0x35, 0xa4, 0x5f, 0x1c, 0x06,
-	0xb6, 0xfa, 0x5a, 0xde, 0xec, 0x1b, 0xaf, 0xa0, 0xcc, 0xfc, 0x01, 0xa9, 0xa0, 0x8c, 0x07, 0x3b,
-	0x83, 0xe1, 0xd7, 0x03, 0xed, 0x1e, 0xaa, 0x03, 0x58, 0xe3, 0x2d, 0xab, 0x43, 0xcc, 0x2d, 0xdc,
-	0xd5, 0x24, 0x16, 0x24, 0xd8, 0xea, 0x98, 0x83, 0xae, 0xb6, 0x84, 0x00, 0xe4, 0xf1, 0x6e, 0xb7,
-	0x3d, 0xc2, 0x5a, 0x99, 0x05, 0x5e, 0x60, 0xcb, 0x6a, 0x3f, 0xc7, 0x5a, 0x85, 0xdd, 0xf4, 0xda,
-	0x66, 0x7f, 0x4c, 0xb0, 0x26, 0x33, 0x6a, 0xd8, 0xeb, 0x61, 0x62, 0x69, 0x25, 0xc4, 0xfc, 0x8a,
-	0x90, 0x21, 0xd1, 0x14, 0xb4, 0x02, 0xb5, 0x6d, 0xdc, 0x26, 0xa3, 0x2d, 0xdc, 0x1e, 0x69, 0xd5,
-	0x8d, 0x3f, 0xeb, 0x50, 0xee, 0xd8, 0xbe, 0x7f, 0x43, 0xdb, 0x4b, 0x6f, 0xd2, 0xf6, 0x4d, 0x61,
-	0x80, 0x25, 0x6e, 0x80, 0xc6, 0xb5, 0xf3, 0xc6, 0xde, 0x5d, 0xf4, 0xbf, 0x2f, 0xa1, 0x96, 0x5b,
-	0x99, 0xf0, 0xaf, 0xf7, 0x6f, 0x16, 0xe5, 0x47, 0x92, 0x5c, 0x28, 0xd0, 0x13, 0x90, 0x6d, 0xc7,
-	0xa1, 0xd3, 0x44, 0x78, 0xd8, 0xc3, 0x9b, 0xb5, 0x6d, 0xce, 0x10, 0xc1, 0xa2, 0x67, 0xa0, 0xb8,
-	0xd4, 0xf1, 0xbd, 0x60, 0xbe, 0x83, 0x71, 0x59, 0x37, 0x85, 0x48, 0x46, 0xb3, 0xd5, 0x1d, 0x79,
-	0xbe, 0x2f, 0xfc, 0x6b, 0xce, 0xea, 0x76, 0x3c, 0xdf, 0x27, 0x9c, 0x43, 0x9b, 0x50, 0x8d, 0x0f,
-	0x67, 0x89, 0x1b, 0x9e, 0x06, 0x73, 0x9d, 0x2b, 0x5d, 0x9c, 0xa0, 0x48, 0xce, 0xa3, 0x0e, 0xa8,
-	0xb6, 0x73, 0x14, 0x84, 0xa7, 0x3e, 0x75, 0x0f, 0xa8, 0x30, 0xb0, 0xf5, 0x79, 0xeb, 0xcb, 0x41,
-	0x52, 0x54, 0xb1, 0xed, 0x8d, 0x98, 0xa5, 0x38, 0x9e, 0x4f, 0xf5, 0xda, 0xa2, 0xed, 0x25, 0x19,
-	0x46, 0x2e, 0x14, 0x6c, 0xa3, 0xb2, 0x2e, 0x85, 0x45, 0x1b, 0x75, 0xcd, 0xb1, 0x9f, 0xb1, 0xe1,
-	0xf2, 0xc3, 0x8c, 0xc6, 0x89, 0xae, 0x2e, 0x12, 0x92, 0x14, 0x22, 0x19, 0x6d, 0x6c, 0x43, 0x2d,
-	0x2f, 0x34, 0xfa, 0x1c, 0xea, 0x85, 0x1e, 0x0c, 0x26, 0xa1, 0x70, 0x8a, 0x77, 0xae, 0x75, 0x61,
-	0x30, 0x09, 0xc9, 0xca, 0xa4, 0x78, 0x6b, 0xfc, 0x2a, 0x81, 0x9c, 0xd6, 0x1d, 0x7d, 0x04, 0xb5,
-	0xcc, 0xd6, 0x32, 0x1b, 0xbd, 0xea, 0x6b, 0x55, 0xe1, 0x6b, 0x31, 0xfa, 0x14, 0x20, 0x9c, 0xd2,
-	0xc8, 0x4e, 0xbc, 0x30, 0xc8, 0x6c, 0xf4, 0x41, 0x91, 0x6e, 0x0e, 0xb3, 0x30, 0x29, 0x90, 0xa8,
-	0x01, 0xca, 0xc4, 0xf3, 0x93, 0x8b, 0x39, 0x9c, 0xa5, 0xe8, 0xa5, 0x4f, 0x49, 0x16, 0x36, 0xbe,
-	0x03, 0x45, 0x74, 0xd6, 0xdd, 0xbe, 0xac, 0x90, 0xa1, 0xb4, 0x38, 0xc3, 0x2f, 0x12, 0x94, 0x59,
-	0x1b, 0xa2, 0x0f, 0x40, 0x49, 0xec, 0xb8, 0x30, 0xb7, 0x56, 0x0a, 0xe6, 0x6c, 0x76, 0x89, 0xcc,
-	0xa2, 0xa6, 0x7b, 0xc9, 0x0e, 0x4b, 0xb7, 0xda, 0x21, 0xeb, 0xed, 0xbd, 0x69, 0xe8, 0x7b, 0xce,
-	0x99, 0x58, 0x6b, 0x66, 0x87, 0x2c, 0xe9, 0x2e, 0x0f, 0x10, 0x38, 0xca, 0xaf, 0x0d, 0x0f, 0xaa,
-	0x59, 0x87, 0x5f, 0xb5, 0x53, 0xe9, 0x4d, 0xdc, 0xfc, 0xf2, 0xe7, 0x2d, 0x1a, 0x16, 0x46, 0x02,
-	0x6a, 0xe1, 0x34, 0xdc, 0x65, 0xcc, 0x14, 0xf6, 0xaa, 0xb4, 0x68, 0xaf, 0x10, 0x94, 0x67, 0x33,
-	0xcf, 0xcd, 0x46, 0x0b, 0xbb, 0x36, 0x7e, 0x97, 0xa0, 0x96, 0x9f, 0x20, 0xb4, 0x09, 0x15, 0xc6,
-	0x66, 0x15, 0x7d, 0x74, 0xcb, 0x89, 0xe3, 0x09, 0x48, 0x2a, 0x31, 0xbe, 0x81, 0x32, 0xbb, 0xfd,
-	0x0f, 0x2a, 0xf7, 0x3f, 0x4f, 0xdf, 0xa7, 0xec, 0x67, 0x06, 0x3f, 0xe4, 0xe8, 0x43, 0xa8, 0x8a,
-	0xf3, 0x7e, 0xb5, 0xe9, 0x33, 0x3f, 0xc8, 0xe3, 0x1b, 0x7f, 0x48, 0x37, 0xcd, 0xc3, 0x15, 0xa8,
-	0xe5, 0xf3, 0x50, 0x93, 0xd0, 0x32, 0x54, 0x47, 0xb8, 0x4d, 0xba, 0x2c, 0x58, 0x62, 0x93, 0xae,
-	0xdd, 0xe9, 0xe0, 0xdd, 0x91, 0xb6, 0xc4, 0x54, 0x5d, 0xdc, 0xe9, 0x9b, 0x03, 0x36, 0x1c, 0x01,
-	0x64, 0x82, 0x5f, 0x9a, 0x2f, 0xd9, 0x6c, 0xac, 0x42, 0x79, 0xc7, 0xec, 0xf7, 0x35, 0x99, 0x89,
-	0xad, 0xed, 0xf1, 0x88, 0x8b, 0x15, 0x74, 0x1f, 0xd4, 0x76, 0x87, 0xa5, 0xe9, 0xe3, 0xee, 0x73,
-	0xac, 0x55, 0x59, 0x2a, 0x82, 0x3b, 0xc3, 0x41, 0xc7, 0xec, 0x63, 0xad, 0x56, 0x1c, 0xb0, 0x90,
-	0x8e, 0xe1, 0xaf, 0xc6, 0xd8, 0x1a, 0x69, 0x2a, 0x7f, 0xcf, 0x78, 0x77, 0x97, 0x60, 0xcb, 0xd2,
-	0x96, 0xb7, 0x1e, 0xbe, 0x3e, 0x5f, 0x95, 0xfe, 0x3a, 0x5f, 0x95, 0xfe, 0x3e, 0x5f, 0x95, 0x7e,
-	0xfb, 0x67, 0xf5, 0xde, 0xb7, 0xc0, 0x97, 0xc8, 0xff, 0x31, 0xfc, 0x1b, 0x00, 0x00, 0xff, 0xff,
-	0x36, 0x59, 0xe8, 0x53, 0x61, 0x0c, 0x00, 0x00,
+	// 1174 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x97, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0xc7, 0xd7, 0xf9, 0xb0, 0x93, 0xe3, 0x36, 0x6b, 0x06, 0xb4, 0xb2, 0xcc, 0x52, 0xba, 0xd5,
+	0x0a, 0x65, 0x41, 0x0a, 0x52, 0xd8, 0x65, 0xd1, 0x02, 0x17, 0x69, 0x32, 0xd9, 0x5a, 0xcd, 0x26,
+	0x65, 0x9c, 0x2c, 0x82, 0x9b, 0xe2, 0xda, 0x93, 0xd6, 0xd4, 0xb5, 0x83, 0xed, 0xb4, 0x54, 0xda,
+	0x0b, 0x78, 0x00, 0xae, 0xe1, 0x9a, 0x77, 0xe0, 0x15, 0xd0, 0x5e, 0xf2, 0x08, 0xa8, 0xbc, 0x08,
+	0x9a, 0xf1, 0xd8, 0x75, 0x3f, 0x92, 0x6e, 0x2f, 0x10, 0x77, 0xb6, 0xcf, 0xef, 0xef, 0xe3, 0x99,
+	0x73, 0xe6, 0x7f, 0x12, 0xb8, 0x1b, 0x3b, 0x07, 0xd4, 0x9d, 0xfb, 0x34, 0x6a, 0xcd, 0xa2, 0x30,
+	0x09, 0xd1, 0xdd, 0x23, 0x1a, 0x87, 0x71, 0x2b, 0x7f, 0x6c, 0xa8, 0xe9, 0x03, 0x1e, 0xdd, 0x78,
+	0x5d, 0x87, 0x2a, 0x3e, 0xa6, 0x41, 0x82, 0x3e, 0x86, 0x4a, 0x72, 0x3a, 0xa3, 0xba, 0xb4, 0x2e,
+	0x35, 0x1b, 0xed, 0x77, 0x5b, 0x97, 0x64, 0x2d, 0x4e, 0xb5, 0xc6, 0xa7, 0x33, 0x4a, 0x38, 0x88,
+	0x3a, 0x00, 0xf1, 0x7c, 0x2f, 0x76, 0x22, 0x6f, 0x8f, 0xba, 0x7a, 0x69, 0x5d, 0x6a, 0xaa, 0xed,
+	0x07, 0x0b, 0x64, 0x56, 0x0e, 0x92, 0x82, 0x08, 0x3d, 0x01, 0x39, 0x9c, 0x4e, 0x69, 0x14, 0xeb,
+	0x65, 0x2e, 0x7f, 0x6f, 0x81, 0x7c, 0xc4, 0x21, 0x22, 0x60, 0xf4, 0x19, 0x28, 0x11, 0x8d, 0x1d,
+	0x2f, 0x70, 0xf5, 0x0a, 0xd7, 0xad, 0x2d, 0xd0, 0x91, 0x94, 0x22, 0x19, 0xce, 0x12, 0xce, 0x67,
+	0xae, 0x9d, 0x50, 0xbd, 0xba, 0x34, 0xe1, 0x84, 0x43, 0x44, 0xc0, 0x2c, 0xe1, 0x11, 0x8d, 0x63,
+	0x7b, 0x9f, 0xea, 0xf2, 0xd2, 0x84, 0x2f, 0x52, 0x8a, 0x64, 0x38, 0x53, 0x4e, 0x6d, 0xcf, 0x9f,
+	0x47, 0x54, 0x57, 0x96, 0x2a, 0xfb, 0x29, 0x45, 0x32, 0x1c, 0xb5, 0xa1, 0x4a, 0xa3, 0x28, 0x8c,
+	0xf4, 0x1a, 0xd7, 0xdd, 0x5f, 0xa0, 0xc3, 0x8c, 0x21, 0x29, 0x6a, 0xfc, 0x2c, 0x01, 0x58, 0xc5,
+	0xed, 0x5d, 0x99, 0x46, 0xf6, 0x11, 0x3d, 0x09, 0xa3, 0xc3, 0x5d, 0xcf, 0xd5, 0xa5, 0xf5, 0x52,
+	0x53, 0x6d, 0x23, 0xf1, 0xa6, 0x7e, 0x16, 0x32, 0x7b, 0x44, 0xcd, 0x39, 0xd3, 0x45, 0x5f, 0x80,
+	0x71, 0x40, 0xed, 0x28, 0xd9, 0xa3, 0x76, 0xb2, 0xeb, 0x05, 0x09, 0x8d, 0x8e, 0x6d, 0x7f, 0x37,
+	0xa6, 0x4e, 0x18, 0xb8, 0x31, 0x2f, 0xb4, 0x44, 0xf4, 0x9c, 0x30, 0x05, 0x60, 0xa5, 0x71, 0xe3,
+	0x7b, 0x90, 0xd3, 0x72, 0xa1, 0x87, 0x79, 0x75, 0xa5, 0xf5, 0x72, 0x53, 0x6d, 0xaf, 0x88, 0xc4,
+	0x3c, 0x9c, 0x17, 0xf3, 0x19, 0x34, 0xbc, 0xe0, 0x98, 0x46, 0x31, 0xdd, 0x15, 0x74, 0x89, 0xd3,
+	0x6f, 0x0b, 0xda, 0x4c, 0x83, 0xa9, 0x68, 0xd5, 0x2b, 0xdc, 0xc5, 0xc6, 0x63, 0x50, 0x44, 0x89,
+	0xd1, 0x23, 0xa8, 0x71, 0xf9, 0xf9, 0x3a, 0x1b, 0xc5, 0x74, 0x66, 0x8f, 0x28, 0x3c, 0x6e, 0xba,
+	0xc6, 0x27, 0x20, 0xa7, 0xf5, 0x45, 0x8f, 0x40, 0x8e, 0x13, 0x3b, 0x99, 0xc7, 0x42, 0xf2, 0x96,
+	0x90, 0x8c, 0xed, 0xf8, 0xd0, 0xe2, 0x01, 0x22, 0x00, 0xe3, 0x15, 0x28, 0xa2, 0xb8, 0x2c, 0x95,
+	0xbd, 0x4f, 0x83, 0xe4, 0x6a, 0xaa, 0x0e, 0x7b, 0xcc, 0x52, 0xf1, 0xb8, 0xe9, 0xa2, 0x36, 0xa8,
+	0xf4, 0x47, 0xea, 0xcc, 0x93, 0x90, 0x7f, 0x58, 0xe9, 0x42, 0x16, 0x2c, 0x22, 0x66, 0x8f, 0x40,
+	0x46, 0x99, 0x2e, 0x42, 0x50, 0x71, 0xed, 0xc4, 0xd6, 0xcb, 0xeb, 0xa5, 0xe6, 0x0a, 0xe1, 0xd7,
+	0xc6, 0x4f, 0x12, 0x28, 0xa2, 0x43, 0x2e, 0xa5, 0x97, 0x6e, 0x95, 0x5e, 0xba, 0x39, 0xfd, 0xbd,
+	0x7c, 0x4f, 0xd8, 0x99, 0xac, 0xe6, 0x1b, 0xf0, 0x00, 0xaa, 0xbc, 0xd7, 0x90, 0x7e, 0x7e, 0x18,
+	0xd8, 0xea, 0xeb, 0x79, 0xb3, 0x6f, 0xbc, 0x82, 0x0a, 0xf3, 0x07, 0xa4, 0x82, 0x32, 0x19, 0x6e,
+	0x0f, 0x47, 0x5f, 0x0f, 0xb5, 0x3b, 0xa8, 0x01, 0x60, 0x4d, 0x36, 0xad, 0x2e, 0x31, 0x37, 0x71,
+	0x4f, 0x93, 0x58, 0x90, 0x60, 0xab, 0x6b, 0x0e, 0x7b, 0x5a, 0x19, 0x01, 0xc8, 0x93, 0x9d, 0x5e,
+	0x67, 0x8c, 0xb5, 0x0a, 0x0b, 0xbc, 0xc0, 0x96, 0xd5, 0x79, 0x8e, 0xb5, 0x2a, 0xbb, 0xe9, 0x77,
+	0xcc, 0xc1, 0x84, 0x60, 0x4d, 0x66, 0xd4, 0xa8, 0xdf, 0xc7, 0xc4, 0xd2, 0x4a, 0x88, 0xf9, 0x15,
+	0x21, 0x23, 0xa2, 0x29, 0x68, 0x15, 0xea, 0x5b, 0xb8, 0x43, 0xc6, 0x9b, 0xb8, 0x33, 0xd6, 0x6a,
+	0x1b, 0x7f, 0x36, 0xa0, 0xd2, 0xb5, 0x7d, 0xff, 0x9a, 0xb6, 0x97, 0xde, 0xa4, 0xed, 0x5b, 0xc2,
+	0x00, 0x4b, 0xdc, 0x00, 0x8d, 0x2b, 0xe7, 0x8d, 0xbd, 0xbb, 0xe8, 0x7f, 0x5f, 0x42, 0x3d, 0xb7,
+	0x32, 0xe1, 0x5f, 0xef, 0x5f, 0x2f, 0xca, 0x8f, 0x24, 0x39, 0x57, 0xa0, 0xc7, 0x20, 0xdb, 0x8e,
+	0x43, 0x67, 0x89, 0x5e, 0x59, 0x70, 0xc0, 0xb9, 0xb6, 0xc3, 0x19, 0x22, 0x58, 0xf4, 0x14, 0x14,
+	0x97, 0x3a, 0xbe, 0x17, 0x2c, 0x76, 0x30, 0x2e, 0xeb, 0xa5, 0x10, 0xc9, 0x68, 0xb6, 0xba, 0x43,
+	0xcf, 0xf7, 0x85, 0x7f, 0x2d, 0x58, 0xdd, 0xb6, 0xe7, 0xfb, 0x84, 0x73, 0xe8, 0x19, 0xd4, 0xe2,
+	0x83, 0x79, 0xe2, 0x86, 0x27, 0xc1, 0x42, 0xe7, 0x4a, 0x17, 0x27, 0x28, 0x92, 0xf3, 0xa8, 0x0b,
+	0xaa, 0xed, 0x1c, 0x06, 0xe1, 0x89, 0x4f, 0xdd, 0x7d, 0xaa, 0xd7, 0x16, 0x8c, 0x06, 0xb1, 0xbe,
+	0x1c, 0x24, 0x45, 0x15, 0xdb, 0xde, 0x88, 0x59, 0x8a, 0xe3, 0xf9, 0x54, 0xaf, 0x2f, 0xdb, 0x5e,
+	0x92, 0x61, 0xe4, 0x5c, 0xc1, 0x36, 0x2a, 0xeb, 0x52, 0x58, 0xb6, 0x51, 0x57, 0x1c, 0xfb, 0x29,
+	0x1b, 0x2e, 0x3f, 0xcc, 0x69, 0x9c, 0xe8, 0xea, 0x32, 0x21, 0x49, 0x21, 0x92, 0xd1, 0xc6, 0x16,
+	0xd4, 0xf3, 0x42, 0xa3, 0xcf, 0xa1, 0x51, 0xe8, 0xc1, 0x60, 0x1a, 0x0a, 0xa7, 0x78, 0xe7, 0x4a,
+	0x17, 0x06, 0xd3, 0x90, 0xac, 0x4e, 0x8b, 0xb7, 0xc6, 0xaf, 0x12, 0xc8, 0x69, 0xdd, 0xd1, 0x47,
+	0x50, 0xcf, 0x6c, 0x2d, 0xb3, 0xd1, 0xcb, 0xbe, 0x56, 0x13, 0xbe, 0x16, 0xa3, 0x4f, 0x01, 0xc2,
+	0x19, 0x8d, 0xec, 0xc4, 0x0b, 0x83, 0xcc, 0x46, 0xef, 0x15, 0xe9, 0xd6, 0x28, 0x0b, 0x93, 0x02,
+	0x89, 0x9a, 0xa0, 0x4c, 0x3d, 0x3f, 0x39, 0x9f, 0xc3, 0x59, 0x8a, 0x7e, 0xfa, 0x94, 0x64, 0x61,
+	0xe3, 0x3b, 0x50, 0x44, 0x67, 0xdd, 0xee, 0xcb, 0x0a, 0x19, 0x4a, 0xcb, 0x33, 0xfc, 0x22, 0x41,
+	0x85, 0xb5, 0x21, 0xfa, 0x00, 0x94, 0xc4, 0x8e, 0x0b, 0x73, 0x6b, 0xb5, 0x60, 0xce, 0x66, 0x8f,
+	0xc8, 0x2c, 0x6a, 0xba, 0x17, 0xec, 0xb0, 0x74, 0xa3, 0x1d, 0xb2, 0xde, 0xde, 0x9d, 0x85, 0xbe,
+	0xe7, 0x9c, 0xea, 0xe5, 0x0b, 0x76, 0xc8, 0x92, 0xee, 0xf0, 0x00, 0x81, 0xc3, 0xfc, 0xda, 0xf0,
+	0xa0, 0x96, 0x75, 0xf8, 0x65, 0x3b, 0x95, 0xde, 0xc4, 0xcd, 0x2f, 0x7e, 0xde, 0xb2, 0x61, 0x61,
+	0x24, 0xa0, 0x16, 0x4e, 0xc3, 0x6d, 0xc6, 0x4c, 0x61, 0xaf, 0x4a, 0xcb, 0xf6, 0x0a, 0x41, 0x65,
+	0x3e, 0xf7, 0xdc, 0x6c, 0xb4, 0xb0, 0x6b, 0xe3, 0x77, 0x09, 0xea, 0xf9, 0x09, 0x42, 0xcf, 0xa0,
+	0xca, 0xd8, 0xac, 0xa2, 0x0f, 0x6f, 0x38, 0x71, 0x3c, 0x01, 0x49, 0x25, 0xc6, 0x37, 0x50, 0x61,
+	0xb7, 0xff, 0x41, 0xe5, 0xfe, 0xe7, 0xe9, 0xfb, 0x84, 0xfd, 0xcc, 0xe0, 0x87, 0x1c, 0x7d, 0x08,
+	0x35, 0x71, 0xde, 0x2f, 0x37, 0xbd, 0x20, 0x48, 0x1e, 0xdf, 0xf8, 0x43, 0xba, 0x6e, 0x1e, 0xae,
+	0x42, 0x3d, 0x9f, 0x87, 0x9a, 0x84, 0x56, 0xa0, 0x36, 0xc6, 0x1d, 0xd2, 0x63, 0xc1, 0x12, 0x9b,
+	0x74, 0x9d, 0x6e, 0x17, 0xef, 0x8c, 0xb5, 0x32, 0x53, 0xf5, 0x70, 0x77, 0x60, 0x0e, 0xd9, 0x70,
+	0x04, 0x90, 0x09, 0x7e, 0x69, 0xbe, 0x64, 0xb3, 0xb1, 0x06, 0x95, 0x6d, 0x73, 0x30, 0xd0, 0x64,
+	0x26, 0xb6, 0xb6, 0x26, 0x63, 0x2e, 0x56, 0xd0, 0x5d, 0x50, 0x3b, 0x5d, 0x96, 0x66, 0x80, 0x7b,
+	0xcf, 0xb1, 0x56, 0x63, 0xa9, 0x08, 0xee, 0x8e, 0x86, 0x5d, 0x73, 0x80, 0xb5, 0x7a, 0x71, 0xc0,
+	0x42, 0x3a, 0x86, 0xbf, 0x9a, 0x60, 0x6b, 0xac, 0xa9, 0xfc, 0x3d, 0x93, 0x9d, 0x1d, 0x82, 0x2d,
+	0x4b, 0x5b, 0xd9, 0xbc, 0xff, 0xfa, 0x6c, 0x4d, 0xfa, 0xeb, 0x6c, 0x4d, 0xfa, 0xfb, 0x6c, 0x4d,
+	0xfa, 0xed, 0x9f, 0xb5, 0x3b, 0xdf, 0x02, 0x5f, 0x22, 0xff, 0xc7, 0xf0, 0xef, 0x00, 0x36, 0x59,
+	0xe8, 0x53, 0x61, 0x0c, 0x00, 0x00,
 }
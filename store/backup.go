@@ -0,0 +1,586 @@
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/Dataman-Cloud/swan/types"
+)
+
+// BackupVersion is the version of the backup archive, the archives of other versions
+// are refused to restore.
+const BackupVersion = 1
+
+// the kinds of the records in the backup archive.
+const (
+	recordHeader      = "header"
+	recordApp         = "app"
+	recordVersion     = "version"
+	recordTask        = "task"
+	recordCompose     = "compose"
+	recordAgent       = "agent"
+	recordQuota       = "quota"
+	recordJob         = "job"
+	recordCronJob     = "cronjob"
+	recordReservation = "reservation"
+	recordUsage       = "usage"
+	recordFrameworkId = "framework_id"
+	recordEnd         = "end"
+)
+
+// recordCounts are the keys of the counts of each kind of the records, the same as
+// MigrateKinds.
+var recordCounts = map[string]string{
+	recordApp:         "apps",
+	recordVersion:     "versions",
+	recordTask:        "tasks",
+	recordCompose:     "composes",
+	recordAgent:       "agents",
+	recordQuota:       "quotas",
+	recordJob:         "jobs",
+	recordCronJob:     "cron jobs",
+	recordReservation: "reservations",
+	recordUsage:       "usage",
+	recordFrameworkId: "framework id",
+}
+
+// BackupHeader is the first record of the backup archive.
+type BackupHeader struct {
+	Version int       `json:"version"`
+	Created time.Time `json:"created"`
+}
+
+// backupRecord is a line of the backup archive, the versions and tasks are given the
+// id of their app.
+type backupRecord struct {
+	Kind  string          `json:"kind"`
+	AppID string          `json:"appId,omitempty"`
+	Data  json.RawMessage `json:"data"`
+}
+
+// RestoreOptions are the options of Restore.
+type RestoreOptions struct {
+	// SkipTasks restores the apps without any task, the apps are left adopting the
+	// running tasks reported by the reconciliation of mesos instead.
+	SkipTasks bool
+
+	// Resume restores to the store left by the restore failed half way, the objects
+	// restored already are updated instead of refused, see Restore.
+	Resume bool
+}
+
+var errStoreNotEmpty = errors.New("the store restored to is not empty")
+
+// Backup writes all of the apps with their versions and tasks, the composes, the agents,
+// the quotas, the jobs, the cron jobs, the reservations, the usage and the framework id in
+// the store to the writer, one json record per line led by the BackupHeader. The records
+// are written once read, so the archive is streamed. The last record is the end with the
+// number of the records of each kind, it's not written on error, so the archive truncated
+// is told by the lack of it.
+func Backup(s Store, w io.Writer) error {
+	enc := json.NewEncoder(w)
+	counts := make(map[string]int)
+
+	put := func(kind, appId string, v interface{}) error {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+
+		if err := enc.Encode(&backupRecord{Kind: kind, AppID: appId, Data: data}); err != nil {
+			return err
+		}
+
+		counts[recordCounts[kind]]++
+
+		return nil
+	}
+
+	if err := put(recordHeader, "", &BackupHeader{Version: BackupVersion, Created: time.Now()}); err != nil {
+		return err
+	}
+
+	apps, err := s.ListApps()
+	if err != nil {
+		return fmt.Errorf("list apps got error: %v", err)
+	}
+
+	for _, app := range apps {
+		versions, err := s.ListVersions(app.ID)
+		if err != nil {
+			return fmt.Errorf("list app %s versions got error: %v", app.ID, err)
+		}
+
+		tasks, err := s.ListTasks(app.ID)
+		if err != nil {
+			return fmt.Errorf("list app %s tasks got error: %v", app.ID, err)
+		}
+
+		if err := put(recordApp, "", app); err != nil {
+			return err
+		}
+
+		for _, ver := range versions {
+			if err := put(recordVersion, app.ID, ver); err != nil {
+				return err
+			}
+		}
+
+		for _, task := range tasks {
+			if err := put(recordTask, app.ID, task); err != nil {
+				return err
+			}
+		}
+	}
+
+	cpss, err := s.ListComposes()
+	if err != nil {
+		return fmt.Errorf("list composes got error: %v", err)
+	}
+
+	for _, cps := range cpss {
+		if err := put(recordCompose, "", cps); err != nil {
+			return err
+		}
+	}
+
+	agents, err := s.ListAgents()
+	if err != nil {
+		return fmt.Errorf("list agents got error: %v", err)
+	}
+
+	for _, agent := range agents {
+		if err := put(recordAgent, "", agent); err != nil {
+			return err
+		}
+	}
+
+	quotas, err := s.ListQuotas()
+	if err != nil {
+		return fmt.Errorf("list quotas got error: %v", err)
+	}
+
+	for _, quota := range quotas {
+		if err := put(recordQuota, "", quota); err != nil {
+			return err
+		}
+	}
+
+	jobs, err := s.ListJobs()
+	if err != nil {
+		return fmt.Errorf("list jobs got error: %v", err)
+	}
+
+	for _, job := range jobs {
+		if err := put(recordJob, "", job); err != nil {
+			return err
+		}
+	}
+
+	cronJobs, err := s.ListCronJobs()
+	if err != nil {
+		return fmt.Errorf("list cron jobs got error: %v", err)
+	}
+
+	for _, cronJob := range cronJobs {
+		if err := put(recordCronJob, "", cronJob); err != nil {
+			return err
+		}
+	}
+
+	reservations, err := s.ListReservations()
+	if err != nil {
+		return fmt.Errorf("list reservations got error: %v", err)
+	}
+
+	for _, r := range reservations {
+		if err := put(recordReservation, "", r); err != nil {
+			return err
+		}
+	}
+
+	records, err := s.ListUsage(usageBegin, usageEnd)
+	if err != nil {
+		return fmt.Errorf("list usage got error: %v", err)
+	}
+
+	for _, record := range records {
+		if err := put(recordUsage, "", record); err != nil {
+			return err
+		}
+	}
+
+	if id, _ := s.GetFrameworkId(); id != "" {
+		if err := put(recordFrameworkId, "", id); err != nil {
+			return err
+		}
+	}
+
+	data, err := json.Marshal(counts)
+	if err != nil {
+		return err
+	}
+
+	return enc.Encode(&backupRecord{Kind: recordEnd, Data: data})
+}
+
+// Restore loads the backup archive written by Backup into the store, which should be
+// empty. It returns the number of the objects of each kind restored, keyed the same as
+// MigrateKinds. The archive is read through and checked against its end record before
+// anything restored, so the truncated one is refused as a whole. The operations of the
+// apps in progress when backed up are not resumed.
+//
+// The restore failed half way is left as is, it's resumed by the same archive with the
+// Resume option, which skips the check of the empty store and updates the objects exist
+// already like Migrate does, the usage records are only added the difference.
+func Restore(s Store, r io.Reader, opts *RestoreOptions) (map[string]int, error) {
+	if !opts.Resume {
+		if err := checkEmpty(s); err != nil {
+			return nil, err
+		}
+	}
+
+	recs, _, err := readBackup(r)
+	if err != nil {
+		return nil, err
+	}
+
+	rs := &restoration{
+		s:      s,
+		opts:   opts,
+		counts: make(map[string]int),
+		usage:  make(map[string]*types.UsageRecord),
+	}
+
+	if opts.Resume {
+		olds, err := s.ListUsage(usageBegin, usageEnd)
+		if err != nil {
+			return nil, fmt.Errorf("list usage got error: %v", err)
+		}
+
+		for _, old := range olds {
+			rs.usage[old.Key()] = old
+		}
+	}
+
+	for _, rec := range recs {
+		if err := rs.record(rec); err != nil {
+			return rs.counts, err
+		}
+	}
+
+	return rs.counts, nil
+}
+
+// CheckBackup reads through the backup archive and checks it against its end record,
+// it returns the number of the records of each kind, keyed the same as MigrateKinds.
+func CheckBackup(r io.Reader) (map[string]int, error) {
+	_, counts, err := readBackup(r)
+	return counts, err
+}
+
+// readBackup decodes all of the records of the archive but the header and the end.
+func readBackup(r io.Reader) ([]*backupRecord, map[string]int, error) {
+	dec := json.NewDecoder(r)
+
+	var header BackupHeader
+	if err := decodeRecord(dec, recordHeader, &header); err != nil {
+		return nil, nil, err
+	}
+
+	if header.Version != BackupVersion {
+		return nil, nil, fmt.Errorf("malformed backup: version %d not supported", header.Version)
+	}
+
+	var (
+		recs   []*backupRecord
+		counts = make(map[string]int)
+	)
+
+	for {
+		rec := new(backupRecord)
+		if err := dec.Decode(rec); err != nil {
+			if err == io.EOF {
+				return nil, nil, errors.New("malformed backup: truncated, no end record")
+			}
+			return nil, nil, fmt.Errorf("malformed backup: %v", err)
+		}
+
+		if rec.Kind == recordEnd {
+			var end map[string]int
+			if err := rec.decode(&end); err != nil {
+				return nil, nil, err
+			}
+
+			for _, kind := range MigrateKinds {
+				if counts[kind] != end[kind] {
+					return nil, nil, fmt.Errorf("malformed backup: %d %s, expected %d", counts[kind], kind, end[kind])
+				}
+			}
+
+			if dec.More() {
+				return nil, nil, errors.New("malformed backup: records after the end")
+			}
+
+			return recs, counts, nil
+		}
+
+		kind, ok := recordCounts[rec.Kind]
+		if !ok {
+			return nil, nil, fmt.Errorf("malformed backup: unknown %s record", rec.Kind)
+		}
+
+		counts[kind]++
+		recs = append(recs, rec)
+	}
+}
+
+// IsNotEmpty tells the store restored to is not empty.
+func IsNotEmpty(err error) bool {
+	return err == errStoreNotEmpty
+}
+
+// checkEmpty tells the store has none of the objects but the framework id, which the
+// manager subscribed to mesos has already.
+func checkEmpty(s Store) error {
+	counts, err := Count(s)
+	if err != nil {
+		return err
+	}
+
+	for kind, n := range counts {
+		if kind != "framework id" && n > 0 {
+			return errStoreNotEmpty
+		}
+	}
+
+	return nil
+}
+
+func decodeRecord(dec *json.Decoder, kind string, v interface{}) error {
+	var rec backupRecord
+	if err := dec.Decode(&rec); err != nil {
+		return fmt.Errorf("malformed backup: %v", err)
+	}
+
+	if rec.Kind != kind {
+		return fmt.Errorf("malformed backup: got %s record, expected %s", rec.Kind, kind)
+	}
+
+	return rec.decode(v)
+}
+
+func (rec *backupRecord) decode(v interface{}) error {
+	if err := json.Unmarshal(rec.Data, v); err != nil {
+		return fmt.Errorf("malformed backup: decode %s record got error: %v", rec.Kind, err)
+	}
+
+	return nil
+}
+
+type restoration struct {
+	s      Store
+	opts   *RestoreOptions
+	counts map[string]int
+	usage  map[string]*types.UsageRecord // the usage in the store if resumed
+}
+
+// put creates the object, or updates it if resumed and got already. The object got
+// without update is skipped.
+func (rs *restoration) put(kind string, get func() error, create, update func() error) error {
+	fn := create
+	if rs.opts.Resume && get() == nil {
+		fn = update
+	}
+
+	if fn != nil {
+		if err := fn(); err != nil {
+			return err
+		}
+	}
+
+	rs.counts[kind]++
+
+	return nil
+}
+
+func (rs *restoration) record(rec *backupRecord) error {
+	s, opts := rs.s, rs.opts
+
+	switch rec.Kind {
+	case recordApp:
+		var app types.Application
+		if err := rec.decode(&app); err != nil {
+			return err
+		}
+
+		app.Revision = 0
+		app.OpStatus = types.OpStatusNoop
+		if opts.SkipTasks {
+			app.OpStatus = types.OpStatusAdopting
+		}
+
+		get := func() error { _, err := s.GetApp(app.ID); return err }
+		create := func() error { return s.CreateApp(&app) }
+		update := func() error { return s.UpdateApp(&app) }
+		if err := rs.put("apps", get, create, update); err != nil {
+			return fmt.Errorf("restore app %s got error: %v", app.ID, err)
+		}
+
+	case recordVersion:
+		var ver types.Version
+		if err := rec.decode(&ver); err != nil {
+			return err
+		}
+
+		// the versions are never changed.
+		get := func() error { _, err := s.GetVersion(rec.AppID, ver.ID); return err }
+		create := func() error { return s.CreateVersion(rec.AppID, &ver) }
+		if err := rs.put("versions", get, create, nil); err != nil {
+			return fmt.Errorf("restore app %s version %s got error: %v", rec.AppID, ver.ID, err)
+		}
+
+	case recordTask:
+		if opts.SkipTasks {
+			return nil
+		}
+
+		var task types.Task
+		if err := rec.decode(&task); err != nil {
+			return err
+		}
+
+		task.Revision = 0
+		get := func() error { _, err := s.GetTask(rec.AppID, task.ID); return err }
+		create := func() error { return s.CreateTask(rec.AppID, &task) }
+		update := func() error { return s.UpdateTask(rec.AppID, &task) }
+		if err := rs.put("tasks", get, create, update); err != nil {
+			return fmt.Errorf("restore task %s got error: %v", task.ID, err)
+		}
+
+	case recordCompose:
+		var cps types.Compose
+		if err := rec.decode(&cps); err != nil {
+			return err
+		}
+
+		cps.Revision = 0
+		get := func() error { _, err := s.GetCompose(cps.ID); return err }
+		create := func() error { return s.CreateCompose(&cps) }
+		update := func() error { return s.UpdateCompose(&cps) }
+		if err := rs.put("composes", get, create, update); err != nil {
+			return fmt.Errorf("restore compose %s got error: %v", cps.ID, err)
+		}
+
+	case recordAgent:
+		var agent types.Agent
+		if err := rec.decode(&agent); err != nil {
+			return err
+		}
+
+		get := func() error { _, err := s.GetAgent(agent.ID); return err }
+		create := func() error { return s.CreateAgent(&agent) }
+		update := func() error { return s.UpdateAgent(&agent) }
+		if err := rs.put("agents", get, create, update); err != nil {
+			return fmt.Errorf("restore agent %s got error: %v", agent.ID, err)
+		}
+
+	case recordFrameworkId:
+		var id string
+		if err := rec.decode(&id); err != nil {
+			return err
+		}
+
+		if err := s.UpdateFrameworkId(id); err != nil {
+			return fmt.Errorf("restore framework id got error: %v", err)
+		}
+
+		rs.counts["framework id"]++
+
+	case recordQuota:
+		var quota types.Quota
+		if err := rec.decode(&quota); err != nil {
+			return err
+		}
+
+		get := func() error { _, err := s.GetQuota(quota.RunAs); return err }
+		create := func() error { return s.CreateQuota(&quota) }
+		update := func() error { return s.UpdateQuota(&quota) }
+		if err := rs.put("quotas", get, create, update); err != nil {
+			return fmt.Errorf("restore quota %s got error: %v", quota.RunAs, err)
+		}
+
+	case recordJob:
+		var job types.Job
+		if err := rec.decode(&job); err != nil {
+			return err
+		}
+
+		get := func() error { _, err := s.GetJob(job.ID); return err }
+		create := func() error { return s.CreateJob(&job) }
+		update := func() error { return s.UpdateJob(&job) }
+		if err := rs.put("jobs", get, create, update); err != nil {
+			return fmt.Errorf("restore job %s got error: %v", job.ID, err)
+		}
+
+	case recordCronJob:
+		var cronJob types.CronJob
+		if err := rec.decode(&cronJob); err != nil {
+			return err
+		}
+
+		get := func() error { _, err := s.GetCronJob(cronJob.ID); return err }
+		create := func() error { return s.CreateCronJob(&cronJob) }
+		update := func() error { return s.UpdateCronJob(&cronJob) }
+		if err := rs.put("cron jobs", get, create, update); err != nil {
+			return fmt.Errorf("restore cron job %s got error: %v", cronJob.ID, err)
+		}
+
+	case recordReservation:
+		var r types.Reservation
+		if err := rec.decode(&r); err != nil {
+			return err
+		}
+
+		get := func() error { _, err := s.GetReservation(r.ID); return err }
+		create := func() error { return s.CreateReservation(&r) }
+		update := func() error { return s.UpdateReservation(&r) }
+		if err := rs.put("reservations", get, create, update); err != nil {
+			return fmt.Errorf("restore reservation %s got error: %v", r.ID, err)
+		}
+
+	case recordUsage:
+		var record types.UsageRecord
+		if err := rec.decode(&record); err != nil {
+			return err
+		}
+
+		// the records are added up in the store, the one restored already is given the
+		// difference, or skipped if the same.
+		add := true
+		if old, ok := rs.usage[record.Key()]; ok {
+			record.CPUSeconds -= old.CPUSeconds
+			record.MemMBSeconds -= old.MemMBSeconds
+			record.DiskMBSeconds -= old.DiskMBSeconds
+			record.InstanceSeconds -= old.InstanceSeconds
+
+			add = record.CPUSeconds != 0 || record.MemMBSeconds != 0 || record.DiskMBSeconds != 0 || record.InstanceSeconds != 0
+		}
+
+		if add {
+			if err := s.AddUsage(&record); err != nil {
+				return fmt.Errorf("restore usage of app %s at %s got error: %v", record.AppID, record.Bucket, err)
+			}
+		}
+
+		rs.counts["usage"]++
+
+	default:
+		return fmt.Errorf("malformed backup: unknown %s record", rec.Kind)
+	}
+
+	return nil
+}
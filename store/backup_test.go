@@ -0,0 +1,153 @@
+package store_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Dataman-Cloud/swan/store"
+	"github.com/Dataman-Cloud/swan/types"
+)
+
+func TestBackupRestore(t *testing.T) {
+	from, to := newStore(t), newStore(t)
+	seed(t, from)
+
+	var buf bytes.Buffer
+	if err := store.Backup(from, &buf); err != nil {
+		t.Fatal(err)
+	}
+
+	counts, err := store.Restore(to, bytes.NewReader(buf.Bytes()), &store.RestoreOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, kind := range store.MigrateKinds {
+		if counts[kind] == 0 {
+			t.Errorf("expected some %s restored", kind)
+		}
+	}
+
+	checkCounts(t, from, to)
+
+	if secs := usageSeconds(t, to); secs != 15 {
+		t.Errorf("expected 15 cpu seconds restored, got %v", secs)
+	}
+
+	// restored again to the store not empty.
+	if _, err := store.Restore(to, bytes.NewReader(buf.Bytes()), &store.RestoreOptions{}); !store.IsNotEmpty(err) {
+		t.Errorf("expected the store not empty, got %v", err)
+	}
+}
+
+func TestRestoreTruncated(t *testing.T) {
+	from := newStore(t)
+	seed(t, from)
+
+	var buf bytes.Buffer
+	if err := store.Backup(from, &buf); err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.SplitAfter(strings.TrimSpace(buf.String()), "\n")
+
+	for name, archive := range map[string]string{
+		"no end record":  strings.Join(lines[:len(lines)-1], ""),
+		"half of record": strings.Join(lines[:len(lines)-1], "") + lines[len(lines)-1][:10],
+		"record dropped": strings.Join(append(lines[:3:3], lines[4:]...), ""),
+		"trailing":       strings.Join(lines, "") + "\n" + lines[1],
+	} {
+		if _, err := store.CheckBackup(strings.NewReader(archive)); err == nil {
+			t.Errorf("%s: expected the archive refused", name)
+		}
+
+		to := newStore(t)
+		if _, err := store.Restore(to, strings.NewReader(archive), &store.RestoreOptions{}); err == nil || !strings.Contains(err.Error(), "malformed backup") {
+			t.Errorf("%s: expected malformed backup, got %v", name, err)
+		}
+
+		// nothing restored from the archive refused.
+		if apps, _ := to.ListApps(); len(apps) != 0 {
+			t.Errorf("%s: expected nothing restored, got %d apps", name, len(apps))
+		}
+	}
+
+	if _, err := store.CheckBackup(strings.NewReader(buf.String())); err != nil {
+		t.Errorf("expected the archive checked, got %v", err)
+	}
+}
+
+func TestRestoreSkipTasks(t *testing.T) {
+	from, to := newStore(t), newStore(t)
+	seed(t, from)
+
+	var buf bytes.Buffer
+	if err := store.Backup(from, &buf); err != nil {
+		t.Fatal(err)
+	}
+
+	counts, err := store.Restore(to, &buf, &store.RestoreOptions{SkipTasks: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if counts["tasks"] != 0 {
+		t.Errorf("expected no task restored, got %d", counts["tasks"])
+	}
+
+	app, err := to.GetApp("nginx.default.bbk.dataman")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if app.OpStatus != types.OpStatusAdopting {
+		t.Errorf("expected the app adopting, got %s", app.OpStatus)
+	}
+}
+
+// failingStore fails to create the jobs, the restore fails half way.
+type failingStore struct {
+	store.Store
+}
+
+func (s *failingStore) CreateJob(job *types.Job) error {
+	return errors.New("store lost")
+}
+
+func TestRestoreResume(t *testing.T) {
+	from, to := newStore(t), newStore(t)
+	seed(t, from)
+
+	var buf bytes.Buffer
+	if err := store.Backup(from, &buf); err != nil {
+		t.Fatal(err)
+	}
+
+	counts, err := store.Restore(&failingStore{to}, bytes.NewReader(buf.Bytes()), &store.RestoreOptions{})
+	if err == nil {
+		t.Fatal("expected the restore failed")
+	}
+
+	if counts["apps"] == 0 || counts["jobs"] != 0 {
+		t.Errorf("expected the restore failed half way, got %v", counts)
+	}
+
+	if _, err := store.Restore(to, bytes.NewReader(buf.Bytes()), &store.RestoreOptions{}); !store.IsNotEmpty(err) {
+		t.Errorf("expected the store not empty, got %v", err)
+	}
+
+	// resumed more than once, the usage is never added up twice.
+	for i := 0; i < 2; i++ {
+		if _, err := store.Restore(to, bytes.NewReader(buf.Bytes()), &store.RestoreOptions{Resume: true}); err != nil {
+			t.Fatal(err)
+		}
+
+		checkCounts(t, from, to)
+
+		if secs := usageSeconds(t, to); secs != 15 {
+			t.Errorf("expected 15 cpu seconds restored, got %v", secs)
+		}
+	}
+}
@@ -0,0 +1,509 @@
+// Package cache keeps the apps with their tasks and versions of the store in memory,
+// the reads of them are served from memory once synced and the writes go through
+// to the store. The cache is kept up to date by the watch of the store.
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	log "github.com/Sirupsen/logrus"
+
+	"github.com/Dataman-Cloud/swan/store"
+	"github.com/Dataman-Cloud/swan/store/watch"
+	"github.com/Dataman-Cloud/swan/types"
+)
+
+type entry struct {
+	app      *types.Application
+	tasks    map[string]*types.Task
+	versions map[string]*types.Version
+}
+
+// Cache wraps the store, all of the methods not cached are passed through.
+type Cache struct {
+	store.Store
+
+	sync.RWMutex
+	apps   map[string]*entry
+	synced bool
+}
+
+func New(db store.Store) *Cache {
+	return &Cache{
+		Store: db,
+		apps:  make(map[string]*entry),
+	}
+}
+
+// Run loads all of the apps and keeps them up to date until the stop channel closed.
+func (c *Cache) Run(stop <-chan struct{}) error {
+	// watch before loading, so no change is lost in between.
+	changes, err := c.Store.WatchApps(stop)
+	if err != nil {
+		return err
+	}
+
+	if err := c.loadAll(); err != nil {
+		return err
+	}
+
+	for id := range changes {
+		if id == watch.All || !c.isSynced() {
+			err = c.loadAll()
+		} else {
+			err = c.load(id)
+		}
+
+		// served from the store until reloaded by the next change.
+		if err != nil {
+			log.Errorf("cache load app %q got error: %v", id, err)
+			c.setSynced(false)
+		}
+	}
+
+	c.setSynced(false)
+
+	return nil
+}
+
+func (c *Cache) isSynced() bool {
+	c.RLock()
+	defer c.RUnlock()
+
+	return c.synced
+}
+
+func (c *Cache) setSynced(synced bool) {
+	c.Lock()
+	c.synced = synced
+	c.Unlock()
+}
+
+func (c *Cache) loadAll() error {
+	apps, err := c.Store.ListApps()
+	if err != nil {
+		return err
+	}
+
+	all := make(map[string]*entry)
+	for _, app := range apps {
+		e, err := c.fetch(app)
+		if err != nil {
+			return err
+		}
+		all[app.ID] = e
+	}
+
+	c.Lock()
+	c.apps = all
+	c.synced = true
+	c.Unlock()
+
+	return nil
+}
+
+func (c *Cache) load(id string) error {
+	app, err := c.Store.GetApp(id)
+	if err != nil {
+		if !strings.Contains(err.Error(), "not exist") {
+			return err
+		}
+
+		c.Lock()
+		delete(c.apps, id)
+		c.Unlock()
+
+		return nil
+	}
+
+	e, err := c.fetch(app)
+	if err != nil {
+		return err
+	}
+
+	c.Lock()
+	c.apps[id] = e
+	c.Unlock()
+
+	return nil
+}
+
+func (c *Cache) fetch(app *types.Application) (*entry, error) {
+	tasks, err := c.Store.ListTasks(app.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := c.Store.ListVersions(app.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &entry{
+		app:      app,
+		tasks:    make(map[string]*types.Task),
+		versions: make(map[string]*types.Version),
+	}
+	for _, task := range tasks {
+		e.tasks[task.ID] = task
+	}
+	for _, ver := range versions {
+		e.versions[ver.ID] = ver
+	}
+
+	return e, nil
+}
+
+func (c *Cache) CreateApp(app *types.Application) error {
+	if err := c.Store.CreateApp(app); err != nil {
+		return err
+	}
+
+	// read back for the revision, otherwise loaded by the watch.
+	if err := c.load(app.ID); err != nil {
+		log.Errorf("cache load app %s got error: %v", app.ID, err)
+	}
+
+	return nil
+}
+
+func (c *Cache) UpdateApp(app *types.Application) error {
+	if err := c.Store.UpdateApp(app); err != nil {
+		return err
+	}
+
+	c.Lock()
+	if e, ok := c.apps[app.ID]; ok {
+		e.app = clone(app).(*types.Application)
+	}
+	c.Unlock()
+
+	return nil
+}
+
+func (c *Cache) GetApp(id string) (*types.Application, error) {
+	// never hold the lock while reading the store.
+	c.RLock()
+	if !c.synced {
+		c.RUnlock()
+		return c.Store.GetApp(id)
+	}
+	defer c.RUnlock()
+
+	e, ok := c.apps[id]
+	if !ok {
+		return nil, fmt.Errorf("app %s not exists", id)
+	}
+
+	return e.application(), nil
+}
+
+func (c *Cache) ListApps() ([]*types.Application, error) {
+	c.RLock()
+	if !c.synced {
+		c.RUnlock()
+		return c.Store.ListApps()
+	}
+	defer c.RUnlock()
+
+	apps := make([]*types.Application, 0, len(c.apps))
+	for _, e := range c.apps {
+		apps = append(apps, e.application())
+	}
+
+	sort.Slice(apps, func(i, j int) bool { return apps[i].ID < apps[j].ID })
+
+	return apps, nil
+}
+
+func (c *Cache) DeleteApp(id string) error {
+	if err := c.Store.DeleteApp(id); err != nil {
+		return err
+	}
+
+	c.Lock()
+	delete(c.apps, id)
+	c.Unlock()
+
+	return nil
+}
+
+func (c *Cache) CreateTask(aid string, task *types.Task) error {
+	if err := c.Store.CreateTask(aid, task); err != nil {
+		return err
+	}
+
+	// read back for the revision, otherwise loaded by the watch.
+	created, err := c.Store.GetTask(aid, task.ID)
+	if err != nil {
+		log.Errorf("cache get task %s got error: %v", task.ID, err)
+		return nil
+	}
+
+	c.setTask(aid, created)
+
+	return nil
+}
+
+func (c *Cache) UpdateTask(aid string, task *types.Task) error {
+	if err := c.Store.UpdateTask(aid, task); err != nil {
+		return err
+	}
+
+	c.setTask(aid, task)
+
+	return nil
+}
+
+func (c *Cache) setTask(aid string, task *types.Task) {
+	c.Lock()
+	if e, ok := c.apps[aid]; ok {
+		e.tasks[task.ID] = clone(task).(*types.Task)
+	}
+	c.Unlock()
+}
+
+func (c *Cache) GetTask(aid, tid string) (*types.Task, error) {
+	c.RLock()
+	if !c.synced {
+		c.RUnlock()
+		return c.Store.GetTask(aid, tid)
+	}
+	defer c.RUnlock()
+
+	var task *types.Task
+	if e, ok := c.apps[aid]; ok {
+		task = e.tasks[tid]
+	}
+
+	if task == nil {
+		return nil, fmt.Errorf("task %s not exists", tid)
+	}
+
+	return clone(task).(*types.Task), nil
+}
+
+func (c *Cache) ListTasks(aid string) ([]*types.Task, error) {
+	c.RLock()
+	if !c.synced {
+		c.RUnlock()
+		return c.Store.ListTasks(aid)
+	}
+	defer c.RUnlock()
+
+	tasks := make([]*types.Task, 0)
+	if e, ok := c.apps[aid]; ok {
+		for _, task := range e.sortedTasks() {
+			tasks = append(tasks, clone(task).(*types.Task))
+		}
+	}
+
+	return tasks, nil
+}
+
+// DeleteTask deletes the task by id, eg: `ab3cd5ef6gh7.0.nginx.default.xcm.dataman`.
+func (c *Cache) DeleteTask(id string) error {
+	if err := c.Store.DeleteTask(id); err != nil {
+		return err
+	}
+
+	parts := strings.SplitN(id, ".", 3)
+	if len(parts) != 3 {
+		return nil
+	}
+
+	c.Lock()
+	if e, ok := c.apps[parts[2]]; ok {
+		delete(e.tasks, id)
+	}
+	c.Unlock()
+
+	return nil
+}
+
+func (c *Cache) CreateVersion(aid string, version *types.Version) error {
+	if err := c.Store.CreateVersion(aid, version); err != nil {
+		return err
+	}
+
+	c.Lock()
+	if e, ok := c.apps[aid]; ok {
+		e.versions[version.ID] = clone(version).(*types.Version)
+	}
+	c.Unlock()
+
+	return nil
+}
+
+func (c *Cache) GetVersion(aid, vid string) (*types.Version, error) {
+	c.RLock()
+	if !c.synced {
+		c.RUnlock()
+		return c.Store.GetVersion(aid, vid)
+	}
+	defer c.RUnlock()
+
+	var ver *types.Version
+	if e, ok := c.apps[aid]; ok {
+		ver = e.versions[vid]
+	}
+
+	if ver == nil {
+		return nil, fmt.Errorf("app %s version %s not exists", aid, vid)
+	}
+
+	return clone(ver).(*types.Version), nil
+}
+
+func (c *Cache) ListVersions(aid string) ([]*types.Version, error) {
+	c.RLock()
+	if !c.synced {
+		c.RUnlock()
+		return c.Store.ListVersions(aid)
+	}
+	defer c.RUnlock()
+
+	versions := make([]*types.Version, 0)
+	if e, ok := c.apps[aid]; ok {
+		for _, ver := range e.sortedVersions() {
+			versions = append(versions, clone(ver).(*types.Version))
+		}
+	}
+
+	return versions, nil
+}
+
+// application returns a copy of the app with the fields derived from its tasks
+// and versions, the same as the stores do.
+func (e *entry) application() *types.Application {
+	app := clone(e.app).(*types.Application)
+
+	tasks := e.sortedTasks()
+
+	app.TaskCount = len(tasks)
+	app.Status = status(tasks)
+	app.Version = version(tasks)
+	app.Health = health(tasks)
+	app.VersionCount = len(e.versions)
+
+	if len(app.Version) == 0 && len(e.versions) > 0 {
+		versions := e.sortedVersions()
+		app.Version = append(app.Version, versions[len(versions)-1].ID)
+	}
+
+	return app
+}
+
+func (e *entry) sortedTasks() types.TaskList {
+	tasks := make(types.TaskList, 0, len(e.tasks))
+	for _, task := range e.tasks {
+		tasks = append(tasks, task)
+	}
+
+	sort.Slice(tasks, func(i, j int) bool { return tasks[i].ID < tasks[j].ID })
+
+	return tasks
+}
+
+func (e *entry) sortedVersions() types.VersionList {
+	versions := make(types.VersionList, 0, len(e.versions))
+	for _, ver := range e.versions {
+		versions = append(versions, ver)
+	}
+
+	versions.Sort()
+
+	return versions
+}
+
+func status(tasks types.TaskList) string {
+	for _, task := range tasks {
+		if task.Status == "TASK_RUNNING" {
+			return "available"
+		}
+	}
+
+	return "unavailable"
+}
+
+func health(tasks types.TaskList) *types.Health {
+	var (
+		total     int64
+		healthy   int64
+		unhealthy int64
+		unset     int64
+	)
+
+	for _, task := range tasks {
+		switch task.Healthy {
+		case types.TaskHealthy:
+			healthy++
+		case types.TaskUnHealthy:
+			unhealthy++
+		case types.TaskHealthyUnset:
+			unset++
+		}
+
+		total++
+	}
+
+	return &types.Health{
+		Total:     total,
+		Healthy:   healthy,
+		UnHealthy: unhealthy,
+		UnSet:     unset,
+	}
+}
+
+func version(tasks types.TaskList) []string {
+	vers := make([]string, 0)
+
+	for _, task := range tasks {
+		if verExist(vers, task.Version) {
+			continue
+		}
+
+		vers = append(vers, task.Version)
+	}
+
+	return vers
+}
+
+func verExist(vers []string, ver string) bool {
+	for _, v := range vers {
+		if v == ver {
+			return true
+		}
+	}
+
+	return false
+}
+
+// clone deep copies the object, the callers are free to modify the copy.
+func clone(v interface{}) interface{} {
+	bs, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+
+	switch v.(type) {
+	case *types.Application:
+		var app types.Application
+		json.Unmarshal(bs, &app)
+		return &app
+	case *types.Task:
+		var task types.Task
+		json.Unmarshal(bs, &task)
+		return &task
+	case *types.Version:
+		var ver types.Version
+		json.Unmarshal(bs, &ver)
+		return &ver
+	}
+
+	panic(fmt.Sprintf("cache: unexpected type %T", v))
+}
@@ -1,6 +1,7 @@
 package etcd
 
 import (
+	"fmt"
 	"path"
 
 	log "github.com/Sirupsen/logrus"
@@ -38,7 +39,14 @@ func (s *EtcdStore) CreateApp(app *types.Application) error {
 		return err
 	}
 
-	return s.create(pval, bs)
+	rev, err := s.createRev(pval, bs)
+	if err != nil {
+		return err
+	}
+
+	app.Revision = rev
+
+	return nil
 }
 
 func (s *EtcdStore) UpdateApp(app *types.Application) error {
@@ -52,7 +60,17 @@ func (s *EtcdStore) UpdateApp(app *types.Application) error {
 		return err
 	}
 
-	return s.update(pval, bs)
+	rev, err := s.cas(pval, app.Revision, bs)
+	if err != nil {
+		if isEtcdTestFailed(err) {
+			return fmt.Errorf("app %s revision %d conflict", app.ID, app.Revision)
+		}
+		return err
+	}
+
+	app.Revision = rev
+
+	return nil
 }
 
 func (s *EtcdStore) GetApp(id string) (*types.Application, error) {
@@ -61,7 +79,7 @@ func (s *EtcdStore) GetApp(id string) (*types.Application, error) {
 		pval = path.Join(p, "value")
 	)
 
-	data, err := s.get(pval)
+	data, rev, err := s.getRev(pval)
 	if err != nil {
 		log.Errorf("find app %s got error: %v", id, err)
 		return nil, err
@@ -72,6 +90,8 @@ func (s *EtcdStore) GetApp(id string) (*types.Application, error) {
 		return nil, err
 	}
 
+	app.Revision = rev
+
 	tasks, err := s.tasks(p, id)
 	if err != nil {
 		log.Errorf("get app %s tasks got error: %v", id, err)
@@ -91,7 +111,7 @@ func (s *EtcdStore) GetApp(id string) (*types.Application, error) {
 
 	app.VersionCount = len(versions)
 
-	if len(app.Version) == 0 {
+	if len(app.Version) == 0 && len(versions) > 0 {
 		types.VersionList(versions).Reverse()
 		app.Version = append(app.Version, versions[0].ID)
 	}
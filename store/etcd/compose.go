@@ -2,6 +2,7 @@ package etcd
 
 import (
 	"errors"
+	"fmt"
 
 	log "github.com/Sirupsen/logrus"
 
@@ -15,7 +16,14 @@ func (s *EtcdStore) CreateCompose(cps *types.Compose) error {
 	}
 
 	path := keyCompose + "/" + cps.ID
-	return s.create(path, bs)
+	rev, err := s.createRev(path, bs)
+	if err != nil {
+		return err
+	}
+
+	cps.Revision = rev
+
+	return nil
 }
 
 func (s *EtcdStore) UpdateCompose(cps *types.Compose) error {
@@ -28,19 +36,29 @@ func (s *EtcdStore) UpdateCompose(cps *types.Compose) error {
 		return err
 	}
 
-	path := keyCompose + "/" + cps.ID
-	return s.update(path, bs)
+	rev, err := s.cas(keyCompose+"/"+cps.ID, cps.Revision, bs)
+	if err != nil {
+		if isEtcdTestFailed(err) {
+			return fmt.Errorf("compose %s revision %d conflict", cps.ID, cps.Revision)
+		}
+		return err
+	}
+
+	cps.Revision = rev
+
+	return nil
 }
 
 func (s *EtcdStore) GetCompose(idOrName string) (*types.Compose, error) {
 	// by id
-	bs, err := s.get(keyCompose + "/" + idOrName)
+	bs, rev, err := s.getRev(keyCompose + "/" + idOrName)
 	if err == nil {
 		cps := new(types.Compose)
 		if err := decode(bs, &cps); err != nil {
 			log.Errorln("etcd GetCompose.decode error:", err)
 			return nil, err
 		}
+		cps.Revision = rev
 		return cps, nil
 	}
 
@@ -68,7 +86,7 @@ func (s *EtcdStore) ListComposes() ([]*types.Compose, error) {
 	}
 
 	for node := range nodes {
-		bs, err := s.get(keyCompose + "/" + node)
+		bs, rev, err := s.getRev(keyCompose + "/" + node)
 		if err != nil {
 			log.Errorln("etcd ListCompose.getnode error:", err)
 			continue
@@ -80,6 +98,8 @@ func (s *EtcdStore) ListComposes() ([]*types.Compose, error) {
 			continue
 		}
 
+		cps.Revision = rev
+
 		ret = append(ret, cps)
 	}
 
@@ -0,0 +1,85 @@
+package etcd
+
+import (
+	"fmt"
+
+	log "github.com/Sirupsen/logrus"
+
+	"github.com/Dataman-Cloud/swan/types"
+)
+
+func (s *EtcdStore) CreateCronJob(cronJob *types.CronJob) error {
+	bs, err := encode(cronJob)
+	if err != nil {
+		return err
+	}
+
+	if err := s.create(keyCronJob+"/"+cronJob.ID, bs); err != nil {
+		if isEtcdNodeExist(err) {
+			return errCronJobAlreadyExists
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *EtcdStore) UpdateCronJob(cronJob *types.CronJob) error {
+	bs, err := encode(cronJob)
+	if err != nil {
+		return err
+	}
+
+	if err := s.update(keyCronJob+"/"+cronJob.ID, bs); err != nil {
+		if isEtcdKeyNotFound(err) {
+			return fmt.Errorf("cron job %s not exists", cronJob.ID)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *EtcdStore) GetCronJob(id string) (*types.CronJob, error) {
+	bs, err := s.get(keyCronJob + "/" + id)
+	if err != nil {
+		if isEtcdKeyNotFound(err) {
+			return nil, fmt.Errorf("cron job %s not exists", id)
+		}
+		return nil, err
+	}
+
+	j := new(types.CronJob)
+	if err := decode(bs, &j); err != nil {
+		log.Errorln("etcd GetCronJob.decode error:", err)
+		return nil, err
+	}
+
+	return j, nil
+}
+
+func (s *EtcdStore) ListCronJobs() ([]*types.CronJob, error) {
+	ret := make([]*types.CronJob, 0, 0)
+
+	nodes, err := s.list(keyCronJob)
+	if err != nil {
+		log.Errorln("etcd ListCronJobs error:", err)
+		return ret, err
+	}
+
+	for id, node := range nodes {
+		j := new(types.CronJob)
+		if err := decode(node, &j); err != nil {
+			log.Errorln("etcd ListCronJobs.decode error:", id, err)
+			continue
+		}
+
+		ret = append(ret, j)
+	}
+
+	return ret, nil
+}
+
+func (s *EtcdStore) DeleteCronJob(id string) error {
+	return s.del(keyCronJob+"/"+id, false)
+}
@@ -0,0 +1,142 @@
+package etcd
+
+import (
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	etcd "github.com/coreos/etcd/client"
+	"golang.org/x/net/context"
+)
+
+const (
+	// the leader key expires in the ttl unless refreshed by the leader.
+	leaderTTL = 10 * time.Second
+
+	// how long to wait before campaigning again on errors.
+	electRetryInterval = time.Second
+)
+
+// Elector elects the leader manager by the leader key with a ttl, the manager who
+// created it is the leader, and keeps refreshing it while leading. The others
+// watch the key and campaign again once it's gone.
+type Elector struct {
+	s    *EtcdStore
+	key  string
+	addr string
+	ttl  time.Duration
+}
+
+// NewElector creates the elector of the manager listening on the addr.
+func (s *EtcdStore) NewElector(addr string) *Elector {
+	return &Elector{
+		s:    s,
+		key:  s.clean(keyLeaderElection),
+		addr: addr,
+		ttl:  leaderTTL,
+	}
+}
+
+// Elect campaigns for the leader manager, and calls fn each time the leader changes.
+// The errors of etcd are retried, so it never returns.
+func (e *Elector) Elect(fn func(leader string, self bool)) error {
+	for {
+		opts := &etcd.SetOptions{
+			PrevExist: etcd.PrevNoExist,
+			TTL:       e.ttl,
+		}
+
+		_, err := e.s.kapi.Set(context.Background(), e.key, e.addr, opts)
+		if err == nil {
+			fn(e.addr, true)
+			e.lead()
+			fn("", false)
+			continue
+		}
+
+		if !isEtcdNodeExist(err) {
+			log.Warnf("campaign for the leader got error: %v", err)
+			time.Sleep(electRetryInterval)
+			continue
+		}
+
+		resp, err := e.s.kapi.Get(context.Background(), e.key, &etcd.GetOptions{Quorum: true})
+		if err != nil {
+			if !isEtcdKeyNotFound(err) {
+				log.Warnf("get the leader got error: %v", err)
+				time.Sleep(electRetryInterval)
+			}
+			continue
+		}
+
+		// still leading before restarted.
+		if resp.Node.Value == e.addr {
+			fn(e.addr, true)
+			e.lead()
+			fn("", false)
+			continue
+		}
+
+		fn(resp.Node.Value, false)
+		e.follow(resp.Node.Value, resp.Index, fn)
+	}
+}
+
+// lead refreshes the leader key until it's lost or failed to be refreshed in the ttl.
+func (e *Elector) lead() {
+	ticker := time.NewTicker(e.ttl / 3)
+	defer ticker.Stop()
+
+	opts := &etcd.SetOptions{
+		PrevExist: etcd.PrevExist,
+		PrevValue: e.addr,
+		TTL:       e.ttl,
+		Refresh:   true,
+	}
+
+	refreshed := time.Now()
+
+	for range ticker.C {
+		_, err := e.s.kapi.Set(context.Background(), e.key, "", opts)
+		if err == nil {
+			refreshed = time.Now()
+			continue
+		}
+
+		if isEtcdKeyNotFound(err) || isEtcdTestFailed(err) {
+			log.Errorf("lost the leader key: %v", err)
+			return
+		}
+
+		log.Warnf("refresh the leader key got error: %v", err)
+
+		if time.Since(refreshed) >= e.ttl {
+			log.Errorf("the leader key not refreshed in %s, stepping down", e.ttl)
+			return
+		}
+	}
+}
+
+// follow watches the leader key after the index until it's gone.
+func (e *Elector) follow(leader string, index uint64, fn func(leader string, self bool)) {
+	w := e.s.kapi.Watcher(e.key, &etcd.WatcherOptions{AfterIndex: index})
+
+	for {
+		resp, err := w.Next(context.Background())
+		if err != nil {
+			log.Warnf("watch the leader got error: %v", err)
+			time.Sleep(electRetryInterval)
+			return
+		}
+
+		switch resp.Action {
+		case "delete", "compareAndDelete", "expire":
+			log.Info("Lost leading manager. Start electing new leader...")
+			return
+		}
+
+		if resp.Node != nil && resp.Node.Value != leader {
+			leader = resp.Node.Value
+			fn(leader, false)
+		}
+	}
+}
@@ -15,23 +15,32 @@ import (
 )
 
 const (
-	keyApp         = "/apps"      // single app
-	keyCompose     = "/composes"  // compose instance (group apps)
-	keyAgent       = "/agents"    // swan agent
-	keyFrameworkID = "/framework" // framework id
+	keyApp         = "/apps"         // single app
+	keyCompose     = "/composes"     // compose instance (group apps)
+	keyAgent       = "/agents"       // swan agent
+	keyQuota       = "/quotas"       // resource quota of runAs
+	keyUsage       = "/usage"        // resource usage rollups
+	keyJob         = "/jobs"         // run-to-completion jobs
+	keyCronJob     = "/cronjobs"     // cron jobs
+	keyReservation = "/reservations" // dynamic reservations of stateful tasks
+	keyFrameworkID = "/framework"    // framework id
 
 	keyTasks    = "tasks"    // sub key of keyApp
 	keyVersions = "versions" // sub key of keyApp
 
-	keyLeaderElection = "/leader-election"
+	keyLeaderElection = "/leader-election" // leader manager address with a ttl
 )
 
 var (
-	errAppNotFound          = errors.New("app not found")
-	errAppAlreadyExists     = errors.New("app already exists")
-	errVersionAlreadyExists = errors.New("version already exists")
-	errInstanceNotFound     = errors.New("instance not found")
-	errAgentNotFound        = errors.New("agent not found")
+	errAppNotFound              = errors.New("app not found")
+	errAppAlreadyExists         = errors.New("app already exists")
+	errVersionAlreadyExists     = errors.New("version already exists")
+	errInstanceNotFound         = errors.New("instance not found")
+	errAgentNotFound            = errors.New("agent not found")
+	errQuotaAlreadyExists       = errors.New("quota already exists")
+	errJobAlreadyExists         = errors.New("job already exists")
+	errCronJobAlreadyExists     = errors.New("cron job already exists")
+	errReservationAlreadyExists = errors.New("reservation already exists")
 
 	errInvalidGet  = errors.New("Get() on directory node make no sense")
 	errInvalidList = errors.New("can't List() on key Node")
@@ -62,7 +71,7 @@ func NewEtcdStore(addrs []string) (*EtcdStore, error) {
 	}
 
 	// create base keys nodes
-	for _, node := range []string{keyApp, keyCompose, keyAgent} {
+	for _, node := range []string{keyApp, keyCompose, keyAgent, keyQuota, keyUsage, keyJob, keyCronJob, keyReservation} {
 		store.ensureDir(node)
 	}
 
@@ -77,6 +86,12 @@ func (s *EtcdStore) clean(p string) string {
 }
 
 func (s *EtcdStore) get(key string) ([]byte, error) {
+	value, _, err := s.getRev(key)
+	return value, err
+}
+
+// getRev returns the value with its revision, the modified index of the key.
+func (s *EtcdStore) getRev(key string) ([]byte, int64, error) {
 	key = s.clean(key)
 	opts := &etcd.GetOptions{
 		Recursive: false,
@@ -84,12 +99,12 @@ func (s *EtcdStore) get(key string) ([]byte, error) {
 	}
 	res, err := s.kapi.Get(context.Background(), key, opts)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 	if res.Node.Dir {
-		return nil, errInvalidGet
+		return nil, 0, errInvalidGet
 	}
-	return []byte(res.Node.Value), nil
+	return []byte(res.Node.Value), int64(res.Node.ModifiedIndex), nil
 }
 
 func (s *EtcdStore) list(key string) (map[string][]byte, error) {
@@ -113,6 +128,28 @@ func (s *EtcdStore) list(key string) (map[string][]byte, error) {
 	return res, nil
 }
 
+// children returns the names of the direct children of the dir, the children of
+// the sub dirs are not read.
+func (s *EtcdStore) children(key string) ([]string, error) {
+	key = s.clean(key)
+	opts := &etcd.GetOptions{
+		Quorum: true,
+		Sort:   true,
+	}
+	resp, err := s.kapi.Get(context.Background(), key, opts)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Node.Dir {
+		return nil, errInvalidList
+	}
+	names := make([]string, 0, len(resp.Node.Nodes))
+	for _, n := range resp.Node.Nodes {
+		names = append(names, filepath.Base(n.Key))
+	}
+	return names, nil
+}
+
 func (s *EtcdStore) ensureDir(key string) error {
 	key = s.clean(key)
 	if ok, _ := s.exists(key); ok {
@@ -152,6 +189,34 @@ func (s *EtcdStore) set(key string, value []byte, mode etcd.PrevExistType) error
 	return err
 }
 
+// createRev creates the key and returns its revision.
+func (s *EtcdStore) createRev(key string, value []byte) (int64, error) {
+	key = s.clean(key)
+	opts := &etcd.SetOptions{
+		PrevExist: etcd.PrevNoExist,
+	}
+	res, err := s.kapi.Set(context.Background(), key, string(value), opts)
+	if err != nil {
+		return 0, err
+	}
+	return int64(res.Node.ModifiedIndex), nil
+}
+
+// cas updates the key only if its revision is rev, unless rev is 0, and returns the
+// new revision.
+func (s *EtcdStore) cas(key string, rev int64, value []byte) (int64, error) {
+	key = s.clean(key)
+	opts := &etcd.SetOptions{
+		PrevExist: etcd.PrevExist,
+		PrevIndex: uint64(rev),
+	}
+	res, err := s.kapi.Set(context.Background(), key, string(value), opts)
+	if err != nil {
+		return 0, err
+	}
+	return int64(res.Node.ModifiedIndex), nil
+}
+
 func (s *EtcdStore) del(key string, recursive bool) error {
 	key = s.clean(key)
 	opts := &etcd.DeleteOptions{
@@ -190,6 +255,20 @@ func isEtcdKeyNotFound(err error) bool {
 	return false
 }
 
+func isEtcdNodeExist(err error) bool {
+	if cErr, ok := err.(etcd.Error); ok {
+		return cErr.Code == etcd.ErrorCodeNodeExist
+	}
+	return false
+}
+
+func isEtcdTestFailed(err error) bool {
+	if cErr, ok := err.(etcd.Error); ok {
+		return cErr.Code == etcd.ErrorCodeTestFailed
+	}
+	return false
+}
+
 type EtcdClusterInfo struct {
 	Health  bool            `json:"health"`
 	Members []MemberWrapper `json:"members"`
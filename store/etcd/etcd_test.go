@@ -0,0 +1,50 @@
+package etcd_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	etcd "github.com/coreos/etcd/client"
+	"golang.org/x/net/context"
+
+	"github.com/Dataman-Cloud/swan/store"
+	swanetcd "github.com/Dataman-Cloud/swan/store/etcd"
+	"github.com/Dataman-Cloud/swan/store/storetest"
+)
+
+// TestEtcdStore runs the conformance suite against the etcd given by the
+// SWAN_TEST_ETCD_ADDRS, eg: http://127.0.0.1:2379. The store is always kept under
+// `/swan`, which is wiped before each test, so never point it to a live cluster.
+func TestEtcdStore(t *testing.T) {
+	addrs := os.Getenv("SWAN_TEST_ETCD_ADDRS")
+	if addrs == "" {
+		t.Skip("SWAN_TEST_ETCD_ADDRS not set")
+	}
+
+	c, err := etcd.New(etcd.Config{
+		Endpoints:               strings.Split(addrs, ","),
+		Transport:               etcd.DefaultTransport,
+		HeaderTimeoutPerRequest: 3 * time.Second,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	kapi := etcd.NewKeysAPI(c)
+
+	storetest.Run(t, func(t *testing.T) store.Store {
+		_, err := kapi.Delete(context.Background(), "/swan", &etcd.DeleteOptions{Recursive: true, Dir: true})
+		if err != nil && !etcd.IsKeyNotFound(err) {
+			t.Fatalf("wipe /swan got error: %v", err)
+		}
+
+		s, err := swanetcd.NewEtcdStore(strings.Split(addrs, ","))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return s
+	})
+}
@@ -0,0 +1,85 @@
+package etcd
+
+import (
+	"fmt"
+
+	log "github.com/Sirupsen/logrus"
+
+	"github.com/Dataman-Cloud/swan/types"
+)
+
+func (s *EtcdStore) CreateJob(job *types.Job) error {
+	bs, err := encode(job)
+	if err != nil {
+		return err
+	}
+
+	if err := s.create(keyJob+"/"+job.ID, bs); err != nil {
+		if isEtcdNodeExist(err) {
+			return errJobAlreadyExists
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *EtcdStore) UpdateJob(job *types.Job) error {
+	bs, err := encode(job)
+	if err != nil {
+		return err
+	}
+
+	if err := s.update(keyJob+"/"+job.ID, bs); err != nil {
+		if isEtcdKeyNotFound(err) {
+			return fmt.Errorf("job %s not exists", job.ID)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *EtcdStore) GetJob(id string) (*types.Job, error) {
+	bs, err := s.get(keyJob + "/" + id)
+	if err != nil {
+		if isEtcdKeyNotFound(err) {
+			return nil, fmt.Errorf("job %s not exists", id)
+		}
+		return nil, err
+	}
+
+	j := new(types.Job)
+	if err := decode(bs, &j); err != nil {
+		log.Errorln("etcd GetJob.decode error:", err)
+		return nil, err
+	}
+
+	return j, nil
+}
+
+func (s *EtcdStore) ListJobs() ([]*types.Job, error) {
+	ret := make([]*types.Job, 0, 0)
+
+	nodes, err := s.list(keyJob)
+	if err != nil {
+		log.Errorln("etcd ListJobs error:", err)
+		return ret, err
+	}
+
+	for id, node := range nodes {
+		j := new(types.Job)
+		if err := decode(node, &j); err != nil {
+			log.Errorln("etcd ListJobs.decode error:", id, err)
+			continue
+		}
+
+		ret = append(ret, j)
+	}
+
+	return ret, nil
+}
+
+func (s *EtcdStore) DeleteJob(id string) error {
+	return s.del(keyJob+"/"+id, false)
+}
@@ -0,0 +1,92 @@
+package etcd
+
+import (
+	"fmt"
+
+	log "github.com/Sirupsen/logrus"
+
+	"github.com/Dataman-Cloud/swan/types"
+)
+
+func (s *EtcdStore) CreateQuota(quota *types.Quota) error {
+	bs, err := encode(quota)
+	if err != nil {
+		return err
+	}
+
+	if err := s.create(keyQuota+"/"+quota.RunAs, bs); err != nil {
+		if isEtcdNodeExist(err) {
+			return errQuotaAlreadyExists
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *EtcdStore) UpdateQuota(quota *types.Quota) error {
+	bs, err := encode(quota)
+	if err != nil {
+		return err
+	}
+
+	if err := s.update(keyQuota+"/"+quota.RunAs, bs); err != nil {
+		if isEtcdKeyNotFound(err) {
+			return fmt.Errorf("quota %s not exists", quota.RunAs)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *EtcdStore) GetQuota(runAs string) (*types.Quota, error) {
+	bs, err := s.get(keyQuota + "/" + runAs)
+	if err != nil {
+		if isEtcdKeyNotFound(err) {
+			return nil, fmt.Errorf("quota %s not exists", runAs)
+		}
+		return nil, err
+	}
+
+	q := new(types.Quota)
+	if err := decode(bs, &q); err != nil {
+		log.Errorln("etcd GetQuota.decode error:", err)
+		return nil, err
+	}
+
+	return q, nil
+}
+
+func (s *EtcdStore) ListQuotas() ([]*types.Quota, error) {
+	ret := make([]*types.Quota, 0, 0)
+
+	nodes, err := s.list(keyQuota)
+	if err != nil {
+		log.Errorln("etcd ListQuotas error:", err)
+		return ret, err
+	}
+
+	for runAs, node := range nodes {
+		q := new(types.Quota)
+		if err := decode(node, &q); err != nil {
+			log.Errorln("etcd ListQuotas.decode error:", runAs, err)
+			continue
+		}
+
+		ret = append(ret, q)
+	}
+
+	return ret, nil
+}
+
+func (s *EtcdStore) DeleteQuota(runAs string) error {
+	if err := s.del(keyQuota+"/"+runAs, false); err != nil {
+		if isEtcdKeyNotFound(err) {
+			return fmt.Errorf("quota %s not exists", runAs)
+		}
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package etcd
+
+import (
+	"fmt"
+
+	log "github.com/Sirupsen/logrus"
+
+	"github.com/Dataman-Cloud/swan/types"
+)
+
+func (s *EtcdStore) CreateReservation(reservation *types.Reservation) error {
+	bs, err := encode(reservation)
+	if err != nil {
+		return err
+	}
+
+	if err := s.create(keyReservation+"/"+reservation.ID, bs); err != nil {
+		if isEtcdNodeExist(err) {
+			return errReservationAlreadyExists
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *EtcdStore) UpdateReservation(reservation *types.Reservation) error {
+	bs, err := encode(reservation)
+	if err != nil {
+		return err
+	}
+
+	if err := s.update(keyReservation+"/"+reservation.ID, bs); err != nil {
+		if isEtcdKeyNotFound(err) {
+			return fmt.Errorf("reservation %s not exists", reservation.ID)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *EtcdStore) GetReservation(id string) (*types.Reservation, error) {
+	bs, err := s.get(keyReservation + "/" + id)
+	if err != nil {
+		if isEtcdKeyNotFound(err) {
+			return nil, fmt.Errorf("reservation %s not exists", id)
+		}
+		return nil, err
+	}
+
+	j := new(types.Reservation)
+	if err := decode(bs, &j); err != nil {
+		log.Errorln("etcd GetReservation.decode error:", err)
+		return nil, err
+	}
+
+	return j, nil
+}
+
+func (s *EtcdStore) ListReservations() ([]*types.Reservation, error) {
+	ret := make([]*types.Reservation, 0, 0)
+
+	nodes, err := s.list(keyReservation)
+	if err != nil {
+		log.Errorln("etcd ListReservations error:", err)
+		return ret, err
+	}
+
+	for id, node := range nodes {
+		j := new(types.Reservation)
+		if err := decode(node, &j); err != nil {
+			log.Errorln("etcd ListReservations.decode error:", id, err)
+			continue
+		}
+
+		ret = append(ret, j)
+	}
+
+	return ret, nil
+}
+
+func (s *EtcdStore) DeleteReservation(id string) error {
+	return s.del(keyReservation+"/"+id, false)
+}
@@ -1,6 +1,7 @@
 package etcd
 
 import (
+	"fmt"
 	"path"
 	"strings"
 
@@ -17,7 +18,14 @@ func (s *EtcdStore) CreateTask(aid string, task *types.Task) error {
 
 	p := path.Join(keyApp, aid, keyTasks, task.ID)
 
-	return s.create(p, bs)
+	rev, err := s.createRev(p, bs)
+	if err != nil {
+		return err
+	}
+
+	task.Revision = rev
+
+	return nil
 }
 
 func (s *EtcdStore) UpdateTask(aid string, task *types.Task) error {
@@ -26,12 +34,16 @@ func (s *EtcdStore) UpdateTask(aid string, task *types.Task) error {
 		return err
 	}
 
-	p := path.Join(keyApp, aid, keyTasks, task.ID)
+	rev, err := s.cas(path.Join(keyApp, aid, keyTasks, task.ID), task.Revision, bs)
+	if err != nil {
+		if isEtcdTestFailed(err) {
+			return fmt.Errorf("task %s revision %d conflict", task.ID, task.Revision)
+		}
+		return err
+	}
 
-	return s.update(p, bs)
-}
+	task.Revision = rev
 
-func (s *EtcdStore) ListTaskHistory(aid, sid string) []*types.Task {
 	return nil
 }
 
@@ -47,7 +59,7 @@ func (s *EtcdStore) ListTasks(id string) ([]*types.Task, error) {
 	tasks := make([]*types.Task, 0)
 	for child := range children {
 		p := path.Join(keyApp, id, keyTasks, child)
-		data, err := s.get(p)
+		data, rev, err := s.getRev(p)
 		if err != nil {
 			log.Errorf("get %s got error: %v", p, err)
 			return nil, err
@@ -59,6 +71,8 @@ func (s *EtcdStore) ListTasks(id string) ([]*types.Task, error) {
 			return nil, err
 		}
 
+		t.Revision = rev
+
 		tasks = append(tasks, t)
 	}
 
@@ -77,7 +91,7 @@ func (s *EtcdStore) DeleteTask(id string) error {
 func (s *EtcdStore) GetTask(aid, tid string) (*types.Task, error) {
 	p := path.Join(keyApp, aid, keyTasks, tid)
 
-	data, err := s.get(p)
+	data, rev, err := s.getRev(p)
 	if err != nil {
 		return nil, err
 	}
@@ -87,6 +101,8 @@ func (s *EtcdStore) GetTask(aid, tid string) (*types.Task, error) {
 		return nil, err
 	}
 
+	task.Revision = rev
+
 	return &task, nil
 
 }
@@ -0,0 +1,120 @@
+package etcd
+
+import (
+	"fmt"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+
+	"github.com/Dataman-Cloud/swan/types"
+)
+
+// maxUsageRetries is how many times the usage added is retried if conflicted.
+const maxUsageRetries = 10
+
+// AddUsage adds the record to the one of its bucket and app, the read-modify-write
+// is retried if the record is changed by others since read.
+func (s *EtcdStore) AddUsage(record *types.UsageRecord) error {
+	key := keyUsage + "/" + record.BucketKey() + "/" + record.AppID
+
+	for i := 0; ; i++ {
+		err := s.addUsage(key, record)
+		if err == nil {
+			return nil
+		}
+
+		if !isEtcdTestFailed(err) && !isEtcdNodeExist(err) && !isEtcdKeyNotFound(err) {
+			return err
+		}
+
+		if i >= maxUsageRetries {
+			return fmt.Errorf("usage %s revision conflict: %v", key, err)
+		}
+	}
+}
+
+func (s *EtcdStore) addUsage(key string, record *types.UsageRecord) error {
+	bs, rev, err := s.getRev(key)
+	if err != nil {
+		if !isEtcdKeyNotFound(err) {
+			return err
+		}
+
+		if bs, err = encode(record); err != nil {
+			return err
+		}
+
+		_, err = s.createRev(key, bs)
+		return err
+	}
+
+	prev := new(types.UsageRecord)
+	if err := decode(bs, &prev); err != nil {
+		log.Errorln("etcd AddUsage.decode error:", err)
+		return err
+	}
+
+	prev.Add(record)
+
+	if bs, err = encode(prev); err != nil {
+		return err
+	}
+
+	_, err = s.cas(key, rev, bs)
+	return err
+}
+
+func (s *EtcdStore) ListUsage(from, to time.Time) ([]*types.UsageRecord, error) {
+	ret := make([]*types.UsageRecord, 0, 0)
+
+	keys, err := s.children(keyUsage)
+	if err != nil {
+		log.Errorln("etcd ListUsage error:", err)
+		return ret, err
+	}
+
+	for _, key := range keys {
+		bucket, err := types.ParseUsageBucket(key)
+		if err != nil || bucket.Before(from) || !bucket.Before(to) {
+			continue
+		}
+
+		nodes, err := s.list(keyUsage + "/" + key)
+		if err != nil {
+			log.Errorln("etcd ListUsage.listbucket error:", err)
+			return ret, err
+		}
+
+		for node, bs := range nodes {
+			r := new(types.UsageRecord)
+			if err := decode(bs, &r); err != nil {
+				log.Errorln("etcd ListUsage.decode error:", node, err)
+				continue
+			}
+
+			ret = append(ret, r)
+		}
+	}
+
+	return ret, nil
+}
+
+func (s *EtcdStore) PurgeUsage(before time.Time) error {
+	keys, err := s.children(keyUsage)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		bucket, err := types.ParseUsageBucket(key)
+		if err != nil || !bucket.Before(before) {
+			continue
+		}
+
+		if err := s.delDir(keyUsage+"/"+key, true); err != nil && !isEtcdKeyNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package etcd
+
+import (
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	etcd "github.com/coreos/etcd/client"
+	"golang.org/x/net/context"
+
+	"github.com/Dataman-Cloud/swan/store/watch"
+)
+
+// WatchApps watches the keys under the apps recursively.
+func (s *EtcdStore) WatchApps(stop <-chan struct{}) (<-chan string, error) {
+	index, err := s.appsIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		w           = watch.New(stop)
+		root        = s.clean(keyApp)
+		ctx, cancel = context.WithCancel(context.Background())
+	)
+
+	go func() {
+		<-stop
+		cancel()
+	}()
+
+	go func() {
+		watcher := s.kapi.Watcher(root, &etcd.WatcherOptions{AfterIndex: index, Recursive: true})
+
+		for {
+			resp, err := watcher.Next(ctx)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+
+				log.Warnf("watch etcd apps got error: %v, resuming", err)
+
+				time.Sleep(time.Second)
+
+				// the events may be lost, eg: the index cleared.
+				if index, err = s.appsIndex(); err != nil {
+					continue
+				}
+
+				w.Changed(watch.All)
+				watcher = s.kapi.Watcher(root, &etcd.WatcherOptions{AfterIndex: index, Recursive: true})
+				continue
+			}
+
+			if id, ok := watch.AppID(root, resp.Node.Key); ok {
+				w.Changed(id)
+			}
+		}
+	}()
+
+	return w.C(), nil
+}
+
+// appsIndex returns the etcd index of the apps to watch after.
+func (s *EtcdStore) appsIndex() (uint64, error) {
+	resp, err := s.kapi.Get(context.Background(), s.clean(keyApp), &etcd.GetOptions{Quorum: true})
+	if err != nil {
+		return 0, err
+	}
+
+	return resp.Index, nil
+}
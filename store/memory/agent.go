@@ -0,0 +1,81 @@
+package memory
+
+import (
+	"fmt"
+
+	log "github.com/Sirupsen/logrus"
+
+	"github.com/Dataman-Cloud/swan/types"
+)
+
+func (s *MemoryStore) CreateAgent(agent *types.Agent) error {
+	bs, err := encode(agent)
+	if err != nil {
+		return err
+	}
+
+	if err := s.create(keyAgent+"/"+agent.ID, bs); err != nil {
+		if err == errAlreadyExists {
+			return fmt.Errorf("agent %s already exists", agent.ID)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *MemoryStore) UpdateAgent(agent *types.Agent) error {
+	bs, err := encode(agent)
+	if err != nil {
+		return err
+	}
+
+	if err := s.update(keyAgent+"/"+agent.ID, bs); err != nil {
+		if err == errNotExists {
+			return errAgentNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *MemoryStore) GetAgent(id string) (*types.Agent, error) {
+	bs, err := s.get(keyAgent + "/" + id)
+	if err != nil {
+		if err == errNotExists {
+			return nil, fmt.Errorf("agent %s not exists", id)
+		}
+		return nil, err
+	}
+
+	a := new(types.Agent)
+	if err := decode(bs, &a); err != nil {
+		log.Errorln("memory GetAgent.decode error:", err)
+		return nil, err
+	}
+
+	return a, nil
+}
+
+func (s *MemoryStore) ListAgents() ([]*types.Agent, error) {
+	ret := make([]*types.Agent, 0)
+
+	for _, node := range s.list(keyAgent) {
+		bs, err := s.get(keyAgent + "/" + node)
+		if err != nil {
+			log.Errorln("memory ListAgents.getnode error:", err)
+			continue
+		}
+
+		a := new(types.Agent)
+		if err := decode(bs, &a); err != nil {
+			log.Errorln("memory ListAgents.decode error:", err)
+			continue
+		}
+
+		ret = append(ret, a)
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,175 @@
+package memory
+
+import (
+	"fmt"
+	"path"
+
+	log "github.com/Sirupsen/logrus"
+
+	"github.com/Dataman-Cloud/swan/types"
+)
+
+func (s *MemoryStore) CreateApp(app *types.Application) error {
+	bs, err := encode(app)
+	if err != nil {
+		return err
+	}
+
+	if err := s.create(path.Join(keyApp, app.ID, "value"), bs); err != nil {
+		if err == errAlreadyExists {
+			return errAppAlreadyExists
+		}
+		return err
+	}
+
+	app.Revision = 1
+
+	return nil
+}
+
+func (s *MemoryStore) UpdateApp(app *types.Application) error {
+	bs, err := encode(app)
+	if err != nil {
+		return err
+	}
+
+	rev, err := s.cas(path.Join(keyApp, app.ID, "value"), app.Revision, bs)
+	if err != nil {
+		if err == errNotExists {
+			return fmt.Errorf("app %s not exists", app.ID)
+		}
+		if err == errConflict {
+			return fmt.Errorf("app %s revision %d conflict", app.ID, app.Revision)
+		}
+		return err
+	}
+
+	app.Revision = rev
+
+	return nil
+}
+
+func (s *MemoryStore) GetApp(id string) (*types.Application, error) {
+	data, rev, err := s.getRev(path.Join(keyApp, id, "value"))
+	if err != nil {
+		if err == errNotExists {
+			return nil, fmt.Errorf("app %s not exists", id)
+		}
+		return nil, err
+	}
+
+	var app types.Application
+	if err := decode(data, &app); err != nil {
+		return nil, err
+	}
+
+	app.Revision = rev
+
+	tasks, err := s.ListTasks(id)
+	if err != nil {
+		log.Errorf("get app %s tasks got error: %v", id, err)
+		return nil, err
+	}
+
+	app.TaskCount = len(tasks)
+	app.Status = status(tasks)
+	app.Version = version(tasks)
+	app.Health = health(tasks)
+
+	versions, err := s.ListVersions(id)
+	if err != nil {
+		log.Errorf("get app %s versions got error: %v", id, err)
+		return nil, err
+	}
+
+	app.VersionCount = len(versions)
+
+	if len(app.Version) == 0 && len(versions) > 0 {
+		types.VersionList(versions).Reverse()
+		app.Version = append(app.Version, versions[0].ID)
+	}
+
+	return &app, nil
+}
+
+func (s *MemoryStore) ListApps() ([]*types.Application, error) {
+	apps := make([]*types.Application, 0)
+	for _, id := range s.list(keyApp) {
+		app, err := s.GetApp(id)
+		if err != nil {
+			log.Errorf("get app error: %v", err)
+			continue
+		}
+		apps = append(apps, app)
+	}
+
+	return apps, nil
+}
+
+// DeleteApp deletes the app with its tasks and versions.
+func (s *MemoryStore) DeleteApp(id string) error {
+	return s.del(path.Join(keyApp, id))
+}
+
+func status(tasks types.TaskList) string {
+	for _, task := range tasks {
+		if task.Status == "TASK_RUNNING" {
+			return "available"
+		}
+	}
+
+	return "unavailable"
+}
+
+func health(tasks types.TaskList) *types.Health {
+	var (
+		total     int64
+		healthy   int64
+		unhealthy int64
+		unset     int64
+	)
+
+	for _, task := range tasks {
+		switch task.Healthy {
+		case types.TaskHealthy:
+			healthy++
+		case types.TaskUnHealthy:
+			unhealthy++
+		case types.TaskHealthyUnset:
+			unset++
+		}
+
+		total++
+	}
+
+	return &types.Health{
+		Total:     total,
+		Healthy:   healthy,
+		UnHealthy: unhealthy,
+		UnSet:     unset,
+	}
+}
+
+func version(tasks types.TaskList) []string {
+	vers := make([]string, 0)
+
+	for _, task := range tasks {
+		if verExist(vers, task.Version) {
+			continue
+		}
+
+		vers = append(vers, task.Version)
+	}
+
+	return vers
+}
+
+func verExist(vers []string, ver string) bool {
+	for _, v := range vers {
+		if v == ver {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,110 @@
+package memory
+
+import (
+	"errors"
+	"fmt"
+
+	log "github.com/Sirupsen/logrus"
+
+	"github.com/Dataman-Cloud/swan/types"
+)
+
+func (s *MemoryStore) CreateCompose(cps *types.Compose) error {
+	bs, err := encode(cps)
+	if err != nil {
+		return err
+	}
+
+	if err := s.create(keyCompose+"/"+cps.ID, bs); err != nil {
+		if err == errAlreadyExists {
+			return errors.New("compose already exists")
+		}
+		return err
+	}
+
+	cps.Revision = 1
+
+	return nil
+}
+
+func (s *MemoryStore) UpdateCompose(cps *types.Compose) error {
+	bs, err := encode(cps)
+	if err != nil {
+		return err
+	}
+
+	rev, err := s.cas(keyCompose+"/"+cps.ID, cps.Revision, bs)
+	if err != nil {
+		if err == errNotExists {
+			return errInstanceNotFound
+		}
+		if err == errConflict {
+			return fmt.Errorf("compose %s revision %d conflict", cps.ID, cps.Revision)
+		}
+		return err
+	}
+
+	cps.Revision = rev
+
+	return nil
+}
+
+func (s *MemoryStore) GetCompose(idOrName string) (*types.Compose, error) {
+	// by id
+	bs, rev, err := s.getRev(keyCompose + "/" + idOrName)
+	if err == nil {
+		cps := new(types.Compose)
+		if err := decode(bs, &cps); err != nil {
+			log.Errorln("memory GetCompose.decode error:", err)
+			return nil, err
+		}
+		cps.Revision = rev
+		return cps, nil
+	}
+
+	// by name
+	cpss, err := s.ListComposes()
+	if err != nil {
+		return nil, err
+	}
+	for _, cps := range cpss {
+		if cps.Name == idOrName {
+			return cps, nil
+		}
+	}
+
+	return nil, errors.New("no such compose")
+}
+
+func (s *MemoryStore) ListComposes() ([]*types.Compose, error) {
+	ret := make([]*types.Compose, 0)
+
+	for _, node := range s.list(keyCompose) {
+		bs, rev, err := s.getRev(keyCompose + "/" + node)
+		if err != nil {
+			log.Errorln("memory ListComposes.getnode error:", err)
+			continue
+		}
+
+		cps := new(types.Compose)
+		if err := decode(bs, &cps); err != nil {
+			log.Errorln("memory ListComposes.decode error:", err)
+			continue
+		}
+
+		cps.Revision = rev
+
+		ret = append(ret, cps)
+	}
+
+	return ret, nil
+}
+
+func (s *MemoryStore) DeleteCompose(idOrName string) error {
+	cps, err := s.GetCompose(idOrName)
+	if err != nil {
+		return err
+	}
+
+	return s.del(keyCompose + "/" + cps.ID)
+}
@@ -0,0 +1,85 @@
+package memory
+
+import (
+	"fmt"
+
+	log "github.com/Sirupsen/logrus"
+
+	"github.com/Dataman-Cloud/swan/types"
+)
+
+func (s *MemoryStore) CreateCronJob(cronJob *types.CronJob) error {
+	bs, err := encode(cronJob)
+	if err != nil {
+		return err
+	}
+
+	if err := s.create(keyCronJob+"/"+cronJob.ID, bs); err != nil {
+		if err == errAlreadyExists {
+			return errCronJobAlreadyExists
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *MemoryStore) UpdateCronJob(cronJob *types.CronJob) error {
+	bs, err := encode(cronJob)
+	if err != nil {
+		return err
+	}
+
+	if err := s.update(keyCronJob+"/"+cronJob.ID, bs); err != nil {
+		if err == errNotExists {
+			return fmt.Errorf("cron job %s not exists", cronJob.ID)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *MemoryStore) GetCronJob(id string) (*types.CronJob, error) {
+	bs, err := s.get(keyCronJob + "/" + id)
+	if err != nil {
+		if err == errNotExists {
+			return nil, fmt.Errorf("cron job %s not exists", id)
+		}
+		return nil, err
+	}
+
+	j := new(types.CronJob)
+	if err := decode(bs, &j); err != nil {
+		log.Errorln("memory GetCronJob.decode error:", err)
+		return nil, err
+	}
+
+	return j, nil
+}
+
+func (s *MemoryStore) ListCronJobs() ([]*types.CronJob, error) {
+	ret := make([]*types.CronJob, 0)
+
+	for _, node := range s.list(keyCronJob) {
+		bs, err := s.get(keyCronJob + "/" + node)
+		if err != nil {
+			log.Errorln("memory ListCronJobs.getnode error:", err)
+			continue
+		}
+
+		j := new(types.CronJob)
+		if err := decode(bs, &j); err != nil {
+			log.Errorln("memory ListCronJobs.decode error:", err)
+			continue
+		}
+
+		ret = append(ret, j)
+	}
+
+	return ret, nil
+}
+
+func (s *MemoryStore) DeleteCronJob(id string) error {
+	return s.del(keyCronJob + "/" + id)
+}
@@ -0,0 +1,14 @@
+package memory
+
+func (s *MemoryStore) UpdateFrameworkId(id string) error {
+	return s.upsert(keyFrameworkID, []byte(id))
+}
+
+func (s *MemoryStore) GetFrameworkId() (string, int64) {
+	bs, err := s.get(keyFrameworkID)
+	if err != nil {
+		return "", 0
+	}
+
+	return string(bs), s.mtime(keyFrameworkID)
+}
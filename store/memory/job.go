@@ -0,0 +1,85 @@
+package memory
+
+import (
+	"fmt"
+
+	log "github.com/Sirupsen/logrus"
+
+	"github.com/Dataman-Cloud/swan/types"
+)
+
+func (s *MemoryStore) CreateJob(job *types.Job) error {
+	bs, err := encode(job)
+	if err != nil {
+		return err
+	}
+
+	if err := s.create(keyJob+"/"+job.ID, bs); err != nil {
+		if err == errAlreadyExists {
+			return errJobAlreadyExists
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *MemoryStore) UpdateJob(job *types.Job) error {
+	bs, err := encode(job)
+	if err != nil {
+		return err
+	}
+
+	if err := s.update(keyJob+"/"+job.ID, bs); err != nil {
+		if err == errNotExists {
+			return fmt.Errorf("job %s not exists", job.ID)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *MemoryStore) GetJob(id string) (*types.Job, error) {
+	bs, err := s.get(keyJob + "/" + id)
+	if err != nil {
+		if err == errNotExists {
+			return nil, fmt.Errorf("job %s not exists", id)
+		}
+		return nil, err
+	}
+
+	j := new(types.Job)
+	if err := decode(bs, &j); err != nil {
+		log.Errorln("memory GetJob.decode error:", err)
+		return nil, err
+	}
+
+	return j, nil
+}
+
+func (s *MemoryStore) ListJobs() ([]*types.Job, error) {
+	ret := make([]*types.Job, 0)
+
+	for _, node := range s.list(keyJob) {
+		bs, err := s.get(keyJob + "/" + node)
+		if err != nil {
+			log.Errorln("memory ListJobs.getnode error:", err)
+			continue
+		}
+
+		j := new(types.Job)
+		if err := decode(bs, &j); err != nil {
+			log.Errorln("memory ListJobs.decode error:", err)
+			continue
+		}
+
+		ret = append(ret, j)
+	}
+
+	return ret, nil
+}
+
+func (s *MemoryStore) DeleteJob(id string) error {
+	return s.del(keyJob + "/" + id)
+}
@@ -0,0 +1,376 @@
+package memory
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Dataman-Cloud/swan/store/watch"
+
+	log "github.com/Sirupsen/logrus"
+)
+
+const (
+	keyApp         = "/apps"         // single app
+	keyCompose     = "/composes"     // compose instance (group apps)
+	keyAgent       = "/agents"       // swan agent
+	keyQuota       = "/quotas"       // resource quota of runAs
+	keyUsage       = "/usage"        // resource usage rollups
+	keyJob         = "/jobs"         // run-to-completion jobs
+	keyCronJob     = "/cronjobs"     // cron jobs
+	keyReservation = "/reservations" // dynamic reservations of stateful tasks
+	keyFrameworkID = "/framework"    // framework id
+
+	keyTasks    = "tasks"    // sub key of keyApp
+	keyVersions = "versions" // sub key of keyApp
+
+	snapshotVersion = 1
+)
+
+var (
+	errAppAlreadyExists         = errors.New("app already exists")
+	errInstanceNotFound         = errors.New("instance not found")
+	errAgentNotFound            = errors.New("agent not found")
+	errQuotaAlreadyExists       = errors.New("quota already exists")
+	errJobAlreadyExists         = errors.New("job already exists")
+	errCronJobAlreadyExists     = errors.New("cron job already exists")
+	errReservationAlreadyExists = errors.New("reservation already exists")
+
+	errNotExists     = errors.New("node not exists")
+	errAlreadyExists = errors.New("node already exists")
+	errConflict      = errors.New("node revision conflict")
+)
+
+// node is a value in the store, with its last modified time in milliseconds, and
+// the revision increased on each change from 1.
+type node struct {
+	Value []byte `json:"value"`
+	Mtime int64  `json:"mtime"`
+	Rev   int64  `json:"rev"`
+}
+
+// snapshot is the content of the snapshot file.
+type snapshot struct {
+	Version int              `json:"version"`
+	Nodes   map[string]*node `json:"nodes"`
+}
+
+// MemoryStore keeps the data in memory with the same key layout as the etcd store,
+// the values are encoded so the callers never share objects with the store.
+//
+// If a file given, the data is loaded from it at start, and written back to it
+// after each change. It's only meant for a single manager, eg: development or tests.
+type MemoryStore struct {
+	sync.RWMutex
+	file  string
+	nodes map[string]*node
+	hub   watch.Hub
+}
+
+// NewMemoryStore creates the memory store, which is snapshotted to the file unless
+// it's empty.
+func NewMemoryStore(file string) (*MemoryStore, error) {
+	s := &MemoryStore{
+		file:  file,
+		nodes: make(map[string]*node),
+	}
+
+	if file == "" {
+		return s, nil
+	}
+
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *MemoryStore) load() error {
+	bs, err := ioutil.ReadFile(s.file)
+	if err != nil {
+		if os.IsNotExist(err) {
+			log.Infof("memory store snapshot %s not exists, starting empty", s.file)
+			return nil
+		}
+		return err
+	}
+
+	var snap snapshot
+	if err := json.Unmarshal(bs, &snap); err != nil {
+		return fmt.Errorf("decode memory store snapshot %s got error: %v", s.file, err)
+	}
+
+	if snap.Version != snapshotVersion {
+		return fmt.Errorf("memory store snapshot %s version %d not supported", s.file, snap.Version)
+	}
+
+	if snap.Nodes != nil {
+		s.nodes = snap.Nodes
+	}
+
+	log.Infof("loaded %d nodes from memory store snapshot %s", len(s.nodes), s.file)
+
+	return nil
+}
+
+// Snapshot returns all of the data in the store, which can be restored by Restore.
+func (s *MemoryStore) Snapshot() ([]byte, error) {
+	s.RLock()
+	defer s.RUnlock()
+
+	return json.Marshal(&snapshot{Version: snapshotVersion, Nodes: s.nodes})
+}
+
+// Restore replaces all of the data in the store with the snapshot.
+func (s *MemoryStore) Restore(bs []byte) error {
+	var snap snapshot
+	if err := json.Unmarshal(bs, &snap); err != nil {
+		return fmt.Errorf("decode memory store snapshot got error: %v", err)
+	}
+
+	if snap.Version != snapshotVersion {
+		return fmt.Errorf("memory store snapshot version %d not supported", snap.Version)
+	}
+
+	if snap.Nodes == nil {
+		snap.Nodes = make(map[string]*node)
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	s.nodes = snap.Nodes
+
+	s.hub.Changed(watch.All)
+
+	return s.save()
+}
+
+// WatchApps watches the changes of the apps.
+func (s *MemoryStore) WatchApps(stop <-chan struct{}) (<-chan string, error) {
+	return s.hub.Watch(stop), nil
+}
+
+// changed notifies the watchers if the key is of an app.
+func (s *MemoryStore) changed(key string) {
+	if id, ok := watch.AppID(keyApp, key); ok {
+		s.hub.Changed(id)
+	}
+}
+
+// save writes the snapshot file atomically, with the lock held.
+func (s *MemoryStore) save() error {
+	if s.file == "" {
+		return nil
+	}
+
+	bs, err := json.Marshal(&snapshot{Version: snapshotVersion, Nodes: s.nodes})
+	if err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(s.file), filepath.Base(s.file)+".tmp")
+	if err != nil {
+		return err
+	}
+
+	if _, err := tmp.Write(bs); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	return os.Rename(tmp.Name(), s.file)
+}
+
+func (s *MemoryStore) get(key string) ([]byte, error) {
+	value, _, err := s.getRev(key)
+	return value, err
+}
+
+// getRev returns the value with its revision.
+func (s *MemoryStore) getRev(key string) ([]byte, int64, error) {
+	s.RLock()
+	defer s.RUnlock()
+
+	n, ok := s.nodes[path.Clean(key)]
+	if !ok {
+		return nil, 0, errNotExists
+	}
+
+	return n.Value, n.Rev, nil
+}
+
+func (s *MemoryStore) exists(key string) bool {
+	_, err := s.get(key)
+	return err == nil
+}
+
+// create sets the key not exists, the revision of the key created is 1.
+func (s *MemoryStore) create(key string, value []byte) error {
+	return s.modify(key, func(old []byte, exists bool) ([]byte, error) {
+		if exists {
+			return nil, errAlreadyExists
+		}
+		return value, nil
+	})
+}
+
+func (s *MemoryStore) update(key string, value []byte) error {
+	return s.modify(key, func(old []byte, exists bool) ([]byte, error) {
+		if !exists {
+			return nil, errNotExists
+		}
+		return value, nil
+	})
+}
+
+// cas updates the key only if its revision is rev, unless rev is 0, and returns
+// the new revision.
+func (s *MemoryStore) cas(key string, rev int64, value []byte) (int64, error) {
+	var next int64
+
+	err := s.modify(key, func(old []byte, exists bool) ([]byte, error) {
+		if !exists {
+			return nil, errNotExists
+		}
+
+		// modify holds the lock while calling fn.
+		cur := s.nodes[path.Clean(key)].Rev
+		if rev != 0 && cur != rev {
+			return nil, errConflict
+		}
+
+		next = cur + 1
+
+		return value, nil
+	})
+
+	return next, err
+}
+
+func (s *MemoryStore) upsert(key string, value []byte) error {
+	return s.modify(key, func(old []byte, exists bool) ([]byte, error) {
+		return value, nil
+	})
+}
+
+// modify sets the key to the value returned by fn atomically, fn is given the
+// current value of the key.
+func (s *MemoryStore) modify(key string, fn func(old []byte, exists bool) ([]byte, error)) error {
+	key = path.Clean(key)
+
+	s.Lock()
+	defer s.Unlock()
+
+	var (
+		old []byte
+		rev int64
+	)
+
+	n, ok := s.nodes[key]
+	if ok {
+		old, rev = n.Value, n.Rev
+	}
+
+	value, err := fn(old, ok)
+	if err != nil {
+		return err
+	}
+
+	s.nodes[key] = &node{
+		Value: value,
+		Mtime: time.Now().UnixNano() / int64(time.Millisecond),
+		Rev:   rev + 1,
+	}
+
+	s.changed(key)
+
+	return s.save()
+}
+
+// del removes the key and all of the keys under it, nothing happens if not exists.
+func (s *MemoryStore) del(key string) error {
+	key = path.Clean(key)
+
+	s.Lock()
+	defer s.Unlock()
+
+	var deleted bool
+	for k := range s.nodes {
+		if k == key || strings.HasPrefix(k, key+"/") {
+			delete(s.nodes, k)
+			deleted = true
+		}
+	}
+
+	if !deleted {
+		return nil
+	}
+
+	s.changed(key)
+
+	return s.save()
+}
+
+// list returns the sorted names of the direct children under the key.
+func (s *MemoryStore) list(key string) []string {
+	prefix := path.Clean(key) + "/"
+
+	s.RLock()
+	defer s.RUnlock()
+
+	seen := make(map[string]bool)
+	for k := range s.nodes {
+		if !strings.HasPrefix(k, prefix) {
+			continue
+		}
+
+		name := strings.SplitN(strings.TrimPrefix(k, prefix), "/", 2)[0]
+		seen[name] = true
+	}
+
+	children := make([]string, 0, len(seen))
+	for name := range seen {
+		children = append(children, name)
+	}
+
+	sort.Strings(children)
+
+	return children
+}
+
+// mtime returns the last modified time of the key in milliseconds.
+func (s *MemoryStore) mtime(key string) int64 {
+	s.RLock()
+	defer s.RUnlock()
+
+	if n, ok := s.nodes[path.Clean(key)]; ok {
+		return n.Mtime
+	}
+
+	return 0
+}
+
+// encode & decode is just short-hands for json Marshal/Unmarshal
+func encode(data interface{}) ([]byte, error) {
+	return json.Marshal(data)
+}
+
+func decode(bs []byte, v interface{}) error {
+	return json.Unmarshal(bs, v)
+}
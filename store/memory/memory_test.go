@@ -0,0 +1,32 @@
+package memory_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/Dataman-Cloud/swan/store"
+	"github.com/Dataman-Cloud/swan/store/memory"
+	"github.com/Dataman-Cloud/swan/store/storetest"
+)
+
+func TestMemoryStore(t *testing.T) {
+	storetest.Run(t, func(t *testing.T) store.Store {
+		s, err := memory.NewMemoryStore("")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return s
+	})
+}
+
+func TestMemoryStoreWithFile(t *testing.T) {
+	storetest.Run(t, func(t *testing.T) store.Store {
+		s, err := memory.NewMemoryStore(filepath.Join(t.TempDir(), "swan.json"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return s
+	})
+}
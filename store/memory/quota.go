@@ -0,0 +1,89 @@
+package memory
+
+import (
+	"fmt"
+
+	log "github.com/Sirupsen/logrus"
+
+	"github.com/Dataman-Cloud/swan/types"
+)
+
+func (s *MemoryStore) CreateQuota(quota *types.Quota) error {
+	bs, err := encode(quota)
+	if err != nil {
+		return err
+	}
+
+	if err := s.create(keyQuota+"/"+quota.RunAs, bs); err != nil {
+		if err == errAlreadyExists {
+			return errQuotaAlreadyExists
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *MemoryStore) UpdateQuota(quota *types.Quota) error {
+	bs, err := encode(quota)
+	if err != nil {
+		return err
+	}
+
+	if err := s.update(keyQuota+"/"+quota.RunAs, bs); err != nil {
+		if err == errNotExists {
+			return fmt.Errorf("quota %s not exists", quota.RunAs)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *MemoryStore) GetQuota(runAs string) (*types.Quota, error) {
+	bs, err := s.get(keyQuota + "/" + runAs)
+	if err != nil {
+		if err == errNotExists {
+			return nil, fmt.Errorf("quota %s not exists", runAs)
+		}
+		return nil, err
+	}
+
+	q := new(types.Quota)
+	if err := decode(bs, &q); err != nil {
+		log.Errorln("memory GetQuota.decode error:", err)
+		return nil, err
+	}
+
+	return q, nil
+}
+
+func (s *MemoryStore) ListQuotas() ([]*types.Quota, error) {
+	ret := make([]*types.Quota, 0)
+
+	for _, node := range s.list(keyQuota) {
+		bs, err := s.get(keyQuota + "/" + node)
+		if err != nil {
+			log.Errorln("memory ListQuotas.getnode error:", err)
+			continue
+		}
+
+		q := new(types.Quota)
+		if err := decode(bs, &q); err != nil {
+			log.Errorln("memory ListQuotas.decode error:", err)
+			continue
+		}
+
+		ret = append(ret, q)
+	}
+
+	return ret, nil
+}
+
+func (s *MemoryStore) DeleteQuota(runAs string) error {
+	if _, err := s.GetQuota(runAs); err != nil {
+		return err
+	}
+
+	return s.del(keyQuota + "/" + runAs)
+}
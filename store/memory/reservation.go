@@ -0,0 +1,85 @@
+package memory
+
+import (
+	"fmt"
+
+	log "github.com/Sirupsen/logrus"
+
+	"github.com/Dataman-Cloud/swan/types"
+)
+
+func (s *MemoryStore) CreateReservation(reservation *types.Reservation) error {
+	bs, err := encode(reservation)
+	if err != nil {
+		return err
+	}
+
+	if err := s.create(keyReservation+"/"+reservation.ID, bs); err != nil {
+		if err == errAlreadyExists {
+			return errReservationAlreadyExists
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *MemoryStore) UpdateReservation(reservation *types.Reservation) error {
+	bs, err := encode(reservation)
+	if err != nil {
+		return err
+	}
+
+	if err := s.update(keyReservation+"/"+reservation.ID, bs); err != nil {
+		if err == errNotExists {
+			return fmt.Errorf("reservation %s not exists", reservation.ID)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *MemoryStore) GetReservation(id string) (*types.Reservation, error) {
+	bs, err := s.get(keyReservation + "/" + id)
+	if err != nil {
+		if err == errNotExists {
+			return nil, fmt.Errorf("reservation %s not exists", id)
+		}
+		return nil, err
+	}
+
+	r := new(types.Reservation)
+	if err := decode(bs, &r); err != nil {
+		log.Errorln("memory GetReservation.decode error:", err)
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (s *MemoryStore) ListReservations() ([]*types.Reservation, error) {
+	ret := make([]*types.Reservation, 0)
+
+	for _, node := range s.list(keyReservation) {
+		bs, err := s.get(keyReservation + "/" + node)
+		if err != nil {
+			log.Errorln("memory ListReservations.getnode error:", err)
+			continue
+		}
+
+		r := new(types.Reservation)
+		if err := decode(bs, &r); err != nil {
+			log.Errorln("memory ListReservations.decode error:", err)
+			continue
+		}
+
+		ret = append(ret, r)
+	}
+
+	return ret, nil
+}
+
+func (s *MemoryStore) DeleteReservation(id string) error {
+	return s.del(keyReservation + "/" + id)
+}
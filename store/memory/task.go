@@ -0,0 +1,104 @@
+package memory
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	log "github.com/Sirupsen/logrus"
+
+	"github.com/Dataman-Cloud/swan/types"
+)
+
+func (s *MemoryStore) CreateTask(aid string, task *types.Task) error {
+	bs, err := encode(task)
+	if err != nil {
+		return err
+	}
+
+	if err := s.create(path.Join(keyApp, aid, keyTasks, task.ID), bs); err != nil {
+		if err == errAlreadyExists {
+			return fmt.Errorf("task %s already exists", task.ID)
+		}
+		return err
+	}
+
+	task.Revision = 1
+
+	return nil
+}
+
+func (s *MemoryStore) UpdateTask(aid string, task *types.Task) error {
+	bs, err := encode(task)
+	if err != nil {
+		return err
+	}
+
+	rev, err := s.cas(path.Join(keyApp, aid, keyTasks, task.ID), task.Revision, bs)
+	if err != nil {
+		if err == errNotExists {
+			return fmt.Errorf("task %s not exists", task.ID)
+		}
+		if err == errConflict {
+			return fmt.Errorf("task %s revision %d conflict", task.ID, task.Revision)
+		}
+		return err
+	}
+
+	task.Revision = rev
+
+	return nil
+}
+
+func (s *MemoryStore) ListTasks(id string) ([]*types.Task, error) {
+	tasks := make([]*types.Task, 0)
+	for _, child := range s.list(path.Join(keyApp, id, keyTasks)) {
+		p := path.Join(keyApp, id, keyTasks, child)
+		data, rev, err := s.getRev(p)
+		if err != nil {
+			log.Errorf("get %s got error: %v", p, err)
+			return nil, err
+		}
+
+		var t *types.Task
+		if err := decode(data, &t); err != nil {
+			log.Errorf("decode task %s got error: %v", id, err)
+			return nil, err
+		}
+
+		t.Revision = rev
+
+		tasks = append(tasks, t)
+	}
+
+	return tasks, nil
+}
+
+// DeleteTask deletes the task by id, eg: `ab3cd5ef6gh7.0.nginx.default.xcm.dataman`.
+func (s *MemoryStore) DeleteTask(id string) error {
+	parts := strings.SplitN(id, ".", 3)
+	if len(parts) != 3 {
+		return fmt.Errorf("malformed task id %s", id)
+	}
+
+	return s.del(path.Join(keyApp, parts[2], keyTasks, id))
+}
+
+func (s *MemoryStore) GetTask(aid, tid string) (*types.Task, error) {
+	data, rev, err := s.getRev(path.Join(keyApp, aid, keyTasks, tid))
+	if err != nil {
+		if err == errNotExists {
+			return nil, fmt.Errorf("task %s not exists", tid)
+		}
+		return nil, err
+	}
+
+	var task types.Task
+	if err := decode(data, &task); err != nil {
+		return nil, err
+	}
+
+	task.Revision = rev
+
+	return &task, nil
+}
@@ -0,0 +1,72 @@
+package memory
+
+import (
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+
+	"github.com/Dataman-Cloud/swan/types"
+)
+
+// AddUsage adds the record to the one of the same bucket and app atomically.
+func (s *MemoryStore) AddUsage(record *types.UsageRecord) error {
+	return s.modify(keyUsage+"/"+record.BucketKey()+"/"+record.AppID, func(old []byte, exists bool) ([]byte, error) {
+		if !exists {
+			return encode(record)
+		}
+
+		prev := new(types.UsageRecord)
+		if err := decode(old, &prev); err != nil {
+			log.Errorln("memory AddUsage.decode error:", err)
+			return nil, err
+		}
+
+		prev.Add(record)
+
+		return encode(prev)
+	})
+}
+
+func (s *MemoryStore) ListUsage(from, to time.Time) ([]*types.UsageRecord, error) {
+	ret := make([]*types.UsageRecord, 0)
+
+	for _, key := range s.list(keyUsage) {
+		bucket, err := types.ParseUsageBucket(key)
+		if err != nil || bucket.Before(from) || !bucket.Before(to) {
+			continue
+		}
+
+		for _, node := range s.list(keyUsage + "/" + key) {
+			bs, err := s.get(keyUsage + "/" + key + "/" + node)
+			if err != nil {
+				log.Errorln("memory ListUsage.getnode error:", err)
+				continue
+			}
+
+			r := new(types.UsageRecord)
+			if err := decode(bs, &r); err != nil {
+				log.Errorln("memory ListUsage.decode error:", err)
+				continue
+			}
+
+			ret = append(ret, r)
+		}
+	}
+
+	return ret, nil
+}
+
+func (s *MemoryStore) PurgeUsage(before time.Time) error {
+	for _, key := range s.list(keyUsage) {
+		bucket, err := types.ParseUsageBucket(key)
+		if err != nil || !bucket.Before(before) {
+			continue
+		}
+
+		if err := s.del(keyUsage + "/" + key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
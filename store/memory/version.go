@@ -0,0 +1,65 @@
+package memory
+
+import (
+	"fmt"
+	"path"
+
+	log "github.com/Sirupsen/logrus"
+
+	"github.com/Dataman-Cloud/swan/types"
+)
+
+func (s *MemoryStore) CreateVersion(aid string, version *types.Version) error {
+	bs, err := encode(version)
+	if err != nil {
+		return err
+	}
+
+	if err := s.create(path.Join(keyApp, aid, keyVersions, version.ID), bs); err != nil {
+		if err == errAlreadyExists {
+			return fmt.Errorf("version %s already exists", version.ID)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *MemoryStore) GetVersion(aid, vid string) (*types.Version, error) {
+	data, err := s.get(path.Join(keyApp, aid, keyVersions, vid))
+	if err != nil {
+		if err == errNotExists {
+			return nil, fmt.Errorf("app %s version %s not exists", aid, vid)
+		}
+		return nil, err
+	}
+
+	var ver types.Version
+	if err := decode(data, &ver); err != nil {
+		return nil, err
+	}
+
+	return &ver, nil
+}
+
+func (s *MemoryStore) ListVersions(aid string) ([]*types.Version, error) {
+	versions := make([]*types.Version, 0)
+	for _, child := range s.list(path.Join(keyApp, aid, keyVersions)) {
+		p := path.Join(keyApp, aid, keyVersions, child)
+		data, err := s.get(p)
+		if err != nil {
+			log.Errorf("get %s got error: %v", p, err)
+			return nil, err
+		}
+
+		var ver *types.Version
+		if err := decode(data, &ver); err != nil {
+			log.Errorf("decode version %s got error: %v", aid, err)
+			return nil, err
+		}
+
+		versions = append(versions, ver)
+	}
+
+	return versions, nil
+}
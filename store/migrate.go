@@ -0,0 +1,355 @@
+package store
+
+import (
+	"fmt"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+
+	"github.com/Dataman-Cloud/swan/types"
+)
+
+// MigrateKinds are the kinds of the objects migrated, in the order of copying.
+var MigrateKinds = []string{
+	"apps", "versions", "tasks", "composes", "agents",
+	"quotas", "jobs", "cron jobs", "reservations", "usage", "framework id",
+}
+
+// the range of all of the usage buckets.
+var (
+	usageBegin = time.Time{}
+	usageEnd   = time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// MigrateCount is the number of the objects of a kind copied, or to be copied if dry run.
+type MigrateCount struct {
+	Created int
+	Updated int
+}
+
+// Migrate copies the apps with their versions and tasks, the composes, the agents, the
+// quotas, the jobs, the cron jobs, the reservations, the usage and the framework id from
+// one store to another. The objects already in the target are updated, so it's fine to
+// run again, eg: after failed half way. The versions never changed are skipped if exists,
+// so are the usage records the same. Nothing is written to the target if dry run.
+func Migrate(from, to Store, dryRun bool) (map[string]*MigrateCount, error) {
+	m := &migration{
+		to:     to,
+		dryRun: dryRun,
+		counts: make(map[string]*MigrateCount),
+	}
+	for _, kind := range MigrateKinds {
+		m.counts[kind] = &MigrateCount{}
+	}
+
+	apps, err := from.ListApps()
+	if err != nil {
+		return nil, fmt.Errorf("list apps got error: %v", err)
+	}
+
+	for _, app := range apps {
+		if err := m.app(from, app); err != nil {
+			return m.counts, err
+		}
+	}
+
+	cpss, err := from.ListComposes()
+	if err != nil {
+		return m.counts, fmt.Errorf("list composes got error: %v", err)
+	}
+
+	for _, cps := range cpss {
+		cps.Revision = 0
+
+		_, err := to.GetCompose(cps.ID)
+		create := func() error { return to.CreateCompose(cps) }
+		update := func() error { return to.UpdateCompose(cps) }
+		if err := m.put("composes", err == nil, create, update); err != nil {
+			return m.counts, fmt.Errorf("copy compose %s got error: %v", cps.ID, err)
+		}
+	}
+
+	agents, err := from.ListAgents()
+	if err != nil {
+		return m.counts, fmt.Errorf("list agents got error: %v", err)
+	}
+
+	for _, agent := range agents {
+		_, err := to.GetAgent(agent.ID)
+		create := func() error { return to.CreateAgent(agent) }
+		update := func() error { return to.UpdateAgent(agent) }
+		if err := m.put("agents", err == nil, create, update); err != nil {
+			return m.counts, fmt.Errorf("copy agent %s got error: %v", agent.ID, err)
+		}
+	}
+
+	quotas, err := from.ListQuotas()
+	if err != nil {
+		return m.counts, fmt.Errorf("list quotas got error: %v", err)
+	}
+
+	for _, quota := range quotas {
+		_, err := to.GetQuota(quota.RunAs)
+		create := func() error { return to.CreateQuota(quota) }
+		update := func() error { return to.UpdateQuota(quota) }
+		if err := m.put("quotas", err == nil, create, update); err != nil {
+			return m.counts, fmt.Errorf("copy quota %s got error: %v", quota.RunAs, err)
+		}
+	}
+
+	jobs, err := from.ListJobs()
+	if err != nil {
+		return m.counts, fmt.Errorf("list jobs got error: %v", err)
+	}
+
+	for _, job := range jobs {
+		_, err := to.GetJob(job.ID)
+		create := func() error { return to.CreateJob(job) }
+		update := func() error { return to.UpdateJob(job) }
+		if err := m.put("jobs", err == nil, create, update); err != nil {
+			return m.counts, fmt.Errorf("copy job %s got error: %v", job.ID, err)
+		}
+	}
+
+	cronJobs, err := from.ListCronJobs()
+	if err != nil {
+		return m.counts, fmt.Errorf("list cron jobs got error: %v", err)
+	}
+
+	for _, cronJob := range cronJobs {
+		_, err := to.GetCronJob(cronJob.ID)
+		create := func() error { return to.CreateCronJob(cronJob) }
+		update := func() error { return to.UpdateCronJob(cronJob) }
+		if err := m.put("cron jobs", err == nil, create, update); err != nil {
+			return m.counts, fmt.Errorf("copy cron job %s got error: %v", cronJob.ID, err)
+		}
+	}
+
+	reservations, err := from.ListReservations()
+	if err != nil {
+		return m.counts, fmt.Errorf("list reservations got error: %v", err)
+	}
+
+	for _, r := range reservations {
+		_, err := to.GetReservation(r.ID)
+		create := func() error { return to.CreateReservation(r) }
+		update := func() error { return to.UpdateReservation(r) }
+		if err := m.put("reservations", err == nil, create, update); err != nil {
+			return m.counts, fmt.Errorf("copy reservation %s got error: %v", r.ID, err)
+		}
+	}
+
+	if err := m.usage(from); err != nil {
+		return m.counts, err
+	}
+
+	if id, _ := from.GetFrameworkId(); id != "" {
+		old, _ := to.GetFrameworkId()
+		update := func() error { return to.UpdateFrameworkId(id) }
+		if err := m.put("framework id", old != "", update, update); err != nil {
+			return m.counts, fmt.Errorf("copy framework id got error: %v", err)
+		}
+	}
+
+	return m.counts, nil
+}
+
+type migration struct {
+	to     Store
+	dryRun bool
+	counts map[string]*MigrateCount
+}
+
+func (m *migration) app(from Store, app *types.Application) error {
+	versions, err := from.ListVersions(app.ID)
+	if err != nil {
+		return fmt.Errorf("list app %s versions got error: %v", app.ID, err)
+	}
+
+	tasks, err := from.ListTasks(app.ID)
+	if err != nil {
+		return fmt.Errorf("list app %s tasks got error: %v", app.ID, err)
+	}
+
+	// the revisions of the source are meaningless to the target.
+	app.Revision = 0
+
+	_, err = m.to.GetApp(app.ID)
+	create := func() error { return m.to.CreateApp(app) }
+	update := func() error { return m.to.UpdateApp(app) }
+	if err := m.put("apps", err == nil, create, update); err != nil {
+		return fmt.Errorf("copy app %s got error: %v", app.ID, err)
+	}
+
+	for _, ver := range versions {
+		if _, err := m.to.GetVersion(app.ID, ver.ID); err == nil {
+			continue
+		}
+
+		create := func() error { return m.to.CreateVersion(app.ID, ver) }
+		if err := m.put("versions", false, create, nil); err != nil {
+			return fmt.Errorf("copy app %s version %s got error: %v", app.ID, ver.ID, err)
+		}
+	}
+
+	for _, task := range tasks {
+		task.Revision = 0
+
+		_, err := m.to.GetTask(app.ID, task.ID)
+		create := func() error { return m.to.CreateTask(app.ID, task) }
+		update := func() error { return m.to.UpdateTask(app.ID, task) }
+		if err := m.put("tasks", err == nil, create, update); err != nil {
+			return fmt.Errorf("copy task %s got error: %v", task.ID, err)
+		}
+	}
+
+	log.Debugf("migrated app %s with %d versions and %d tasks", app.ID, len(versions), len(tasks))
+
+	return nil
+}
+
+// usage copies the usage records. The records are added up in the store, so the one
+// already in the target is given the difference to be the same as the source.
+func (m *migration) usage(from Store) error {
+	records, err := from.ListUsage(usageBegin, usageEnd)
+	if err != nil {
+		return fmt.Errorf("list usage got error: %v", err)
+	}
+
+	olds, err := m.to.ListUsage(usageBegin, usageEnd)
+	if err != nil {
+		return fmt.Errorf("list usage of the target got error: %v", err)
+	}
+
+	existing := make(map[string]*types.UsageRecord, len(olds))
+	for _, old := range olds {
+		existing[old.Key()] = old
+	}
+
+	for _, record := range records {
+		diff := *record
+
+		old, exists := existing[record.Key()]
+		if exists {
+			diff.CPUSeconds -= old.CPUSeconds
+			diff.MemMBSeconds -= old.MemMBSeconds
+			diff.DiskMBSeconds -= old.DiskMBSeconds
+			diff.InstanceSeconds -= old.InstanceSeconds
+
+			if diff.CPUSeconds == 0 && diff.MemMBSeconds == 0 && diff.DiskMBSeconds == 0 && diff.InstanceSeconds == 0 {
+				continue
+			}
+		}
+
+		add := func() error { return m.to.AddUsage(&diff) }
+		if err := m.put("usage", exists, add, add); err != nil {
+			return fmt.Errorf("copy usage of app %s at %s got error: %v", record.AppID, record.Bucket, err)
+		}
+	}
+
+	return nil
+}
+
+// put creates or updates the object in the target unless dry run.
+func (m *migration) put(kind string, exists bool, create, update func() error) error {
+	fn := create
+	if exists {
+		fn = update
+	}
+
+	if !m.dryRun {
+		if err := fn(); err != nil {
+			return err
+		}
+	}
+
+	if exists {
+		m.counts[kind].Updated++
+	} else {
+		m.counts[kind].Created++
+	}
+
+	return nil
+}
+
+// Count returns the number of the objects of each kind migrated in the store.
+func Count(s Store) (map[string]int, error) {
+	counts := make(map[string]int)
+
+	apps, err := s.ListApps()
+	if err != nil {
+		return nil, err
+	}
+
+	counts["apps"] = len(apps)
+
+	for _, app := range apps {
+		versions, err := s.ListVersions(app.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		tasks, err := s.ListTasks(app.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		counts["versions"] += len(versions)
+		counts["tasks"] += len(tasks)
+	}
+
+	cpss, err := s.ListComposes()
+	if err != nil {
+		return nil, err
+	}
+
+	counts["composes"] = len(cpss)
+
+	agents, err := s.ListAgents()
+	if err != nil {
+		return nil, err
+	}
+
+	counts["agents"] = len(agents)
+
+	quotas, err := s.ListQuotas()
+	if err != nil {
+		return nil, err
+	}
+
+	counts["quotas"] = len(quotas)
+
+	jobs, err := s.ListJobs()
+	if err != nil {
+		return nil, err
+	}
+
+	counts["jobs"] = len(jobs)
+
+	cronJobs, err := s.ListCronJobs()
+	if err != nil {
+		return nil, err
+	}
+
+	counts["cron jobs"] = len(cronJobs)
+
+	reservations, err := s.ListReservations()
+	if err != nil {
+		return nil, err
+	}
+
+	counts["reservations"] = len(reservations)
+
+	records, err := s.ListUsage(usageBegin, usageEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	counts["usage"] = len(records)
+
+	if id, _ := s.GetFrameworkId(); id != "" {
+		counts["framework id"] = 1
+	}
+
+	return counts, nil
+}
@@ -0,0 +1,148 @@
+package store_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Dataman-Cloud/swan/store"
+	"github.com/Dataman-Cloud/swan/store/memory"
+	"github.com/Dataman-Cloud/swan/types"
+)
+
+func newStore(t *testing.T) store.Store {
+	s, err := memory.NewMemoryStore("")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return s
+}
+
+// seed fills the store with the objects of each kind.
+func seed(t *testing.T, s store.Store) {
+	bucket := time.Unix(1500000000, 0)
+
+	for _, err := range []error{
+		s.CreateApp(&types.Application{ID: "nginx.default.bbk.dataman"}),
+		s.CreateVersion("nginx.default.bbk.dataman", &types.Version{ID: "1", Name: "nginx"}),
+		s.CreateTask("nginx.default.bbk.dataman", &types.Task{ID: "0-nginx", Name: "0.nginx.default.bbk.dataman"}),
+		s.CreateCompose(&types.Compose{ID: "web", Name: "web"}),
+		s.CreateAgent(&types.Agent{ID: "agent0"}),
+		s.CreateQuota(&types.Quota{RunAs: "bbk", CPUs: 8}),
+		s.CreateJob(&types.Job{ID: "backup", Retries: 2}),
+		s.CreateCronJob(&types.CronJob{ID: "nightly", Schedule: "0 2 * * *"}),
+		s.CreateReservation(&types.Reservation{ID: "0.mysql.default.bbk.dataman", AgentID: "agent0", CPUs: 1}),
+		s.AddUsage(&types.UsageRecord{Bucket: bucket, AppID: "nginx.default.bbk.dataman", RunAs: "bbk", CPUSeconds: 10}),
+		s.AddUsage(&types.UsageRecord{Bucket: bucket.Add(time.Hour), AppID: "nginx.default.bbk.dataman", RunAs: "bbk", CPUSeconds: 5}),
+		s.UpdateFrameworkId("framework0"),
+	} {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func checkCounts(t *testing.T, from, to store.Store) {
+	src, err := store.Count(from)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dst, err := store.Count(to)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, kind := range store.MigrateKinds {
+		if src[kind] == 0 {
+			t.Errorf("expected some %s in the source", kind)
+		}
+
+		if src[kind] != dst[kind] {
+			t.Errorf("%s: expected %d, got %d", kind, src[kind], dst[kind])
+		}
+	}
+}
+
+func usageSeconds(t *testing.T, s store.Store) float64 {
+	records, err := s.ListUsage(time.Time{}, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var secs float64
+	for _, r := range records {
+		secs += r.CPUSeconds
+	}
+
+	return secs
+}
+
+func TestMigrate(t *testing.T) {
+	from, to := newStore(t), newStore(t)
+	seed(t, from)
+
+	counts, err := store.Migrate(from, to, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, kind := range store.MigrateKinds {
+		if counts[kind].Created == 0 || counts[kind].Updated != 0 {
+			t.Errorf("%s: expected created only, got %+v", kind, counts[kind])
+		}
+	}
+
+	checkCounts(t, from, to)
+
+	if r, err := to.GetReservation("0.mysql.default.bbk.dataman"); err != nil || r.AgentID != "agent0" {
+		t.Errorf("expected the reservation copied, got %+v, %v", r, err)
+	}
+
+	// run again, the usage is not added up twice.
+	if _, err := store.Migrate(from, to, false); err != nil {
+		t.Fatal(err)
+	}
+
+	if secs := usageSeconds(t, to); secs != 15 {
+		t.Errorf("expected 15 cpu seconds after migrated twice, got %v", secs)
+	}
+
+	// the usage grown in the source meanwhile is given the difference.
+	if err := from.AddUsage(&types.UsageRecord{Bucket: time.Unix(1500000000, 0), AppID: "nginx.default.bbk.dataman", CPUSeconds: 3}); err != nil {
+		t.Fatal(err)
+	}
+
+	counts, err = store.Migrate(from, to, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if counts["usage"].Updated != 1 {
+		t.Errorf("expected 1 usage record updated, got %+v", counts["usage"])
+	}
+
+	if secs := usageSeconds(t, to); secs != 18 {
+		t.Errorf("expected 18 cpu seconds, got %v", secs)
+	}
+}
+
+func TestMigrateDryRun(t *testing.T) {
+	from, to := newStore(t), newStore(t)
+	seed(t, from)
+
+	if _, err := store.Migrate(from, to, true); err != nil {
+		t.Fatal(err)
+	}
+
+	dst, err := store.Count(to)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, kind := range store.MigrateKinds {
+		if dst[kind] != 0 {
+			t.Errorf("%s: expected nothing written, got %d", kind, dst[kind])
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package store
+
+import (
+	"strings"
+
+	"github.com/Dataman-Cloud/swan/types"
+)
+
+// QuotaUsage sums up the resources consumed by all of the runAs's apps, and the
+// jobs not finished, one task of each.
+func QuotaUsage(s Store, runAs string) (*types.ResourceUsage, error) {
+	apps, err := s.ListApps()
+	if err != nil {
+		return nil, err
+	}
+
+	used := &types.ResourceUsage{}
+
+	for _, app := range apps {
+		if app.RunAs != runAs {
+			continue
+		}
+
+		usage, err := AppUsage(s, app.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		used.Add(usage)
+		used.Apps++
+	}
+
+	jobs, err := s.ListJobs()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, job := range jobs {
+		if job.RunAs != runAs || job.Finished() || job.Template == nil {
+			continue
+		}
+
+		used.Add(types.NewResourceUsage(job.Template, 1))
+	}
+
+	return used, nil
+}
+
+// CheckQuota verifies the requested resources against the runAs's quota, the runAs
+// without any quota is unlimited.
+func CheckQuota(s Store, runAs string, req *types.ResourceUsage) error {
+	quota, err := s.GetQuota(runAs)
+	if err != nil {
+		if strings.Contains(err.Error(), "not exists") {
+			return nil
+		}
+
+		return err
+	}
+
+	used, err := QuotaUsage(s, runAs)
+	if err != nil {
+		return err
+	}
+
+	return quota.Check(used, req)
+}
+
+// AppUsage sums up the resources consumed by the app's tasks, according to the
+// version each task is running.
+func AppUsage(s Store, appId string) (*types.ResourceUsage, error) {
+	tasks, err := s.ListTasks(appId)
+	if err != nil {
+		return nil, err
+	}
+
+	return TasksUsage(s, appId, tasks)
+}
+
+// TasksUsage sums up the resources consumed by the given tasks of the app as AppUsage.
+func TasksUsage(s Store, appId string, tasks []*types.Task) (*types.ResourceUsage, error) {
+	versions, err := s.ListVersions(appId)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(versions) == 0 {
+		return &types.ResourceUsage{}, nil
+	}
+
+	types.VersionList(versions).Reverse()
+
+	m := make(map[string]*types.Version)
+	for _, ver := range versions {
+		m[ver.ID] = ver
+	}
+
+	used := &types.ResourceUsage{}
+	for _, task := range tasks {
+		ver, ok := m[task.Version]
+		if !ok {
+			ver = versions[0] // the latest
+		}
+
+		used.Add(types.NewResourceUsage(ver, 1))
+	}
+
+	return used, nil
+}
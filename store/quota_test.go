@@ -0,0 +1,44 @@
+package store_test
+
+import (
+	"testing"
+
+	"github.com/Dataman-Cloud/swan/store"
+	"github.com/Dataman-Cloud/swan/types"
+)
+
+func TestQuotaUsageJobs(t *testing.T) {
+	s := newStore(t)
+
+	for _, err := range []error{
+		s.CreateQuota(&types.Quota{RunAs: "bbk", CPUs: 2}),
+		s.CreateJob(&types.Job{ID: "running", RunAs: "bbk", Status: types.JobRunning, Template: &types.Version{CPUs: 1}}),
+		s.CreateJob(&types.Job{ID: "done", RunAs: "bbk", Status: types.JobSucceeded, Template: &types.Version{CPUs: 1}}),
+		s.CreateJob(&types.Job{ID: "other", RunAs: "xcm", Status: types.JobPending, Template: &types.Version{CPUs: 1}}),
+	} {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	used, err := store.QuotaUsage(s, "bbk")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if used.CPUs != 1 || used.Instances != 1 {
+		t.Errorf("expected the running job counted only, got %+v", used)
+	}
+
+	if err := store.CheckQuota(s, "bbk", &types.ResourceUsage{CPUs: 1, Instances: 1}); err != nil {
+		t.Errorf("expected one more job allowed, got %v", err)
+	}
+
+	if err := store.CheckQuota(s, "bbk", &types.ResourceUsage{CPUs: 2, Instances: 1}); err == nil {
+		t.Error("expected the quota exceeded")
+	}
+
+	if err := store.CheckQuota(s, "xcm", &types.ResourceUsage{CPUs: 100}); err != nil {
+		t.Errorf("expected the runAs without quota unlimited, got %v", err)
+	}
+}
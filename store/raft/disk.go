@@ -0,0 +1,275 @@
+package raft
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	log "github.com/Sirupsen/logrus"
+	etcdraft "github.com/coreos/etcd/raft"
+	"github.com/coreos/etcd/raft/raftpb"
+)
+
+const (
+	recordEntry     byte = 1
+	recordHardState byte = 2
+
+	// type, size and crc of the record.
+	recordHeaderSize = 1 + 4 + 4
+
+	maxRecordSize = 64 << 20
+)
+
+var (
+	errBadRecord = errors.New("raft log: bad record")
+)
+
+// disk persists the raft state in the data dir, the latest snapshot in `snapshot`,
+// and the hard states and entries since the snapshot appended to `log`. The etcd
+// `wal` and `snap` packages are not used, their dependencies are not vendored.
+type disk struct {
+	dir string
+	log *os.File
+}
+
+func openDisk(dir string) (*disk, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+
+	return &disk{dir: dir}, nil
+}
+
+func (d *disk) path(name string) string {
+	return filepath.Join(d.dir, name)
+}
+
+// load reads the persisted state, the torn record at the tail of the log written
+// by a crash is truncated.
+func (d *disk) load() (snap raftpb.Snapshot, hs raftpb.HardState, ents []raftpb.Entry, err error) {
+	bs, err := ioutil.ReadFile(d.path("snapshot"))
+	if err != nil && !os.IsNotExist(err) {
+		return
+	}
+
+	if err == nil {
+		if err = snap.Unmarshal(bs); err != nil {
+			err = fmt.Errorf("decode raft snapshot got error: %v", err)
+			return
+		}
+	}
+
+	d.log, err = os.OpenFile(d.path("log"), os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return
+	}
+
+	var (
+		r      = bufio.NewReader(d.log)
+		offset int64
+	)
+
+	for {
+		typ, data, n, rerr := readRecord(r)
+		if rerr == io.EOF {
+			break
+		}
+
+		if rerr != nil {
+			log.Warnf("truncating raft log at %d for the bad record: %v", offset, rerr)
+			if err = d.log.Truncate(offset); err != nil {
+				return
+			}
+			break
+		}
+
+		offset += n
+
+		switch typ {
+		case recordHardState:
+			if err = hs.Unmarshal(data); err != nil {
+				return
+			}
+		case recordEntry:
+			var ent raftpb.Entry
+			if err = ent.Unmarshal(data); err != nil {
+				return
+			}
+
+			if ent.Index <= snap.Metadata.Index {
+				continue
+			}
+
+			// the entries conflicted are overwritten by the later ones.
+			if len(ents) > 0 && ent.Index <= ents[len(ents)-1].Index {
+				if ent.Index <= ents[0].Index {
+					ents = ents[:0]
+				} else {
+					ents = ents[:ent.Index-ents[0].Index]
+				}
+			}
+
+			ents = append(ents, ent)
+		}
+	}
+
+	_, err = d.log.Seek(offset, io.SeekStart)
+
+	return
+}
+
+// save appends the hard state and the entries to the log, and syncs it.
+func (d *disk) save(hs raftpb.HardState, ents []raftpb.Entry) error {
+	if len(ents) == 0 && etcdraft.IsEmptyHardState(hs) {
+		return nil
+	}
+
+	w := bufio.NewWriter(d.log)
+
+	for _, ent := range ents {
+		data, err := ent.Marshal()
+		if err != nil {
+			return err
+		}
+
+		if err := writeRecord(w, recordEntry, data); err != nil {
+			return err
+		}
+	}
+
+	if !etcdraft.IsEmptyHardState(hs) {
+		data, err := hs.Marshal()
+		if err != nil {
+			return err
+		}
+
+		if err := writeRecord(w, recordHardState, data); err != nil {
+			return err
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	return d.log.Sync()
+}
+
+// saveSnapshot replaces the snapshot file.
+func (d *disk) saveSnapshot(snap raftpb.Snapshot) error {
+	data, err := snap.Marshal()
+	if err != nil {
+		return err
+	}
+
+	return writeFile(d.path("snapshot"), data)
+}
+
+// rewrite replaces the log with the hard state and the entries after the snapshot,
+// the others are useless.
+func (d *disk) rewrite(hs raftpb.HardState, ents []raftpb.Entry) error {
+	tmp, err := os.OpenFile(d.path("log.tmp"), os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+
+	old := d.log
+	d.log = tmp
+
+	if err := d.save(hs, ents); err != nil {
+		d.log = old
+		tmp.Close()
+		return err
+	}
+
+	if err := os.Rename(tmp.Name(), d.path("log")); err != nil {
+		d.log = old
+		tmp.Close()
+		return err
+	}
+
+	return old.Close()
+}
+
+func (d *disk) close() error {
+	if d.log == nil {
+		return nil
+	}
+
+	return d.log.Close()
+}
+
+func writeRecord(w io.Writer, typ byte, data []byte) error {
+	var header [recordHeaderSize]byte
+
+	header[0] = typ
+	binary.BigEndian.PutUint32(header[1:5], uint32(len(data)))
+	binary.BigEndian.PutUint32(header[5:9], crc32.ChecksumIEEE(data))
+
+	if _, err := w.Write(header[:]); err != nil {
+		return err
+	}
+
+	_, err := w.Write(data)
+	return err
+}
+
+// readRecord returns the record and the bytes it takes.
+func readRecord(r io.Reader) (byte, []byte, int64, error) {
+	var header [recordHeaderSize]byte
+
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return 0, nil, 0, errBadRecord
+		}
+		return 0, nil, 0, err
+	}
+
+	size := binary.BigEndian.Uint32(header[1:5])
+	if size > maxRecordSize {
+		return 0, nil, 0, errBadRecord
+	}
+
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return 0, nil, 0, errBadRecord
+	}
+
+	if crc32.ChecksumIEEE(data) != binary.BigEndian.Uint32(header[5:9]) {
+		return 0, nil, 0, errBadRecord
+	}
+
+	return header[0], data, int64(recordHeaderSize) + int64(size), nil
+}
+
+// writeFile writes the file atomically.
+func writeFile(name string, data []byte) error {
+	tmp := name + ".tmp"
+
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, name)
+}
@@ -0,0 +1,290 @@
+package raft
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/coreos/etcd/raft/raftpb"
+
+	"github.com/Dataman-Cloud/swan/types"
+)
+
+func entries(term uint64, from, to uint64) []raftpb.Entry {
+	ents := make([]raftpb.Entry, 0, to-from+1)
+	for i := from; i <= to; i++ {
+		ents = append(ents, raftpb.Entry{
+			Term:  term,
+			Index: i,
+			Type:  raftpb.EntryNormal,
+			Data:  []byte(fmt.Sprintf("%d-%d", term, i)),
+		})
+	}
+
+	return ents
+}
+
+func openLoad(t *testing.T, dir string) (*disk, raftpb.Snapshot, raftpb.HardState, []raftpb.Entry) {
+	d, err := openDisk(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snap, hs, ents, err := d.load()
+	if err != nil {
+		t.Fatalf("load got error: %v", err)
+	}
+
+	return d, snap, hs, ents
+}
+
+func checkEntries(t *testing.T, got, expected []raftpb.Entry) {
+	if len(got) == 0 && len(expected) == 0 {
+		return
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected entries %v, got %v", expected, got)
+	}
+}
+
+func TestDiskRecover(t *testing.T) {
+	dir := t.TempDir()
+
+	d, _, _, ents := openLoad(t, dir)
+	checkEntries(t, ents, nil)
+
+	if err := d.save(raftpb.HardState{Term: 1, Vote: 1, Commit: 3}, entries(1, 1, 5)); err != nil {
+		t.Fatal(err)
+	}
+
+	// the entries from 4 are overwritten by the new leader.
+	if err := d.save(raftpb.HardState{Term: 2, Vote: 2, Commit: 5}, entries(2, 4, 6)); err != nil {
+		t.Fatal(err)
+	}
+
+	d.close()
+
+	d, snap, hs, ents := openLoad(t, dir)
+	defer d.close()
+
+	if snap.Metadata.Index != 0 {
+		t.Errorf("expected no snapshot, got the one at %d", snap.Metadata.Index)
+	}
+
+	if hs.Term != 2 || hs.Vote != 2 || hs.Commit != 5 {
+		t.Errorf("expected the latest hard state, got %+v", hs)
+	}
+
+	checkEntries(t, ents, append(entries(1, 1, 3), entries(2, 4, 6)...))
+}
+
+func TestDiskSnapshot(t *testing.T) {
+	dir := t.TempDir()
+
+	d, _, _, _ := openLoad(t, dir)
+
+	hs := raftpb.HardState{Term: 1, Vote: 1, Commit: 8}
+	if err := d.save(hs, entries(1, 1, 8)); err != nil {
+		t.Fatal(err)
+	}
+
+	snap := raftpb.Snapshot{
+		Data:     []byte("state"),
+		Metadata: raftpb.SnapshotMetadata{Index: 6, Term: 1, ConfState: raftpb.ConfState{Nodes: []uint64{1}}},
+	}
+
+	if err := d.saveSnapshot(snap); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := d.rewrite(hs, entries(1, 7, 8)); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := d.save(raftpb.HardState{Term: 1, Vote: 1, Commit: 9}, entries(1, 9, 9)); err != nil {
+		t.Fatal(err)
+	}
+
+	d.close()
+
+	d, got, _, ents := openLoad(t, dir)
+	defer d.close()
+
+	if !reflect.DeepEqual(got, snap) {
+		t.Errorf("expected snapshot %+v, got %+v", snap, got)
+	}
+
+	checkEntries(t, ents, entries(1, 7, 9))
+}
+
+// TestDiskTornWrite loads the log cut by a crash in the middle of a record, the
+// torn record is truncated and the log is appended after the records intact.
+func TestDiskTornWrite(t *testing.T) {
+	for _, c := range []struct {
+		name string
+		tear func(t *testing.T, name string, size int64)
+	}{
+		{"short", func(t *testing.T, name string, size int64) {
+			if err := os.Truncate(name, size-3); err != nil {
+				t.Fatal(err)
+			}
+		}},
+		{"header", func(t *testing.T, name string, size int64) {
+			appendFile(t, name, []byte{recordEntry, 0, 0})
+		}},
+		{"crc", func(t *testing.T, name string, size int64) {
+			f, err := os.OpenFile(name, os.O_RDWR, 0600)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer f.Close()
+
+			if _, err := f.WriteAt([]byte{'x'}, size-1); err != nil {
+				t.Fatal(err)
+			}
+		}},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			dir := t.TempDir()
+
+			d, _, _, _ := openLoad(t, dir)
+			if err := d.save(raftpb.HardState{Term: 1, Commit: 2}, entries(1, 1, 2)); err != nil {
+				t.Fatal(err)
+			}
+			if err := d.save(raftpb.HardState{}, entries(1, 3, 3)); err != nil {
+				t.Fatal(err)
+			}
+			d.close()
+
+			fi, err := os.Stat(d.path("log"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			c.tear(t, d.path("log"), fi.Size())
+
+			expected := entries(1, 1, 3)
+			if c.name != "header" {
+				expected = entries(1, 1, 2) // the last entry is torn
+			}
+
+			d, _, hs, ents := openLoad(t, dir)
+			checkEntries(t, ents, expected)
+
+			if hs.Commit != 2 {
+				t.Errorf("expected the hard state kept, got %+v", hs)
+			}
+
+			next := uint64(len(expected)) + 1
+			if err := d.save(raftpb.HardState{Term: 1, Commit: next}, entries(1, next, next)); err != nil {
+				t.Fatal(err)
+			}
+			d.close()
+
+			d, _, hs, ents = openLoad(t, dir)
+			defer d.close()
+
+			checkEntries(t, ents, entries(1, 1, next))
+
+			if hs.Commit != next {
+				t.Errorf("expected the hard state of commit %d, got %+v", next, hs)
+			}
+		})
+	}
+}
+
+func appendFile(t *testing.T, name string, data []byte) {
+	f, err := os.OpenFile(name, os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	if _, err := f.Write(data); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestRestart restarts the raft store from the data dir, the store is restored from
+// the snapshot and the entries after it.
+func TestRestart(t *testing.T) {
+	defer func(snapshot, catchup uint64) {
+		snapshotEntries, catchupEntries = snapshot, catchup
+	}(snapshotEntries, catchupEntries)
+
+	snapshotEntries, catchupEntries = 5, 2
+
+	var (
+		dir  = t.TempDir()
+		peer = "http://" + freeAddr(t)
+	)
+
+	start := func() *RaftStore {
+		s, err := NewRaftStore(&Config{ID: 1, Peers: []string{peer}, DataDir: dir, Listen: "127.0.0.1:9999"})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// announced once the entries before are applied.
+		deadline := time.Now().Add(10 * time.Second)
+		for !s.Leader().Self {
+			if time.Now().After(deadline) {
+				s.Stop()
+				t.Fatal("timed out waiting for the raft store elected")
+			}
+
+			time.Sleep(50 * time.Millisecond)
+		}
+
+		return s
+	}
+
+	s := start()
+	for i := 0; i < 12; i++ {
+		if err := s.CreateApp(&types.Application{ID: fmt.Sprintf("app%d", i)}); err != nil {
+			s.Stop()
+			t.Fatal(err)
+		}
+	}
+
+	if err := s.DeleteApp("app0"); err != nil {
+		s.Stop()
+		t.Fatal(err)
+	}
+	s.Stop()
+
+	if _, err := os.Stat(s.disk.path("snapshot")); err != nil {
+		t.Fatalf("expected the snapshot taken, got %v", err)
+	}
+
+	s = start()
+	defer s.Stop()
+
+	apps, err := s.ListApps()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(apps) != 11 {
+		t.Fatalf("expected 11 apps restored, got %d", len(apps))
+	}
+
+	if _, err := s.GetApp("app0"); err == nil {
+		t.Errorf("expected app0 deleted")
+	}
+
+}
+
+func freeAddr(t *testing.T) string {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	return ln.Addr().String()
+}
@@ -0,0 +1,571 @@
+// Package raft is the store replicated by the managers themselves with raft, so no
+// zookeeper or etcd is required.
+//
+// The writes of the store are proposed to the raft group, and applied to the memory
+// store of each manager in the same order once committed, the reads are served by
+// the local memory store, which may be a little behind the leader on a follower.
+// The manager address of the raft leader is replicated too, as the leader manager.
+package raft
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	etcdraft "github.com/coreos/etcd/raft"
+	"github.com/coreos/etcd/raft/raftpb"
+	"golang.org/x/net/context"
+
+	"github.com/Dataman-Cloud/swan/store/memory"
+)
+
+const (
+	tickInterval   = 100 * time.Millisecond
+	electionTicks  = 10
+	heartbeatTicks = 1
+
+	proposeTimeout = 10 * time.Second
+)
+
+var (
+	// applied entries between the snapshots, and the entries kept after a snapshot
+	// for the slow followers.
+	snapshotEntries uint64 = 10000
+	catchupEntries  uint64 = 5000
+
+	errStopped = errors.New("raft store stopped")
+)
+
+type Config struct {
+	ID      uint64   // 1-based index of this manager in the peers
+	Peers   []string // raft peer urls of all of the managers, eg: http://10.0.0.1:2111
+	DataDir string   // where the raft log and snapshot saved
+	Listen  string   // address of this manager, announced as the leader
+}
+
+// Leader is the leader manager.
+type Leader struct {
+	Addr string `json:"addr"`
+	Self bool   `json:"-"` // whether it's this manager
+}
+
+type RaftStore struct {
+	id  uint64
+	cfg *Config
+	db  *memory.MemoryStore
+
+	node      etcdraft.Node
+	storage   *etcdraft.MemoryStorage
+	disk      *disk
+	transport *transport
+
+	// accessed by the run loop only.
+	confState     raftpb.ConfState
+	applied       uint64
+	snapshotIndex uint64
+
+	sync.Mutex
+	seq        uint64
+	waits      map[uint64]chan error
+	leaderAddr string // the leader manager applied
+	leaderId   uint64
+	leading    bool // whether it's the raft leader
+
+	leaderCh chan struct{}
+	stopc    chan struct{}
+	donec    chan struct{}
+}
+
+// op is a write of the store proposed, the result is returned to the proposer.
+type op struct {
+	ID     uint64            `json:"id"`
+	Node   uint64            `json:"node"`
+	Method string            `json:"method"`
+	Args   []json.RawMessage `json:"args"`
+}
+
+// state is the data of the raft snapshot.
+type state struct {
+	Leader   string          `json:"leader"`
+	LeaderID uint64          `json:"leaderId"`
+	Store    json.RawMessage `json:"store"`
+}
+
+func NewRaftStore(cfg *Config) (*RaftStore, error) {
+	if cfg.ID == 0 || cfg.ID > uint64(len(cfg.Peers)) {
+		return nil, fmt.Errorf("raft id %d should be in [1, %d]", cfg.ID, len(cfg.Peers))
+	}
+
+	for _, p := range cfg.Peers {
+		if u, err := url.Parse(p); err != nil || u.Scheme != "http" || u.Host == "" {
+			return nil, fmt.Errorf("malformed raft peer %s, eg: http://10.0.0.1:2111", p)
+		}
+	}
+
+	db, err := memory.NewMemoryStore("")
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := openDisk(cfg.DataDir)
+	if err != nil {
+		return nil, err
+	}
+
+	snap, hs, ents, err := d.load()
+	if err != nil {
+		return nil, fmt.Errorf("load raft data from %s got error: %v", cfg.DataDir, err)
+	}
+
+	s := &RaftStore{
+		id:       cfg.ID,
+		cfg:      cfg,
+		db:       db,
+		storage:  etcdraft.NewMemoryStorage(),
+		disk:     d,
+		seq:      uint64(time.Now().UnixNano()),
+		waits:    make(map[uint64]chan error),
+		leaderCh: make(chan struct{}, 1),
+		stopc:    make(chan struct{}),
+		donec:    make(chan struct{}),
+	}
+
+	if !etcdraft.IsEmptySnap(snap) {
+		if err := s.restore(snap); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.storage.SetHardState(hs); err != nil {
+		return nil, err
+	}
+
+	if err := s.storage.Append(ents); err != nil {
+		return nil, err
+	}
+
+	c := &etcdraft.Config{
+		ID:              s.id,
+		ElectionTick:    electionTicks,
+		HeartbeatTick:   heartbeatTicks,
+		Storage:         s.storage,
+		Applied:         s.applied,
+		MaxSizePerMsg:   1024 * 1024,
+		MaxInflightMsgs: 256,
+		CheckQuorum:     true,
+		PreVote:         true,
+	}
+
+	if etcdraft.IsEmptySnap(snap) && etcdraft.IsEmptyHardState(hs) && len(ents) == 0 {
+		peers := make([]etcdraft.Peer, 0, len(cfg.Peers))
+		for i := range cfg.Peers {
+			peers = append(peers, etcdraft.Peer{ID: uint64(i + 1)})
+		}
+
+		log.Infof("starting raft member %d of %d peers", s.id, len(peers))
+		s.node = etcdraft.StartNode(c, peers)
+	} else {
+		log.Infof("restarting raft member %d at applied index %d", s.id, s.applied)
+		s.node = etcdraft.RestartNode(c)
+	}
+
+	s.transport, err = newTransport(s, cfg.Peers)
+	if err != nil {
+		s.node.Stop()
+		return nil, err
+	}
+
+	s.transport.start()
+
+	go s.run()
+
+	return s, nil
+}
+
+// Stop stops the raft member, the proposals pending fail.
+func (s *RaftStore) Stop() {
+	select {
+	case <-s.stopc:
+		return
+	default:
+	}
+
+	close(s.stopc)
+	<-s.donec
+
+	s.transport.stop()
+	s.node.Stop()
+	s.disk.close()
+}
+
+// LeaderChanged notifies the leader changes, the latest one is returned by Leader.
+func (s *RaftStore) LeaderChanged() <-chan struct{} {
+	return s.leaderCh
+}
+
+// Leader returns the leader manager applied. This manager is the leader only while
+// it's the raft leader as well, the leader is unknown once it stepped down until the
+// next one announced.
+func (s *RaftStore) Leader() Leader {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.leaderId == s.id {
+		if !s.leading {
+			return Leader{}
+		}
+
+		return Leader{Addr: s.leaderAddr, Self: true}
+	}
+
+	return Leader{Addr: s.leaderAddr}
+}
+
+// Elect follows the leader manager announced, the raft leader becomes the leader
+// manager. It's reported as a follower with the leader unknown once stepped down.
+// It returns only if the store stopped.
+func (s *RaftStore) Elect(fn func(leader string, self bool)) error {
+	for {
+		select {
+		case <-s.leaderCh:
+		case <-s.stopc:
+			return errStopped
+		}
+
+		l := s.Leader()
+
+		fn(l.Addr, l.Self)
+	}
+}
+
+func (s *RaftStore) run() {
+	defer close(s.donec)
+
+	ticker := time.NewTicker(tickInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.node.Tick()
+		case rd := <-s.node.Ready():
+			if err := s.ready(rd); err != nil {
+				log.Fatalf("raft store got error: %v", err)
+			}
+
+			s.node.Advance()
+		case <-s.stopc:
+			s.Lock()
+			for id, ch := range s.waits {
+				ch <- errStopped
+				delete(s.waits, id)
+			}
+			s.Unlock()
+			return
+		}
+	}
+}
+
+// ready persists the raft state, sends the messages and applies the entries committed.
+func (s *RaftStore) ready(rd etcdraft.Ready) error {
+	if !etcdraft.IsEmptySnap(rd.Snapshot) {
+		if err := s.disk.saveSnapshot(rd.Snapshot); err != nil {
+			return err
+		}
+
+		if err := s.restore(rd.Snapshot); err != nil {
+			return err
+		}
+
+		if err := s.compact(); err != nil {
+			return err
+		}
+	}
+
+	if err := s.disk.save(rd.HardState, rd.Entries); err != nil {
+		return err
+	}
+
+	if !etcdraft.IsEmptyHardState(rd.HardState) {
+		if err := s.storage.SetHardState(rd.HardState); err != nil {
+			return err
+		}
+	}
+
+	if err := s.storage.Append(rd.Entries); err != nil {
+		return err
+	}
+
+	s.transport.send(rd.Messages)
+
+	if err := s.applyEntries(rd.CommittedEntries); err != nil {
+		return err
+	}
+
+	if rd.SoftState != nil {
+		s.softState(rd.SoftState)
+	}
+
+	return s.maybeSnapshot()
+}
+
+// restore loads the snapshot into the raft storage and the memory store.
+func (s *RaftStore) restore(snap raftpb.Snapshot) error {
+	var st state
+	if err := json.Unmarshal(snap.Data, &st); err != nil {
+		return fmt.Errorf("decode raft snapshot data got error: %v", err)
+	}
+
+	if err := s.storage.ApplySnapshot(snap); err != nil {
+		return err
+	}
+
+	if err := s.db.Restore(st.Store); err != nil {
+		return err
+	}
+
+	s.setLeader(st.Leader, st.LeaderID)
+
+	s.confState = snap.Metadata.ConfState
+	s.applied = snap.Metadata.Index
+	s.snapshotIndex = snap.Metadata.Index
+
+	log.Infof("raft store restored from the snapshot at index %d", s.applied)
+
+	return nil
+}
+
+func (s *RaftStore) applyEntries(ents []raftpb.Entry) error {
+	for _, ent := range ents {
+		if ent.Index <= s.applied {
+			continue
+		}
+
+		switch ent.Type {
+		case raftpb.EntryNormal:
+			if len(ent.Data) == 0 {
+				break // the empty entry of a new leader
+			}
+
+			var o op
+			if err := json.Unmarshal(ent.Data, &o); err != nil {
+				return fmt.Errorf("decode raft entry %d got error: %v", ent.Index, err)
+			}
+
+			err := s.apply(&o)
+			if err != nil {
+				log.Debugf("apply %s of entry %d got error: %v", o.Method, ent.Index, err)
+			}
+
+			if o.Node == s.id {
+				s.trigger(o.ID, err)
+			}
+		case raftpb.EntryConfChange:
+			var cc raftpb.ConfChange
+			if err := cc.Unmarshal(ent.Data); err != nil {
+				return fmt.Errorf("decode raft conf change %d got error: %v", ent.Index, err)
+			}
+
+			s.confState = *s.node.ApplyConfChange(cc)
+		}
+
+		s.applied = ent.Index
+	}
+
+	return nil
+}
+
+// softState announces this manager as the leader once it's elected, and gives up
+// the leader manager once stepped down, eg: cut off in a minority partition.
+func (s *RaftStore) softState(st *etcdraft.SoftState) {
+	leading := st.RaftState == etcdraft.StateLeader
+
+	s.Lock()
+	if leading == s.leading {
+		s.Unlock()
+		return
+	}
+
+	s.leading = leading
+	announced := s.leaderId == s.id
+	s.Unlock()
+
+	if leading {
+		log.Infof("raft member %d became the leader", s.id)
+		go s.announce()
+		return
+	}
+
+	log.Warnf("raft member %d is not the leader any more", s.id)
+
+	if announced {
+		s.notifyLeader()
+	}
+}
+
+// announce proposes this manager as the leader until done or not leading any more.
+func (s *RaftStore) announce() {
+	for {
+		err := s.propose("SetLeader", s.cfg.Listen)
+		if err == nil || err == errStopped {
+			return
+		}
+
+		log.Errorf("announce the leader manager got error: %v", err)
+
+		if s.node.Status().Lead != s.id {
+			return
+		}
+
+		time.Sleep(time.Second)
+	}
+}
+
+func (s *RaftStore) setLeader(addr string, id uint64) {
+	s.Lock()
+	s.leaderAddr = addr
+	s.leaderId = id
+	s.Unlock()
+
+	s.notifyLeader()
+}
+
+func (s *RaftStore) notifyLeader() {
+	select {
+	case s.leaderCh <- struct{}{}:
+	default:
+	}
+}
+
+// maybeSnapshot takes a snapshot of the memory store if enough entries applied,
+// and compacts the raft log.
+func (s *RaftStore) maybeSnapshot() error {
+	if s.applied-s.snapshotIndex < snapshotEntries {
+		return nil
+	}
+
+	data, err := s.db.Snapshot()
+	if err != nil {
+		return err
+	}
+
+	s.Lock()
+	st := state{Leader: s.leaderAddr, LeaderID: s.leaderId, Store: data}
+	s.Unlock()
+
+	bs, err := json.Marshal(&st)
+	if err != nil {
+		return err
+	}
+
+	snap, err := s.storage.CreateSnapshot(s.applied, &s.confState, bs)
+	if err != nil {
+		return err
+	}
+
+	if err := s.disk.saveSnapshot(snap); err != nil {
+		return err
+	}
+
+	if s.applied > catchupEntries {
+		if err := s.storage.Compact(s.applied - catchupEntries); err != nil && err != etcdraft.ErrCompacted {
+			return err
+		}
+	}
+
+	s.snapshotIndex = s.applied
+
+	log.Infof("raft store snapshotted at index %d", s.applied)
+
+	return s.compact()
+}
+
+// compact rewrites the log on disk with the entries after the snapshot.
+func (s *RaftStore) compact() error {
+	hs, _, err := s.storage.InitialState()
+	if err != nil {
+		return err
+	}
+
+	last, err := s.storage.LastIndex()
+	if err != nil {
+		return err
+	}
+
+	var ents []raftpb.Entry
+	if last > s.snapshotIndex {
+		ents, err = s.storage.Entries(s.snapshotIndex+1, last+1, ^uint64(0))
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.disk.rewrite(hs, ents)
+}
+
+// propose proposes the write and waits for its result applied.
+func (s *RaftStore) propose(method string, args ...interface{}) error {
+	o := op{
+		Node:   s.id,
+		Method: method,
+		Args:   make([]json.RawMessage, 0, len(args)),
+	}
+
+	for _, arg := range args {
+		bs, err := json.Marshal(arg)
+		if err != nil {
+			return err
+		}
+
+		o.Args = append(o.Args, bs)
+	}
+
+	ch := make(chan error, 1)
+
+	s.Lock()
+	s.seq++
+	o.ID = s.seq
+	s.waits[o.ID] = ch
+	s.Unlock()
+
+	defer func() {
+		s.Lock()
+		delete(s.waits, o.ID)
+		s.Unlock()
+	}()
+
+	data, err := json.Marshal(&o)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), proposeTimeout)
+	defer cancel()
+
+	if err := s.node.Propose(ctx, data); err != nil {
+		return fmt.Errorf("propose %s got error: %v", method, err)
+	}
+
+	select {
+	case err := <-ch:
+		return err
+	case <-ctx.Done():
+		return fmt.Errorf("%s not committed in %s, the raft group may lose its quorum", method, proposeTimeout)
+	case <-s.stopc:
+		return errStopped
+	}
+}
+
+func (s *RaftStore) trigger(id uint64, err error) {
+	s.Lock()
+	defer s.Unlock()
+
+	if ch, ok := s.waits[id]; ok {
+		ch <- err
+		delete(s.waits, id)
+	}
+}
@@ -0,0 +1,144 @@
+package raft_test
+
+import (
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/Dataman-Cloud/swan/store"
+	"github.com/Dataman-Cloud/swan/store/raft"
+	"github.com/Dataman-Cloud/swan/store/storetest"
+)
+
+// peerURLs returns the urls of n raft peers on the free local ports.
+func peerURLs(t *testing.T, n int) []string {
+	peers := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer ln.Close()
+
+		peers = append(peers, fmt.Sprintf("http://%s", ln.Addr()))
+	}
+
+	return peers
+}
+
+// managerAddr is the manager address announced by the raft member.
+func managerAddr(id uint64) string {
+	return fmt.Sprintf("127.0.0.1:%d", 9000+id)
+}
+
+func newStore(t *testing.T, id uint64, peers []string, dataDir string) *raft.RaftStore {
+	s, err := raft.NewRaftStore(&raft.Config{
+		ID:      id,
+		Peers:   peers,
+		DataDir: dataDir,
+		Listen:  managerAddr(id),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(s.Stop)
+
+	return s
+}
+
+// newSingleStore starts a raft store of a single member, and waits for it elected.
+func newSingleStore(t *testing.T) *raft.RaftStore {
+	s := newStore(t, 1, peerURLs(t, 1), t.TempDir())
+
+	select {
+	case <-s.LeaderChanged():
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for the raft store elected")
+	}
+
+	return s
+}
+
+type election struct {
+	leader string
+	self   bool
+}
+
+// elect runs the election of the store, the results are sent to the channel.
+func elect(s *raft.RaftStore) chan election {
+	ch := make(chan election, 16)
+
+	go s.Elect(func(leader string, self bool) {
+		ch <- election{leader, self}
+	})
+
+	return ch
+}
+
+func waitElection(t *testing.T, ch chan election, expected election) {
+	timeout := time.After(10 * time.Second)
+
+	for {
+		select {
+		case got := <-ch:
+			if got == expected {
+				return
+			}
+		case <-timeout:
+			t.Fatalf("timed out waiting for the election %+v", expected)
+		}
+	}
+}
+
+func TestRaftStore(t *testing.T) {
+	storetest.Run(t, func(t *testing.T) store.Store {
+		return newSingleStore(t)
+	})
+}
+
+func TestLeaderStepDown(t *testing.T) {
+	var (
+		peers     = peerURLs(t, 3)
+		stores    = make([]*raft.RaftStore, 0, 3)
+		elections = make([]chan election, 0, 3)
+	)
+
+	for i := range peers {
+		s := newStore(t, uint64(i+1), peers, t.TempDir())
+		stores = append(stores, s)
+		elections = append(elections, elect(s))
+	}
+
+	var leader int
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		if l := stores[0].Leader(); l.Addr != "" {
+			fmt.Sscanf(l.Addr, "127.0.0.1:%d", &leader)
+			leader -= 9001
+			break
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for the leader manager")
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	waitElection(t, elections[leader], election{managerAddr(uint64(leader + 1)), true})
+
+	// the leader is cut off in a minority, it gives up the leader manager as soon
+	// as it loses the quorum.
+	for i, s := range stores {
+		if i != leader {
+			s.Stop()
+		}
+	}
+
+	waitElection(t, elections[leader], election{"", false})
+
+	if l := stores[leader].Leader(); l.Self || l.Addr != "" {
+		t.Errorf("expected the leader unknown after stepped down, got %+v", l)
+	}
+}
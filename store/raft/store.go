@@ -0,0 +1,483 @@
+package raft
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Dataman-Cloud/swan/types"
+)
+
+// the writes are proposed to the raft group.
+
+func (s *RaftStore) CreateApp(app *types.Application) error {
+	if err := s.propose("CreateApp", app); err != nil {
+		return err
+	}
+
+	// created with the revision 1 as the memory store.
+	app.Revision = 1
+
+	return nil
+}
+
+func (s *RaftStore) UpdateApp(app *types.Application) error {
+	if err := s.propose("UpdateApp", app); err != nil {
+		return err
+	}
+
+	// the revision is increased by 1 on each update as the memory store, but unknown
+	// if updated unconditionally.
+	if app.Revision != 0 {
+		app.Revision++
+	}
+
+	return nil
+}
+
+func (s *RaftStore) DeleteApp(appId string) error {
+	return s.propose("DeleteApp", appId)
+}
+
+func (s *RaftStore) CreateTask(aid string, task *types.Task) error {
+	if err := s.propose("CreateTask", aid, task); err != nil {
+		return err
+	}
+
+	task.Revision = 1
+
+	return nil
+}
+
+func (s *RaftStore) UpdateTask(aid string, task *types.Task) error {
+	if err := s.propose("UpdateTask", aid, task); err != nil {
+		return err
+	}
+
+	if task.Revision != 0 {
+		task.Revision++
+	}
+
+	return nil
+}
+
+func (s *RaftStore) DeleteTask(id string) error {
+	return s.propose("DeleteTask", id)
+}
+
+func (s *RaftStore) CreateVersion(aid string, version *types.Version) error {
+	return s.propose("CreateVersion", aid, version)
+}
+
+func (s *RaftStore) UpdateFrameworkId(frameworkId string) error {
+	return s.propose("UpdateFrameworkId", frameworkId)
+}
+
+func (s *RaftStore) CreateCompose(ins *types.Compose) error {
+	if err := s.propose("CreateCompose", ins); err != nil {
+		return err
+	}
+
+	ins.Revision = 1
+
+	return nil
+}
+
+func (s *RaftStore) DeleteCompose(idOrName string) error {
+	return s.propose("DeleteCompose", idOrName)
+}
+
+func (s *RaftStore) UpdateCompose(ins *types.Compose) error {
+	if err := s.propose("UpdateCompose", ins); err != nil {
+		return err
+	}
+
+	if ins.Revision != 0 {
+		ins.Revision++
+	}
+
+	return nil
+}
+
+func (s *RaftStore) CreateAgent(agent *types.Agent) error {
+	return s.propose("CreateAgent", agent)
+}
+
+func (s *RaftStore) UpdateAgent(agent *types.Agent) error {
+	return s.propose("UpdateAgent", agent)
+}
+
+func (s *RaftStore) CreateQuota(quota *types.Quota) error {
+	return s.propose("CreateQuota", quota)
+}
+
+func (s *RaftStore) UpdateQuota(quota *types.Quota) error {
+	return s.propose("UpdateQuota", quota)
+}
+
+func (s *RaftStore) DeleteQuota(runAs string) error {
+	return s.propose("DeleteQuota", runAs)
+}
+
+func (s *RaftStore) AddUsage(record *types.UsageRecord) error {
+	return s.propose("AddUsage", record)
+}
+
+func (s *RaftStore) PurgeUsage(before time.Time) error {
+	return s.propose("PurgeUsage", before)
+}
+
+func (s *RaftStore) CreateJob(job *types.Job) error {
+	return s.propose("CreateJob", job)
+}
+
+func (s *RaftStore) UpdateJob(job *types.Job) error {
+	return s.propose("UpdateJob", job)
+}
+
+func (s *RaftStore) DeleteJob(id string) error {
+	return s.propose("DeleteJob", id)
+}
+
+func (s *RaftStore) CreateCronJob(cronJob *types.CronJob) error {
+	return s.propose("CreateCronJob", cronJob)
+}
+
+func (s *RaftStore) UpdateCronJob(cronJob *types.CronJob) error {
+	return s.propose("UpdateCronJob", cronJob)
+}
+
+func (s *RaftStore) DeleteCronJob(id string) error {
+	return s.propose("DeleteCronJob", id)
+}
+
+func (s *RaftStore) CreateReservation(reservation *types.Reservation) error {
+	return s.propose("CreateReservation", reservation)
+}
+
+func (s *RaftStore) UpdateReservation(reservation *types.Reservation) error {
+	return s.propose("UpdateReservation", reservation)
+}
+
+func (s *RaftStore) DeleteReservation(id string) error {
+	return s.propose("DeleteReservation", id)
+}
+
+// the reads are served by the local memory store.
+
+func (s *RaftStore) GetApp(appId string) (*types.Application, error) {
+	return s.db.GetApp(appId)
+}
+
+func (s *RaftStore) ListApps() ([]*types.Application, error) {
+	return s.db.ListApps()
+}
+
+func (s *RaftStore) GetTask(aid, tid string) (*types.Task, error) {
+	return s.db.GetTask(aid, tid)
+}
+
+func (s *RaftStore) ListTasks(aid string) ([]*types.Task, error) {
+	return s.db.ListTasks(aid)
+}
+
+func (s *RaftStore) GetVersion(aid, vid string) (*types.Version, error) {
+	return s.db.GetVersion(aid, vid)
+}
+
+func (s *RaftStore) ListVersions(aid string) ([]*types.Version, error) {
+	return s.db.ListVersions(aid)
+}
+
+func (s *RaftStore) GetFrameworkId() (string, int64) {
+	return s.db.GetFrameworkId()
+}
+
+func (s *RaftStore) GetCompose(idOrName string) (*types.Compose, error) {
+	return s.db.GetCompose(idOrName)
+}
+
+func (s *RaftStore) ListComposes() ([]*types.Compose, error) {
+	return s.db.ListComposes()
+}
+
+func (s *RaftStore) GetAgent(id string) (*types.Agent, error) {
+	return s.db.GetAgent(id)
+}
+
+func (s *RaftStore) ListAgents() ([]*types.Agent, error) {
+	return s.db.ListAgents()
+}
+
+func (s *RaftStore) GetQuota(runAs string) (*types.Quota, error) {
+	return s.db.GetQuota(runAs)
+}
+
+func (s *RaftStore) ListQuotas() ([]*types.Quota, error) {
+	return s.db.ListQuotas()
+}
+
+func (s *RaftStore) ListUsage(from, to time.Time) ([]*types.UsageRecord, error) {
+	return s.db.ListUsage(from, to)
+}
+
+func (s *RaftStore) GetJob(id string) (*types.Job, error) {
+	return s.db.GetJob(id)
+}
+
+func (s *RaftStore) ListJobs() ([]*types.Job, error) {
+	return s.db.ListJobs()
+}
+
+func (s *RaftStore) GetCronJob(id string) (*types.CronJob, error) {
+	return s.db.GetCronJob(id)
+}
+
+func (s *RaftStore) ListCronJobs() ([]*types.CronJob, error) {
+	return s.db.ListCronJobs()
+}
+
+func (s *RaftStore) GetReservation(id string) (*types.Reservation, error) {
+	return s.db.GetReservation(id)
+}
+
+func (s *RaftStore) ListReservations() ([]*types.Reservation, error) {
+	return s.db.ListReservations()
+}
+
+// WatchApps watches the changes applied to the local memory store.
+func (s *RaftStore) WatchApps(stop <-chan struct{}) (<-chan string, error) {
+	return s.db.WatchApps(stop)
+}
+
+// apply applies the write committed to the memory store.
+func (s *RaftStore) apply(o *op) error {
+	switch o.Method {
+	case "SetLeader":
+		var addr string
+		if err := decodeArgs(o, &addr); err != nil {
+			return err
+		}
+
+		s.setLeader(addr, o.Node)
+
+		return nil
+	case "CreateApp":
+		app := new(types.Application)
+		if err := decodeArgs(o, app); err != nil {
+			return err
+		}
+
+		return s.db.CreateApp(app)
+	case "UpdateApp":
+		app := new(types.Application)
+		if err := decodeArgs(o, app); err != nil {
+			return err
+		}
+
+		return s.db.UpdateApp(app)
+	case "DeleteApp":
+		var appId string
+		if err := decodeArgs(o, &appId); err != nil {
+			return err
+		}
+
+		return s.db.DeleteApp(appId)
+	case "CreateTask":
+		var (
+			aid  string
+			task = new(types.Task)
+		)
+
+		if err := decodeArgs(o, &aid, task); err != nil {
+			return err
+		}
+
+		return s.db.CreateTask(aid, task)
+	case "UpdateTask":
+		var (
+			aid  string
+			task = new(types.Task)
+		)
+
+		if err := decodeArgs(o, &aid, task); err != nil {
+			return err
+		}
+
+		return s.db.UpdateTask(aid, task)
+	case "DeleteTask":
+		var id string
+		if err := decodeArgs(o, &id); err != nil {
+			return err
+		}
+
+		return s.db.DeleteTask(id)
+	case "CreateVersion":
+		var (
+			aid     string
+			version = new(types.Version)
+		)
+
+		if err := decodeArgs(o, &aid, version); err != nil {
+			return err
+		}
+
+		return s.db.CreateVersion(aid, version)
+	case "UpdateFrameworkId":
+		var frameworkId string
+		if err := decodeArgs(o, &frameworkId); err != nil {
+			return err
+		}
+
+		return s.db.UpdateFrameworkId(frameworkId)
+	case "CreateCompose":
+		ins := new(types.Compose)
+		if err := decodeArgs(o, ins); err != nil {
+			return err
+		}
+
+		return s.db.CreateCompose(ins)
+	case "DeleteCompose":
+		var idOrName string
+		if err := decodeArgs(o, &idOrName); err != nil {
+			return err
+		}
+
+		return s.db.DeleteCompose(idOrName)
+	case "UpdateCompose":
+		ins := new(types.Compose)
+		if err := decodeArgs(o, ins); err != nil {
+			return err
+		}
+
+		return s.db.UpdateCompose(ins)
+	case "CreateAgent":
+		agent := new(types.Agent)
+		if err := decodeArgs(o, agent); err != nil {
+			return err
+		}
+
+		return s.db.CreateAgent(agent)
+	case "UpdateAgent":
+		agent := new(types.Agent)
+		if err := decodeArgs(o, agent); err != nil {
+			return err
+		}
+
+		return s.db.UpdateAgent(agent)
+	case "CreateQuota":
+		quota := new(types.Quota)
+		if err := decodeArgs(o, quota); err != nil {
+			return err
+		}
+
+		return s.db.CreateQuota(quota)
+	case "UpdateQuota":
+		quota := new(types.Quota)
+		if err := decodeArgs(o, quota); err != nil {
+			return err
+		}
+
+		return s.db.UpdateQuota(quota)
+	case "DeleteQuota":
+		var runAs string
+		if err := decodeArgs(o, &runAs); err != nil {
+			return err
+		}
+
+		return s.db.DeleteQuota(runAs)
+	case "AddUsage":
+		record := new(types.UsageRecord)
+		if err := decodeArgs(o, record); err != nil {
+			return err
+		}
+
+		return s.db.AddUsage(record)
+	case "PurgeUsage":
+		var before time.Time
+		if err := decodeArgs(o, &before); err != nil {
+			return err
+		}
+
+		return s.db.PurgeUsage(before)
+	case "CreateJob":
+		job := new(types.Job)
+		if err := decodeArgs(o, job); err != nil {
+			return err
+		}
+
+		return s.db.CreateJob(job)
+	case "UpdateJob":
+		job := new(types.Job)
+		if err := decodeArgs(o, job); err != nil {
+			return err
+		}
+
+		return s.db.UpdateJob(job)
+	case "DeleteJob":
+		var id string
+		if err := decodeArgs(o, &id); err != nil {
+			return err
+		}
+
+		return s.db.DeleteJob(id)
+	case "CreateCronJob":
+		cronJob := new(types.CronJob)
+		if err := decodeArgs(o, cronJob); err != nil {
+			return err
+		}
+
+		return s.db.CreateCronJob(cronJob)
+	case "UpdateCronJob":
+		cronJob := new(types.CronJob)
+		if err := decodeArgs(o, cronJob); err != nil {
+			return err
+		}
+
+		return s.db.UpdateCronJob(cronJob)
+	case "DeleteCronJob":
+		var id string
+		if err := decodeArgs(o, &id); err != nil {
+			return err
+		}
+
+		return s.db.DeleteCronJob(id)
+	case "CreateReservation":
+		reservation := new(types.Reservation)
+		if err := decodeArgs(o, reservation); err != nil {
+			return err
+		}
+
+		return s.db.CreateReservation(reservation)
+	case "UpdateReservation":
+		reservation := new(types.Reservation)
+		if err := decodeArgs(o, reservation); err != nil {
+			return err
+		}
+
+		return s.db.UpdateReservation(reservation)
+	case "DeleteReservation":
+		var id string
+		if err := decodeArgs(o, &id); err != nil {
+			return err
+		}
+
+		return s.db.DeleteReservation(id)
+	}
+
+	return fmt.Errorf("unknown raft store method %s", o.Method)
+}
+
+func decodeArgs(o *op, ptrs ...interface{}) error {
+	if len(o.Args) != len(ptrs) {
+		return fmt.Errorf("%s requires %d args, got %d", o.Method, len(ptrs), len(o.Args))
+	}
+
+	for i, ptr := range ptrs {
+		if err := json.Unmarshal(o.Args[i], ptr); err != nil {
+			return fmt.Errorf("decode args of %s got error: %v", o.Method, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,184 @@
+package raft
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	etcdraft "github.com/coreos/etcd/raft"
+	"github.com/coreos/etcd/raft/raftpb"
+	"golang.org/x/net/context"
+)
+
+const (
+	messagePath = "/raft/message"
+
+	// messages queued for a peer, the later ones are dropped if it's full and raft
+	// retries them.
+	peerQueueSize = 4096
+)
+
+// transport sends the raft messages to the peers over http, and steps the ones
+// received into the node.
+type transport struct {
+	s     *RaftStore
+	peers map[uint64]*peer
+	ln    net.Listener
+	srv   *http.Server
+}
+
+type peer struct {
+	id     uint64
+	url    string
+	msgs   chan raftpb.Message
+	client *http.Client
+	stopc  chan struct{}
+}
+
+func newTransport(s *RaftStore, urls []string) (*transport, error) {
+	t := &transport{
+		s:     s,
+		peers: make(map[uint64]*peer),
+	}
+
+	for i, u := range urls {
+		id := uint64(i + 1)
+		if id == s.id {
+			continue
+		}
+
+		t.peers[id] = &peer{
+			id:     id,
+			url:    u + messagePath,
+			msgs:   make(chan raftpb.Message, peerQueueSize),
+			client: &http.Client{Timeout: 5 * time.Second},
+			stopc:  make(chan struct{}),
+		}
+	}
+
+	self, err := url.Parse(urls[s.id-1])
+	if err != nil {
+		return nil, err
+	}
+
+	t.ln, err = net.Listen("tcp", self.Host)
+	if err != nil {
+		return nil, fmt.Errorf("listen raft peer %s got error: %v", self.Host, err)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(messagePath, t.receive)
+
+	t.srv = &http.Server{Handler: mux}
+
+	return t, nil
+}
+
+func (t *transport) start() {
+	for _, p := range t.peers {
+		go t.run(p)
+	}
+
+	go func() {
+		if err := t.srv.Serve(t.ln); err != nil && err != http.ErrServerClosed {
+			log.Errorf("raft peer server got error: %v", err)
+		}
+	}()
+}
+
+func (t *transport) stop() {
+	for _, p := range t.peers {
+		close(p.stopc)
+	}
+
+	t.srv.Close()
+}
+
+func (t *transport) send(msgs []raftpb.Message) {
+	for _, m := range msgs {
+		p, ok := t.peers[m.To]
+		if !ok {
+			log.Warnf("raft message to unknown peer %d dropped", m.To)
+			continue
+		}
+
+		select {
+		case p.msgs <- m:
+		default:
+			t.s.node.ReportUnreachable(m.To)
+		}
+	}
+}
+
+func (t *transport) run(p *peer) {
+	for {
+		select {
+		case m := <-p.msgs:
+			err := t.post(p, m)
+			if err != nil {
+				log.Debugf("send raft message to peer %d got error: %v", p.id, err)
+				t.s.node.ReportUnreachable(p.id)
+			}
+
+			if m.Type == raftpb.MsgSnap {
+				status := etcdraft.SnapshotFinish
+				if err != nil {
+					status = etcdraft.SnapshotFailure
+				}
+				t.s.node.ReportSnapshot(p.id, status)
+			}
+		case <-p.stopc:
+			return
+		}
+	}
+}
+
+func (t *transport) post(p *peer, m raftpb.Message) error {
+	data, err := m.Marshal()
+	if err != nil {
+		return err
+	}
+
+	resp, err := p.client.Post(p.url, "application/x-protobuf", bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("peer responsed %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+func (t *transport) receive(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	data, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxRecordSize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var m raftpb.Message
+	if err := m.Unmarshal(data); err != nil {
+		http.Error(w, "decode raft message got error: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := t.s.node.Step(context.TODO(), m); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
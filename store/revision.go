@@ -0,0 +1,74 @@
+package store
+
+import (
+	"strings"
+
+	"github.com/Dataman-Cloud/swan/types"
+)
+
+// maxConflictRetries is how many times an update conflicted is retried.
+const maxConflictRetries = 10
+
+// IsConflict tells whether the update failed for the object has been changed by
+// others since got, eg: `task xxx revision 3 conflict`.
+func IsConflict(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "revision") && strings.Contains(err.Error(), "conflict")
+}
+
+// UpdateAppWith sets the app by fn and updates it. If the app has been changed by
+// others since got, fn is applied to the latest one got again and retried.
+func UpdateAppWith(db Store, app *types.Application, fn func(*types.Application)) error {
+	for i := 0; ; i++ {
+		fn(app)
+
+		err := db.UpdateApp(app)
+		if !IsConflict(err) || i >= maxConflictRetries {
+			return err
+		}
+
+		latest, err := db.GetApp(app.ID)
+		if err != nil {
+			return err
+		}
+
+		*app = *latest
+	}
+}
+
+// UpdateTaskWith sets the task by fn and updates it, retried as UpdateAppWith.
+func UpdateTaskWith(db Store, appId string, task *types.Task, fn func(*types.Task)) error {
+	for i := 0; ; i++ {
+		fn(task)
+
+		err := db.UpdateTask(appId, task)
+		if !IsConflict(err) || i >= maxConflictRetries {
+			return err
+		}
+
+		latest, err := db.GetTask(appId, task.ID)
+		if err != nil {
+			return err
+		}
+
+		*task = *latest
+	}
+}
+
+// UpdateComposeWith sets the compose by fn and updates it, retried as UpdateAppWith.
+func UpdateComposeWith(db Store, cps *types.Compose, fn func(*types.Compose)) error {
+	for i := 0; ; i++ {
+		fn(cps)
+
+		err := db.UpdateCompose(cps)
+		if !IsConflict(err) || i >= maxConflictRetries {
+			return err
+		}
+
+		latest, err := db.GetCompose(cps.ID)
+		if err != nil {
+			return err
+		}
+
+		*cps = *latest
+	}
+}
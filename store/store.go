@@ -2,9 +2,15 @@ package store
 
 import (
 	"errors"
+	"fmt"
 	"net/url"
+	"strings"
+	"time"
 
+	"github.com/Dataman-Cloud/swan/config"
 	"github.com/Dataman-Cloud/swan/store/etcd"
+	"github.com/Dataman-Cloud/swan/store/memory"
+	"github.com/Dataman-Cloud/swan/store/raft"
 	"github.com/Dataman-Cloud/swan/store/zk"
 	"github.com/Dataman-Cloud/swan/types"
 )
@@ -39,15 +45,91 @@ type Store interface {
 	UpdateAgent(agent *types.Agent) error
 	GetAgent(id string) (*types.Agent, error)
 	ListAgents() ([]*types.Agent, error)
+
+	CreateQuota(quota *types.Quota) error
+	UpdateQuota(quota *types.Quota) error
+	GetQuota(runAs string) (*types.Quota, error)
+	ListQuotas() ([]*types.Quota, error)
+	DeleteQuota(runAs string) error
+
+	AddUsage(record *types.UsageRecord) error
+	ListUsage(from, to time.Time) ([]*types.UsageRecord, error)
+	PurgeUsage(before time.Time) error // removes the buckets starting before
+
+	CreateJob(job *types.Job) error
+	UpdateJob(job *types.Job) error
+	GetJob(id string) (*types.Job, error)
+	ListJobs() ([]*types.Job, error)
+	DeleteJob(id string) error
+
+	CreateCronJob(cronJob *types.CronJob) error
+	UpdateCronJob(cronJob *types.CronJob) error
+	GetCronJob(id string) (*types.CronJob, error)
+	ListCronJobs() ([]*types.CronJob, error)
+	DeleteCronJob(id string) error
+
+	CreateReservation(reservation *types.Reservation) error
+	UpdateReservation(reservation *types.Reservation) error
+	GetReservation(id string) (*types.Reservation, error)
+	ListReservations() ([]*types.Reservation, error)
+	DeleteReservation(id string) error
+
+	// WatchApps sends the id of the app whenever the app, its tasks or versions are
+	// changed, or "" if any of the apps may have changed, eg: the watch lost and
+	// resumed. The changes pending are coalesced, the channel is closed once the
+	// stop channel closed.
+	WatchApps(stop <-chan struct{}) (<-chan string, error)
+}
+
+// all of the backends should implement the whole Store.
+var (
+	_ Store = &zk.ZKStore{}
+	_ Store = &etcd.EtcdStore{}
+	_ Store = &memory.MemoryStore{}
+	_ Store = &raft.RaftStore{}
+)
+
+func Setup(cfg *config.ManagerConfig) (Store, error) {
+	switch cfg.StoreType {
+	case "zk":
+		return zk.NewZKStore(cfg.ZKURL)
+	case "etcd":
+		return etcd.NewEtcdStore(cfg.EtcdAddrs)
+	case "memory":
+		return memory.NewMemoryStore(cfg.StoreFile)
+	case "raft":
+		return raft.NewRaftStore(&raft.Config{
+			ID:      cfg.RaftID,
+			Peers:   cfg.RaftPeers,
+			DataDir: cfg.RaftDataDir,
+			Listen:  cfg.Listen,
+		})
+	}
+
+	return nil, errors.New("unsuported db store type: " + cfg.StoreType)
 }
 
-func Setup(typ string, zkURL *url.URL, etcdAddrs []string) (Store, error) {
-	switch typ {
+// Open opens the store by the url out of the managers, eg: `zk://zk1:2181,zk2:2181/swan`,
+// `etcd://etcd1:2379,etcd2:2379` or `memory:///var/lib/swan/store.json`. The raft store
+// can't be opened as it's only served by the managers.
+func Open(addr string) (Store, error) {
+	u, err := url.Parse(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	switch u.Scheme {
 	case "zk":
-		return zk.NewZKStore(zkURL)
+		return zk.NewZKStore(u)
 	case "etcd":
-		return etcd.NewEtcdStore(etcdAddrs)
+		var addrs []string
+		for _, host := range strings.Split(u.Host, ",") {
+			addrs = append(addrs, "http://"+host)
+		}
+		return etcd.NewEtcdStore(addrs)
+	case "memory":
+		return memory.NewMemoryStore(u.Path)
 	}
 
-	return nil, errors.New("unsuported db store type: " + typ)
+	return nil, fmt.Errorf("unsuported db store url %s, eg: zk://zk1:2181/swan, etcd://etcd1:2379", addr)
 }
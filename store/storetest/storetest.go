@@ -0,0 +1,581 @@
+// Package storetest is the conformance suite of the store backends, which makes
+// sure all of them behave the same as the store.Store used by the scheduler and
+// the api. Run it in the tests of a backend with a setup returning an empty store:
+//
+//	func TestMemoryStore(t *testing.T) {
+//		storetest.Run(t, func(t *testing.T) store.Store {
+//			s, _ := memory.NewMemoryStore("")
+//			return s
+//		})
+//	}
+//
+// The zk and etcd stores should be given a clean path or prefix for each setup.
+package storetest
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dataman-Cloud/swan/store"
+	"github.com/Dataman-Cloud/swan/types"
+)
+
+// Setup returns an empty store for a test.
+type Setup func(t *testing.T) store.Store
+
+// Run runs the conformance suite on the stores returned by the setup.
+func Run(t *testing.T, setup Setup) {
+	for _, c := range []struct {
+		name string
+		fn   func(*testing.T, store.Store)
+	}{
+		{"App", testApp},
+		{"Task", testTask},
+		{"Version", testVersion},
+		{"FrameworkId", testFrameworkId},
+		{"Compose", testCompose},
+		{"Agent", testAgent},
+		{"Quota", testQuota},
+		{"Usage", testUsage},
+		{"Job", testJob},
+		{"CronJob", testCronJob},
+		{"Reservation", testReservation},
+		{"Revision", testRevision},
+		{"Watch", testWatch},
+	} {
+		fn := c.fn
+		t.Run(c.name, func(t *testing.T) {
+			fn(t, setup(t))
+		})
+	}
+}
+
+const appId = "nginx.default.bbk.dataman"
+
+// newApp creates the app with its first version, in the same order as the api.
+func newApp(t *testing.T, s store.Store) {
+	if err := s.CreateApp(&types.Application{ID: appId, Name: "nginx", RunAs: "default"}); err != nil {
+		t.Fatalf("create app got error: %v", err)
+	}
+
+	if err := s.CreateVersion(appId, &types.Version{ID: "1000", Name: "nginx"}); err != nil {
+		t.Fatalf("create version got error: %v", err)
+	}
+}
+
+func testApp(t *testing.T, s store.Store) {
+	newApp(t, s)
+
+	err := s.CreateApp(&types.Application{ID: appId})
+	expectError(t, err, "already exists", "create duplicated app")
+
+	app, err := s.GetApp(appId)
+	if err != nil {
+		t.Fatalf("get app got error: %v", err)
+	}
+
+	if app.Name != "nginx" || app.VersionCount != 1 || len(app.Version) != 1 || app.Version[0] != "1000" {
+		t.Errorf("get app got unexpected app %+v", app)
+	}
+
+	app.OpStatus = "scaling"
+	if err := s.UpdateApp(app); err != nil {
+		t.Fatalf("update app got error: %v", err)
+	}
+
+	if app, _ = s.GetApp(appId); app == nil || app.OpStatus != "scaling" {
+		t.Errorf("app not updated: %+v", app)
+	}
+
+	apps, err := s.ListApps()
+	if err != nil || len(apps) != 1 || apps[0].ID != appId {
+		t.Errorf("list apps got %v, %v", apps, err)
+	}
+
+	if err := s.DeleteApp(appId); err != nil {
+		t.Fatalf("delete app got error: %v", err)
+	}
+
+	_, err = s.GetApp(appId)
+	expectError(t, err, "", "get deleted app")
+
+	if apps, _ = s.ListApps(); len(apps) != 0 {
+		t.Errorf("deleted app listed: %v", apps)
+	}
+}
+
+func testTask(t *testing.T, s store.Store) {
+	newApp(t, s)
+
+	ids := []string{"a1b2c3d4e5f6.0." + appId, "a1b2c3d4e5f7.1." + appId}
+	for i, id := range ids {
+		task := &types.Task{ID: id, Name: id[13:], Version: "1000", Status: "TASK_STAGING", Healthy: types.TaskHealthyUnset}
+		if i == 0 {
+			task.Status = "TASK_RUNNING"
+		}
+
+		if err := s.CreateTask(appId, task); err != nil {
+			t.Fatalf("create task got error: %v", err)
+		}
+	}
+
+	task, err := s.GetTask(appId, ids[1])
+	if err != nil || task.ID != ids[1] || task.Status != "TASK_STAGING" {
+		t.Fatalf("get task got %+v, %v", task, err)
+	}
+
+	task.Status = "TASK_RUNNING"
+	if err := s.UpdateTask(appId, task); err != nil {
+		t.Fatalf("update task got error: %v", err)
+	}
+
+	if task, _ = s.GetTask(appId, ids[1]); task == nil || task.Status != "TASK_RUNNING" {
+		t.Errorf("task not updated: %+v", task)
+	}
+
+	tasks, err := s.ListTasks(appId)
+	if err != nil || len(tasks) != 2 {
+		t.Errorf("list tasks got %d tasks, %v", len(tasks), err)
+	}
+
+	app, err := s.GetApp(appId)
+	if err != nil || app.TaskCount != 2 || app.Status != "available" || app.Health.Total != 2 {
+		t.Errorf("get app got %+v, %v", app, err)
+	}
+
+	if err := s.DeleteTask(ids[0]); err != nil {
+		t.Fatalf("delete task got error: %v", err)
+	}
+
+	_, err = s.GetTask(appId, ids[0])
+	expectError(t, err, "", "get deleted task")
+
+	if tasks, _ = s.ListTasks(appId); len(tasks) != 1 {
+		t.Errorf("list tasks got %d tasks after deleted", len(tasks))
+	}
+}
+
+func testRevision(t *testing.T, s store.Store) {
+	created := &types.Application{ID: appId, Name: "nginx"}
+	if err := s.CreateApp(created); err != nil {
+		t.Fatalf("create app got error: %v", err)
+	}
+
+	app, err := s.GetApp(appId)
+	if err != nil || app.Revision == 0 || app.Revision != created.Revision {
+		t.Fatalf("get app got %+v, %v, expected the revision %d as created", app, err, created.Revision)
+	}
+
+	stale := *app
+
+	app.OpStatus = "scaling"
+	if err := s.UpdateApp(app); err != nil {
+		t.Fatalf("update app got error: %v", err)
+	}
+
+	if got, err := s.GetApp(appId); err != nil || got.Revision != app.Revision || got.Revision == stale.Revision {
+		t.Errorf("get updated app got %+v, %v, expected the revision %d", got, err, app.Revision)
+	}
+
+	stale.OpStatus = "deleting"
+	err = s.UpdateApp(&stale)
+	expectError(t, err, "conflict", "update app with the stale revision")
+	if !store.IsConflict(err) {
+		t.Errorf("update app with the stale revision got not conflicted error: %v", err)
+	}
+
+	err = store.UpdateAppWith(s, &stale, func(app *types.Application) {
+		app.Progress++
+	})
+	if err != nil || stale.OpStatus != "scaling" || stale.Progress != 1 {
+		t.Errorf("update app with retries got %+v, %v", stale, err)
+	}
+
+	id := "a1b2c3d4e5f6.0." + appId
+	newTask := &types.Task{ID: id, Status: "TASK_STAGING"}
+	if err := s.CreateTask(appId, newTask); err != nil {
+		t.Fatalf("create task got error: %v", err)
+	}
+
+	task, err := s.GetTask(appId, id)
+	if err != nil || task.Revision == 0 || task.Revision != newTask.Revision {
+		t.Fatalf("get task got %+v, %v, expected the revision %d as newTask", task, err, newTask.Revision)
+	}
+
+	other := *task
+
+	other.Weight = 50
+	if err := s.UpdateTask(appId, &other); err != nil {
+		t.Fatalf("update task got error: %v", err)
+	}
+
+	err = store.UpdateTaskWith(s, appId, task, func(task *types.Task) {
+		task.Status = "TASK_RUNNING"
+	})
+	if err != nil || task.Status != "TASK_RUNNING" || task.Weight != 50 {
+		t.Errorf("update task with retries got %+v, %v", task, err)
+	}
+
+	if tasks, _ := s.ListTasks(appId); len(tasks) != 1 || tasks[0].Revision != task.Revision {
+		t.Errorf("list tasks got unexpected revision: %+v", tasks)
+	}
+
+	cps := &types.Compose{ID: "c0ffee", Name: "wordpress"}
+	if err := s.CreateCompose(cps); err != nil {
+		t.Fatalf("create compose got error: %v", err)
+	}
+
+	rev := cps.Revision
+	if cps, err = s.GetCompose("wordpress"); err != nil || cps.Revision == 0 || cps.Revision != rev {
+		t.Fatalf("get compose got %+v, %v, expected the revision %d as created", cps, err, rev)
+	}
+
+	latest := *cps
+	if err := s.UpdateCompose(&latest); err != nil {
+		t.Fatalf("update compose got error: %v", err)
+	}
+
+	expectError(t, s.UpdateCompose(cps), "conflict", "update compose with the stale revision")
+
+	// updated unconditionally without the revision.
+	if err := s.UpdateCompose(&types.Compose{ID: "c0ffee", Name: "wordpress"}); err != nil {
+		t.Errorf("update compose without revision got error: %v", err)
+	}
+}
+
+func testWatch(t *testing.T, s store.Store) {
+	stop := make(chan struct{})
+	defer close(stop)
+
+	changes, err := s.WatchApps(stop)
+	if err != nil {
+		t.Fatalf("watch apps got error: %v", err)
+	}
+
+	// the changes may be coalesced, or sent as all of the apps.
+	expectChanged := func(what string) {
+		timeout := time.After(5 * time.Second)
+		for {
+			select {
+			case id := <-changes:
+				if id == appId || id == "" {
+					return
+				}
+			case <-timeout:
+				t.Fatalf("%s: no change of app %s watched", what, appId)
+			}
+		}
+	}
+
+	newApp(t, s)
+	expectChanged("create app")
+
+	id := "a1b2c3d4e5f6.0." + appId
+	if err := s.CreateTask(appId, &types.Task{ID: id, Status: "TASK_STAGING"}); err != nil {
+		t.Fatalf("create task got error: %v", err)
+	}
+	expectChanged("create task")
+
+	if err := s.UpdateTask(appId, &types.Task{ID: id, Status: "TASK_RUNNING"}); err != nil {
+		t.Fatalf("update task got error: %v", err)
+	}
+	expectChanged("update task")
+
+	if err := s.DeleteApp(appId); err != nil {
+		t.Fatalf("delete app got error: %v", err)
+	}
+	expectChanged("delete app")
+}
+
+func testVersion(t *testing.T, s store.Store) {
+	newApp(t, s)
+
+	if err := s.CreateVersion(appId, &types.Version{ID: "2000", Name: "nginx", CPUs: 0.5}); err != nil {
+		t.Fatalf("create version got error: %v", err)
+	}
+
+	ver, err := s.GetVersion(appId, "2000")
+	if err != nil || ver.ID != "2000" || ver.CPUs != 0.5 {
+		t.Errorf("get version got %+v, %v", ver, err)
+	}
+
+	_, err = s.GetVersion(appId, "3000")
+	expectError(t, err, "", "get missing version")
+
+	vers, err := s.ListVersions(appId)
+	if err != nil || len(vers) != 2 {
+		t.Errorf("list versions got %d versions, %v", len(vers), err)
+	}
+}
+
+func testFrameworkId(t *testing.T, s store.Store) {
+	if id, _ := s.GetFrameworkId(); id != "" {
+		t.Errorf("framework id %s of the empty store", id)
+	}
+
+	for _, want := range []string{"a3c9-0000", "a3c9-0001"} {
+		if err := s.UpdateFrameworkId(want); err != nil {
+			t.Fatalf("update framework id got error: %v", err)
+		}
+
+		id, mtime := s.GetFrameworkId()
+		if id != want || mtime <= 0 {
+			t.Errorf("get framework id got %s, %d", id, mtime)
+		}
+	}
+}
+
+func testCompose(t *testing.T, s store.Store) {
+	cps := &types.Compose{ID: "c0ffee", Name: "wordpress", Status: "creating"}
+	if err := s.CreateCompose(cps); err != nil {
+		t.Fatalf("create compose got error: %v", err)
+	}
+
+	for _, idOrName := range []string{"c0ffee", "wordpress"} {
+		got, err := s.GetCompose(idOrName)
+		if err != nil || got.ID != "c0ffee" {
+			t.Errorf("get compose %s got %+v, %v", idOrName, got, err)
+		}
+	}
+
+	cps.Status = "ready"
+	if err := s.UpdateCompose(cps); err != nil {
+		t.Fatalf("update compose got error: %v", err)
+	}
+
+	if got, _ := s.GetCompose("c0ffee"); got == nil || got.Status != "ready" {
+		t.Errorf("compose not updated: %+v", got)
+	}
+
+	err := s.UpdateCompose(&types.Compose{ID: "deadbeef"})
+	expectError(t, err, "", "update missing compose")
+
+	if cpss, err := s.ListComposes(); err != nil || len(cpss) != 1 {
+		t.Errorf("list composes got %v, %v", cpss, err)
+	}
+
+	if err := s.DeleteCompose("wordpress"); err != nil {
+		t.Fatalf("delete compose got error: %v", err)
+	}
+
+	_, err = s.GetCompose("c0ffee")
+	expectError(t, err, "", "get deleted compose")
+}
+
+func testAgent(t *testing.T, s store.Store) {
+	agent := &types.Agent{ID: "10.0.0.1", CreatedAt: time.Now()}
+	if err := s.CreateAgent(agent); err != nil {
+		t.Fatalf("create agent got error: %v", err)
+	}
+
+	got, err := s.GetAgent(agent.ID)
+	if err != nil || got.ID != agent.ID {
+		t.Errorf("get agent got %+v, %v", got, err)
+	}
+
+	active := time.Now().Add(time.Minute).Truncate(time.Second)
+
+	agent.LastActive = active
+	if err := s.UpdateAgent(agent); err != nil {
+		t.Fatalf("update agent got error: %v", err)
+	}
+
+	if got, _ = s.GetAgent(agent.ID); got == nil || !got.LastActive.Equal(active) {
+		t.Errorf("agent not updated: %+v", got)
+	}
+
+	err = s.UpdateAgent(&types.Agent{ID: "10.0.0.2"})
+	expectError(t, err, "", "update missing agent")
+
+	_, err = s.GetAgent("10.0.0.2")
+	expectError(t, err, "", "get missing agent")
+
+	if agents, err := s.ListAgents(); err != nil || len(agents) != 1 {
+		t.Errorf("list agents got %v, %v", agents, err)
+	}
+}
+
+func testQuota(t *testing.T, s store.Store) {
+	testCRUD(t, crud{
+		create: func(id string, v float64) error { return s.CreateQuota(&types.Quota{RunAs: id, CPUs: v}) },
+		update: func(id string, v float64) error { return s.UpdateQuota(&types.Quota{RunAs: id, CPUs: v}) },
+		get: func(id string) (float64, error) {
+			q, err := s.GetQuota(id)
+			if err != nil {
+				return 0, err
+			}
+			return q.CPUs, nil
+		},
+		list: func() (int, error) {
+			qs, err := s.ListQuotas()
+			return len(qs), err
+		},
+		del: s.DeleteQuota,
+	})
+
+	expectError(t, s.DeleteQuota("baz"), "not exists", "delete missing quota")
+}
+
+func testJob(t *testing.T, s store.Store) {
+	testCRUD(t, crud{
+		create: func(id string, v float64) error { return s.CreateJob(&types.Job{ID: id, Retries: int(v)}) },
+		update: func(id string, v float64) error { return s.UpdateJob(&types.Job{ID: id, Retries: int(v)}) },
+		get: func(id string) (float64, error) {
+			j, err := s.GetJob(id)
+			if err != nil {
+				return 0, err
+			}
+			return float64(j.Retries), nil
+		},
+		list: func() (int, error) {
+			js, err := s.ListJobs()
+			return len(js), err
+		},
+		del: s.DeleteJob,
+	})
+}
+
+func testCronJob(t *testing.T, s store.Store) {
+	testCRUD(t, crud{
+		create: func(id string, v float64) error {
+			return s.CreateCronJob(&types.CronJob{ID: id, HistoryLimit: int(v)})
+		},
+		update: func(id string, v float64) error {
+			return s.UpdateCronJob(&types.CronJob{ID: id, HistoryLimit: int(v)})
+		},
+		get: func(id string) (float64, error) {
+			j, err := s.GetCronJob(id)
+			if err != nil {
+				return 0, err
+			}
+			return float64(j.HistoryLimit), nil
+		},
+		list: func() (int, error) {
+			js, err := s.ListCronJobs()
+			return len(js), err
+		},
+		del: s.DeleteCronJob,
+	})
+}
+
+func testReservation(t *testing.T, s store.Store) {
+	testCRUD(t, crud{
+		create: func(id string, v float64) error {
+			return s.CreateReservation(&types.Reservation{ID: id, CPUs: v})
+		},
+		update: func(id string, v float64) error {
+			return s.UpdateReservation(&types.Reservation{ID: id, CPUs: v})
+		},
+		get: func(id string) (float64, error) {
+			r, err := s.GetReservation(id)
+			if err != nil {
+				return 0, err
+			}
+			return r.CPUs, nil
+		},
+		list: func() (int, error) {
+			rs, err := s.ListReservations()
+			return len(rs), err
+		},
+		del: s.DeleteReservation,
+	})
+}
+
+// crud is the operations of a kind of object identified by id, which carries a
+// value to check the updates.
+type crud struct {
+	create func(id string, v float64) error
+	update func(id string, v float64) error
+	get    func(id string) (float64, error)
+	list   func() (int, error)
+	del    func(id string) error
+}
+
+func testCRUD(t *testing.T, c crud) {
+	for _, id := range []string{"foo", "bar"} {
+		if err := c.create(id, 1); err != nil {
+			t.Fatalf("create %s got error: %v", id, err)
+		}
+	}
+
+	expectError(t, c.create("foo", 2), "already exists", "create duplicated")
+
+	if v, err := c.get("foo"); err != nil || v != 1 {
+		t.Errorf("get foo got %v, %v", v, err)
+	}
+
+	if err := c.update("foo", 3); err != nil {
+		t.Fatalf("update foo got error: %v", err)
+	}
+
+	if v, _ := c.get("foo"); v != 3 {
+		t.Errorf("foo not updated: %v", v)
+	}
+
+	expectError(t, c.update("baz", 1), "not exists", "update missing")
+
+	_, err := c.get("baz")
+	expectError(t, err, "not exists", "get missing")
+
+	if n, err := c.list(); err != nil || n != 2 {
+		t.Errorf("list got %d, %v", n, err)
+	}
+
+	if err := c.del("foo"); err != nil {
+		t.Fatalf("delete foo got error: %v", err)
+	}
+
+	_, err = c.get("foo")
+	expectError(t, err, "not exists", "get deleted")
+
+	if n, _ := c.list(); n != 1 {
+		t.Errorf("list got %d after deleted", n)
+	}
+}
+
+func testUsage(t *testing.T, s store.Store) {
+	bucket := time.Date(2017, 6, 1, 10, 0, 0, 0, time.UTC)
+
+	for _, r := range []*types.UsageRecord{
+		{Bucket: bucket, AppID: appId, RunAs: "default", CPUSeconds: 10},
+		{Bucket: bucket, AppID: appId, RunAs: "default", CPUSeconds: 5},
+		{Bucket: bucket.Add(time.Hour), AppID: appId, RunAs: "default", CPUSeconds: 1},
+	} {
+		if err := s.AddUsage(r); err != nil {
+			t.Fatalf("add usage got error: %v", err)
+		}
+	}
+
+	records, err := s.ListUsage(bucket, bucket.Add(time.Hour))
+	if err != nil || len(records) != 1 || records[0].CPUSeconds != 15 {
+		t.Errorf("list usage got %v, %v", records, err)
+	}
+
+	if records, _ = s.ListUsage(bucket, bucket.Add(2*time.Hour)); len(records) != 2 {
+		t.Errorf("list usage got %d records", len(records))
+	}
+
+	if err := s.PurgeUsage(bucket.Add(time.Hour)); err != nil {
+		t.Fatalf("purge usage got error: %v", err)
+	}
+
+	records, err = s.ListUsage(bucket, bucket.Add(2*time.Hour))
+	if err != nil || len(records) != 1 || !records[0].Bucket.Equal(bucket.Add(time.Hour)) {
+		t.Errorf("list usage got %v, %v after purged", records, err)
+	}
+}
+
+// expectError checks the error contains the substr, any error is ok if it's empty.
+func expectError(t *testing.T, err error, substr, what string) {
+	t.Helper()
+
+	if err == nil {
+		t.Errorf("%s: expected error, got nil", what)
+		return
+	}
+
+	if !strings.Contains(err.Error(), substr) {
+		t.Errorf("%s: expected error contains %q, got %v", what, substr, err)
+	}
+}
@@ -0,0 +1,140 @@
+// Package watch delivers the changes of the apps in the stores to the watchers,
+// the changes pending are coalesced so the writes of the stores never block.
+package watch
+
+import (
+	"path"
+	"strings"
+	"sync"
+)
+
+// All is sent if any of the apps may have changed, eg: the watch lost and resumed.
+const All = ""
+
+// Watcher sends the id of the apps changed to its channel until stopped.
+type Watcher struct {
+	sync.Mutex
+	pending map[string]bool
+	signal  chan struct{}
+	out     chan string
+	stop    <-chan struct{}
+}
+
+// New creates the watcher, which is stopped once the stop channel closed.
+func New(stop <-chan struct{}) *Watcher {
+	w := &Watcher{
+		pending: make(map[string]bool),
+		signal:  make(chan struct{}, 1),
+		out:     make(chan string),
+		stop:    stop,
+	}
+
+	go w.run()
+
+	return w
+}
+
+// C returns the channel the changes sent to, closed once stopped.
+func (w *Watcher) C() <-chan string {
+	return w.out
+}
+
+// Stopped returns the channel closed once the watcher stopped.
+func (w *Watcher) Stopped() <-chan struct{} {
+	return w.stop
+}
+
+// Changed marks the app changed, it never blocks.
+func (w *Watcher) Changed(id string) {
+	w.Lock()
+	w.pending[id] = true
+	w.Unlock()
+
+	select {
+	case w.signal <- struct{}{}:
+	default:
+	}
+}
+
+func (w *Watcher) run() {
+	defer close(w.out)
+
+	for {
+		select {
+		case <-w.signal:
+		case <-w.stop:
+			return
+		}
+
+		w.Lock()
+		pending := w.pending
+		w.pending = make(map[string]bool)
+		w.Unlock()
+
+		ids := make([]string, 0, len(pending))
+		if pending[All] {
+			ids = append(ids, All)
+		} else {
+			for id := range pending {
+				ids = append(ids, id)
+			}
+		}
+
+		for _, id := range ids {
+			select {
+			case w.out <- id:
+			case <-w.stop:
+				return
+			}
+		}
+	}
+}
+
+// Hub fans out the changes to all of the watchers.
+type Hub struct {
+	sync.Mutex
+	watchers map[*Watcher]bool
+}
+
+// Watch adds a watcher to the hub, which is removed once stopped.
+func (h *Hub) Watch(stop <-chan struct{}) <-chan string {
+	w := New(stop)
+
+	h.Lock()
+	if h.watchers == nil {
+		h.watchers = make(map[*Watcher]bool)
+	}
+	h.watchers[w] = true
+	h.Unlock()
+
+	go func() {
+		<-stop
+
+		h.Lock()
+		delete(h.watchers, w)
+		h.Unlock()
+	}()
+
+	return w.C()
+}
+
+// Changed marks the app changed for all of the watchers.
+func (h *Hub) Changed(id string) {
+	h.Lock()
+	defer h.Unlock()
+
+	for w := range h.watchers {
+		w.Changed(id)
+	}
+}
+
+// AppID returns the id of the app the key belongs to, eg: `nginx.default.bbk.dataman`
+// of `/apps/nginx.default.bbk.dataman/tasks/xxx`. The root is the key of the apps.
+func AppID(root, key string) (string, bool) {
+	rel := strings.TrimPrefix(path.Clean(key), path.Clean(root)+"/")
+	if rel == path.Clean(key) || rel == "" {
+		return "", false
+	}
+
+	return strings.SplitN(rel, "/", 2)[0], true
+}
@@ -27,7 +27,7 @@ func (zk *ZKStore) UpdateAgent(agent *types.Agent) error {
 	}
 
 	path := keyAgent + "/" + agent.ID
-	return zk.create(path, bs)
+	return zk.set(path, bs)
 }
 
 func (zk *ZKStore) GetAgent(id string) (*types.Agent, error) {
@@ -30,7 +30,21 @@ func (zk *ZKStore) CreateApp(app *types.Application) error {
 		return err
 	}
 
-	return zk.createAll(p, bs)
+	if err := zk.createAll(p, bs); err != nil {
+		return err
+	}
+
+	// the app without any task yet is still listable.
+	for _, sub := range []string{"tasks", "versions"} {
+		if err := zk.create(path.Join(p, sub), nil); err != nil {
+			return err
+		}
+	}
+
+	// the version of the node created is 0, see revision.
+	app.Revision = 1
+
+	return nil
 }
 
 // All of AppHolder Write Ops Requires Transaction Lock
@@ -40,15 +54,26 @@ func (zk *ZKStore) UpdateApp(app *types.Application) error {
 		return err
 	}
 
-	path := path.Join(keyApp, app.ID)
+	rev, err := zk.cas(path.Join(keyApp, app.ID), app.Revision, bs)
+	if err != nil {
+		if err == errNotExists {
+			return fmt.Errorf("app %s not exists", app.ID)
+		}
+		if err == errConflict {
+			return fmt.Errorf("app %s revision %d conflict", app.ID, app.Revision)
+		}
+		return err
+	}
 
-	return zk.set(path, bs)
+	app.Revision = rev
+
+	return nil
 }
 
 func (zk *ZKStore) GetApp(id string) (*types.Application, error) {
 	p := path.Join(keyApp, id)
 
-	data, _, err := zk.get(p)
+	data, stat, err := zk.get(p)
 	if err != nil {
 		log.Errorf("find app %s got error: %v", id, err)
 		return nil, fmt.Errorf("app %s not exists", id)
@@ -59,6 +84,8 @@ func (zk *ZKStore) GetApp(id string) (*types.Application, error) {
 		return nil, err
 	}
 
+	app.Revision = revision(stat)
+
 	tasks, err := zk.tasks(p, id)
 	if err != nil {
 		log.Errorf("get app %s tasks got error: %v", id, err)
@@ -76,7 +103,7 @@ func (zk *ZKStore) GetApp(id string) (*types.Application, error) {
 		return nil, err
 	}
 
-	if len(app.Version) == 0 {
+	if len(app.Version) == 0 && len(versions) > 0 {
 		types.VersionList(versions).Reverse()
 		app.Version = append(app.Version, versions[0].ID)
 	}
@@ -2,6 +2,7 @@ package zk
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/Dataman-Cloud/swan/types"
 
@@ -15,7 +16,13 @@ func (zk *ZKStore) CreateCompose(cps *types.Compose) error {
 	}
 
 	path := keyCompose + "/" + cps.ID
-	return zk.createAll(path, bs)
+	if err := zk.createAll(path, bs); err != nil {
+		return err
+	}
+
+	cps.Revision = 1
+
+	return nil
 }
 
 func (zk *ZKStore) UpdateCompose(cps *types.Compose) error {
@@ -28,19 +35,29 @@ func (zk *ZKStore) UpdateCompose(cps *types.Compose) error {
 		return err
 	}
 
-	path := keyCompose + "/" + cps.ID
-	return zk.create(path, bs)
+	rev, err := zk.cas(keyCompose+"/"+cps.ID, cps.Revision, bs)
+	if err != nil {
+		if err == errConflict {
+			return fmt.Errorf("compose %s revision %d conflict", cps.ID, cps.Revision)
+		}
+		return err
+	}
+
+	cps.Revision = rev
+
+	return nil
 }
 
 func (zk *ZKStore) GetCompose(idOrName string) (*types.Compose, error) {
 	// by id
-	bs, _, err := zk.get(keyCompose + "/" + idOrName)
+	bs, stat, err := zk.get(keyCompose + "/" + idOrName)
 	if err == nil {
 		cps := new(types.Compose)
 		if err := decode(bs, &cps); err != nil {
 			log.Errorln("zk GetCompose.decode error:", err)
 			return nil, err
 		}
+		cps.Revision = revision(stat)
 		return cps, nil
 	}
 
@@ -68,7 +85,7 @@ func (zk *ZKStore) ListComposes() ([]*types.Compose, error) {
 	}
 
 	for _, node := range nodes {
-		bs, _, err := zk.get(keyCompose + "/" + node)
+		bs, stat, err := zk.get(keyCompose + "/" + node)
 		if err != nil {
 			log.Errorln("zk ListCompose.getnode error:", err)
 			continue
@@ -80,6 +97,8 @@ func (zk *ZKStore) ListComposes() ([]*types.Compose, error) {
 			continue
 		}
 
+		cps.Revision = revision(stat)
+
 		ret = append(ret, cps)
 	}
 
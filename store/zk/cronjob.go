@@ -0,0 +1,93 @@
+package zk
+
+import (
+	"fmt"
+
+	"github.com/Dataman-Cloud/swan/types"
+
+	log "github.com/Sirupsen/logrus"
+)
+
+func (zk *ZKStore) CreateCronJob(cronJob *types.CronJob) error {
+	p := keyCronJob + "/" + cronJob.ID
+
+	exist, err := zk.exist(p)
+	if err != nil {
+		return err
+	}
+
+	if exist {
+		return errCronJobAlreadyExists
+	}
+
+	bs, err := encode(cronJob)
+	if err != nil {
+		return err
+	}
+
+	return zk.createAll(p, bs)
+}
+
+func (zk *ZKStore) UpdateCronJob(cronJob *types.CronJob) error {
+	if _, err := zk.GetCronJob(cronJob.ID); err != nil {
+		return err
+	}
+
+	bs, err := encode(cronJob)
+	if err != nil {
+		return err
+	}
+
+	return zk.set(keyCronJob+"/"+cronJob.ID, bs)
+}
+
+func (zk *ZKStore) GetCronJob(id string) (*types.CronJob, error) {
+	bs, _, err := zk.get(keyCronJob + "/" + id)
+	if err != nil {
+		if err == errNotExists {
+			return nil, fmt.Errorf("cron job %s not exists", id)
+		}
+
+		return nil, err
+	}
+
+	j := new(types.CronJob)
+	if err := decode(bs, &j); err != nil {
+		log.Errorln("zk GetCronJob.decode error:", err)
+		return nil, err
+	}
+
+	return j, nil
+}
+
+func (zk *ZKStore) ListCronJobs() ([]*types.CronJob, error) {
+	ret := make([]*types.CronJob, 0, 0)
+
+	nodes, err := zk.list(keyCronJob)
+	if err != nil {
+		log.Errorln("zk ListCronJobs error:", err)
+		return ret, err
+	}
+
+	for _, node := range nodes {
+		bs, _, err := zk.get(keyCronJob + "/" + node)
+		if err != nil {
+			log.Errorln("zk ListCronJobs.getnode error:", err)
+			continue
+		}
+
+		j := new(types.CronJob)
+		if err := decode(bs, &j); err != nil {
+			log.Errorln("zk ListCronJobs.decode error:", err)
+			continue
+		}
+
+		ret = append(ret, j)
+	}
+
+	return ret, nil
+}
+
+func (zk *ZKStore) DeleteCronJob(id string) error {
+	return zk.del(keyCronJob + "/" + id)
+}
@@ -3,6 +3,15 @@ package zk
 import log "github.com/Sirupsen/logrus"
 
 func (zk *ZKStore) UpdateFrameworkId(id string) error {
+	exist, err := zk.exist(keyFrameworkID)
+	if err != nil {
+		return err
+	}
+
+	if !exist {
+		return zk.createAll(keyFrameworkID, []byte(id))
+	}
+
 	return zk.set(keyFrameworkID, []byte(id))
 }
 
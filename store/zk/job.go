@@ -0,0 +1,93 @@
+package zk
+
+import (
+	"fmt"
+
+	"github.com/Dataman-Cloud/swan/types"
+
+	log "github.com/Sirupsen/logrus"
+)
+
+func (zk *ZKStore) CreateJob(job *types.Job) error {
+	p := keyJob + "/" + job.ID
+
+	exist, err := zk.exist(p)
+	if err != nil {
+		return err
+	}
+
+	if exist {
+		return errJobAlreadyExists
+	}
+
+	bs, err := encode(job)
+	if err != nil {
+		return err
+	}
+
+	return zk.createAll(p, bs)
+}
+
+func (zk *ZKStore) UpdateJob(job *types.Job) error {
+	if _, err := zk.GetJob(job.ID); err != nil {
+		return err
+	}
+
+	bs, err := encode(job)
+	if err != nil {
+		return err
+	}
+
+	return zk.set(keyJob+"/"+job.ID, bs)
+}
+
+func (zk *ZKStore) GetJob(id string) (*types.Job, error) {
+	bs, _, err := zk.get(keyJob + "/" + id)
+	if err != nil {
+		if err == errNotExists {
+			return nil, fmt.Errorf("job %s not exists", id)
+		}
+
+		return nil, err
+	}
+
+	j := new(types.Job)
+	if err := decode(bs, &j); err != nil {
+		log.Errorln("zk GetJob.decode error:", err)
+		return nil, err
+	}
+
+	return j, nil
+}
+
+func (zk *ZKStore) ListJobs() ([]*types.Job, error) {
+	ret := make([]*types.Job, 0, 0)
+
+	nodes, err := zk.list(keyJob)
+	if err != nil {
+		log.Errorln("zk ListJobs error:", err)
+		return ret, err
+	}
+
+	for _, node := range nodes {
+		bs, _, err := zk.get(keyJob + "/" + node)
+		if err != nil {
+			log.Errorln("zk ListJobs.getnode error:", err)
+			continue
+		}
+
+		j := new(types.Job)
+		if err := decode(bs, &j); err != nil {
+			log.Errorln("zk ListJobs.decode error:", err)
+			continue
+		}
+
+		ret = append(ret, j)
+	}
+
+	return ret, nil
+}
+
+func (zk *ZKStore) DeleteJob(id string) error {
+	return zk.del(keyJob + "/" + id)
+}
@@ -0,0 +1,97 @@
+package zk
+
+import (
+	"fmt"
+
+	"github.com/Dataman-Cloud/swan/types"
+
+	log "github.com/Sirupsen/logrus"
+)
+
+func (zk *ZKStore) CreateQuota(quota *types.Quota) error {
+	p := keyQuota + "/" + quota.RunAs
+
+	exist, err := zk.exist(p)
+	if err != nil {
+		return err
+	}
+
+	if exist {
+		return errQuotaAlreadyExists
+	}
+
+	bs, err := encode(quota)
+	if err != nil {
+		return err
+	}
+
+	return zk.createAll(p, bs)
+}
+
+func (zk *ZKStore) UpdateQuota(quota *types.Quota) error {
+	if _, err := zk.GetQuota(quota.RunAs); err != nil {
+		return err
+	}
+
+	bs, err := encode(quota)
+	if err != nil {
+		return err
+	}
+
+	return zk.set(keyQuota+"/"+quota.RunAs, bs)
+}
+
+func (zk *ZKStore) GetQuota(runAs string) (*types.Quota, error) {
+	bs, _, err := zk.get(keyQuota + "/" + runAs)
+	if err != nil {
+		if err == errNotExists {
+			return nil, fmt.Errorf("quota %s not exists", runAs)
+		}
+
+		return nil, err
+	}
+
+	q := new(types.Quota)
+	if err := decode(bs, &q); err != nil {
+		log.Errorln("zk GetQuota.decode error:", err)
+		return nil, err
+	}
+
+	return q, nil
+}
+
+func (zk *ZKStore) ListQuotas() ([]*types.Quota, error) {
+	ret := make([]*types.Quota, 0, 0)
+
+	nodes, err := zk.list(keyQuota)
+	if err != nil {
+		log.Errorln("zk ListQuotas error:", err)
+		return ret, err
+	}
+
+	for _, node := range nodes {
+		bs, _, err := zk.get(keyQuota + "/" + node)
+		if err != nil {
+			log.Errorln("zk ListQuotas.getnode error:", err)
+			continue
+		}
+
+		q := new(types.Quota)
+		if err := decode(bs, &q); err != nil {
+			log.Errorln("zk ListQuotas.decode error:", err)
+			continue
+		}
+
+		ret = append(ret, q)
+	}
+
+	return ret, nil
+}
+
+func (zk *ZKStore) DeleteQuota(runAs string) error {
+	if _, err := zk.GetQuota(runAs); err != nil {
+		return err
+	}
+
+	return zk.del(keyQuota + "/" + runAs)
+}
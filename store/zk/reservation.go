@@ -0,0 +1,93 @@
+package zk
+
+import (
+	"fmt"
+
+	"github.com/Dataman-Cloud/swan/types"
+
+	log "github.com/Sirupsen/logrus"
+)
+
+func (zk *ZKStore) CreateReservation(reservation *types.Reservation) error {
+	p := keyReservation + "/" + reservation.ID
+
+	exist, err := zk.exist(p)
+	if err != nil {
+		return err
+	}
+
+	if exist {
+		return errReservationAlreadyExists
+	}
+
+	bs, err := encode(reservation)
+	if err != nil {
+		return err
+	}
+
+	return zk.createAll(p, bs)
+}
+
+func (zk *ZKStore) UpdateReservation(reservation *types.Reservation) error {
+	if _, err := zk.GetReservation(reservation.ID); err != nil {
+		return err
+	}
+
+	bs, err := encode(reservation)
+	if err != nil {
+		return err
+	}
+
+	return zk.set(keyReservation+"/"+reservation.ID, bs)
+}
+
+func (zk *ZKStore) GetReservation(id string) (*types.Reservation, error) {
+	bs, _, err := zk.get(keyReservation + "/" + id)
+	if err != nil {
+		if err == errNotExists {
+			return nil, fmt.Errorf("reservation %s not exists", id)
+		}
+
+		return nil, err
+	}
+
+	j := new(types.Reservation)
+	if err := decode(bs, &j); err != nil {
+		log.Errorln("zk GetReservation.decode error:", err)
+		return nil, err
+	}
+
+	return j, nil
+}
+
+func (zk *ZKStore) ListReservations() ([]*types.Reservation, error) {
+	ret := make([]*types.Reservation, 0, 0)
+
+	nodes, err := zk.list(keyReservation)
+	if err != nil {
+		log.Errorln("zk ListReservations error:", err)
+		return ret, err
+	}
+
+	for _, node := range nodes {
+		bs, _, err := zk.get(keyReservation + "/" + node)
+		if err != nil {
+			log.Errorln("zk ListReservations.getnode error:", err)
+			continue
+		}
+
+		j := new(types.Reservation)
+		if err := decode(bs, &j); err != nil {
+			log.Errorln("zk ListReservations.decode error:", err)
+			continue
+		}
+
+		ret = append(ret, j)
+	}
+
+	return ret, nil
+}
+
+func (zk *ZKStore) DeleteReservation(id string) error {
+	return zk.del(keyReservation + "/" + id)
+}
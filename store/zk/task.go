@@ -18,7 +18,13 @@ func (zk *ZKStore) CreateTask(aid string, task *types.Task) error {
 
 	p := path.Join(keyApp, aid, "tasks", task.ID)
 
-	return zk.createAll(p, bs)
+	if err := zk.createAll(p, bs); err != nil {
+		return err
+	}
+
+	task.Revision = 1
+
+	return nil
 }
 
 func (zk *ZKStore) UpdateTask(aid string, task *types.Task) error {
@@ -27,12 +33,19 @@ func (zk *ZKStore) UpdateTask(aid string, task *types.Task) error {
 		return err
 	}
 
-	p := path.Join(keyApp, aid, "tasks", task.ID)
+	rev, err := zk.cas(path.Join(keyApp, aid, "tasks", task.ID), task.Revision, bs)
+	if err != nil {
+		if err == errNotExists {
+			return fmt.Errorf("task %s not exists", task.ID)
+		}
+		if err == errConflict {
+			return fmt.Errorf("task %s revision %d conflict", task.ID, task.Revision)
+		}
+		return err
+	}
 
-	return zk.set(p, bs)
-}
+	task.Revision = rev
 
-func (zk *ZKStore) ListTaskHistory(aid, sid string) []*types.Task {
 	return nil
 }
 
@@ -48,7 +61,7 @@ func (zk *ZKStore) ListTasks(id string) ([]*types.Task, error) {
 	tasks := make([]*types.Task, 0)
 	for _, child := range children {
 		p := path.Join(keyApp, id, "tasks", child)
-		data, _, err := zk.get(p)
+		data, stat, err := zk.get(p)
 		if err != nil {
 			log.Errorf("get %s got error: %v", p, err)
 			return nil, err
@@ -60,6 +73,8 @@ func (zk *ZKStore) ListTasks(id string) ([]*types.Task, error) {
 			return nil, err
 		}
 
+		t.Revision = revision(stat)
+
 		tasks = append(tasks, t)
 	}
 
@@ -75,7 +90,7 @@ func (zk *ZKStore) DeleteTask(id string) error {
 func (zk *ZKStore) GetTask(aid, tid string) (*types.Task, error) {
 	p := path.Join(keyApp, aid, "tasks", tid)
 
-	data, _, err := zk.get(p)
+	data, stat, err := zk.get(p)
 	if err != nil {
 		if err == errNotExists {
 			return nil, fmt.Errorf("task %s not exist", tid)
@@ -89,6 +104,8 @@ func (zk *ZKStore) GetTask(aid, tid string) (*types.Task, error) {
 		return nil, err
 	}
 
+	task.Revision = revision(stat)
+
 	return &task, nil
 }
 
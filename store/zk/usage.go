@@ -0,0 +1,138 @@
+package zk
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Dataman-Cloud/swan/types"
+
+	log "github.com/Sirupsen/logrus"
+)
+
+// maxUsageRetries is how many times the usage added is retried if conflicted.
+const maxUsageRetries = 10
+
+// AddUsage adds the record to the one of its bucket and app, the read-modify-write
+// is retried if the record is changed by others since read.
+func (zk *ZKStore) AddUsage(record *types.UsageRecord) error {
+	p := keyUsage + "/" + record.BucketKey() + "/" + record.AppID
+
+	for i := 0; ; i++ {
+		err := zk.addUsage(p, record)
+		if err == nil {
+			return nil
+		}
+
+		if err != errConflict && err != errNodeExists && err != errNotExists {
+			return err
+		}
+
+		if i >= maxUsageRetries {
+			return fmt.Errorf("usage %s revision conflict: %v", p, err)
+		}
+	}
+}
+
+func (zk *ZKStore) addUsage(p string, record *types.UsageRecord) error {
+	bs, stat, err := zk.get(p)
+	if err != nil && err != errNotExists {
+		return err
+	}
+
+	if err == errNotExists {
+		if bs, err = encode(record); err != nil {
+			return err
+		}
+
+		return zk.createNew(p, bs)
+	}
+
+	prev := new(types.UsageRecord)
+	if err := decode(bs, &prev); err != nil {
+		log.Errorln("zk AddUsage.decode error:", err)
+		return err
+	}
+
+	prev.Add(record)
+
+	if bs, err = encode(prev); err != nil {
+		return err
+	}
+
+	_, err = zk.cas(p, revision(stat), bs)
+	return err
+}
+
+func (zk *ZKStore) ListUsage(from, to time.Time) ([]*types.UsageRecord, error) {
+	ret := make([]*types.UsageRecord, 0, 0)
+
+	keys, err := zk.list(keyUsage)
+	if err != nil {
+		log.Errorln("zk ListUsage error:", err)
+		return ret, err
+	}
+
+	for _, key := range keys {
+		bucket, err := types.ParseUsageBucket(key)
+		if err != nil || bucket.Before(from) || !bucket.Before(to) {
+			continue
+		}
+
+		nodes, err := zk.list(keyUsage + "/" + key)
+		if err != nil {
+			log.Errorln("zk ListUsage.listbucket error:", err)
+			return ret, err
+		}
+
+		for _, node := range nodes {
+			bs, _, err := zk.get(keyUsage + "/" + key + "/" + node)
+			if err != nil {
+				log.Errorln("zk ListUsage.getnode error:", err)
+				continue
+			}
+
+			r := new(types.UsageRecord)
+			if err := decode(bs, &r); err != nil {
+				log.Errorln("zk ListUsage.decode error:", err)
+				continue
+			}
+
+			ret = append(ret, r)
+		}
+	}
+
+	return ret, nil
+}
+
+func (zk *ZKStore) PurgeUsage(before time.Time) error {
+	keys, err := zk.list(keyUsage)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		bucket, err := types.ParseUsageBucket(key)
+		if err != nil || !bucket.Before(before) {
+			continue
+		}
+
+		p := keyUsage + "/" + key
+
+		nodes, err := zk.list(p)
+		if err != nil {
+			return err
+		}
+
+		for _, node := range nodes {
+			if err := zk.del(p + "/" + node); err != nil {
+				return err
+			}
+		}
+
+		if err := zk.del(p); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,176 @@
+package zk
+
+import (
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/samuel/go-zookeeper/zk"
+
+	"github.com/Dataman-Cloud/swan/store/watch"
+)
+
+// appsWatch watches the tree of the apps by the one-shot zk watches, which are set
+// again once fired. The children of the apps, the tasks and the versions nodes are
+// watched, and the data of the apps and the tasks.
+type appsWatch struct {
+	zs   *ZKStore
+	w    *watch.Watcher
+	root string
+
+	sync.Mutex
+	armed map[string]bool // the paths watched, with the suffix "/" for the children
+}
+
+// WatchApps watches the apps, their tasks and versions.
+func (zs *ZKStore) WatchApps(stop <-chan struct{}) (<-chan string, error) {
+	aw := &appsWatch{
+		zs:    zs,
+		w:     watch.New(stop),
+		root:  zs.clean(keyApp),
+		armed: make(map[string]bool),
+	}
+
+	if err := aw.children(aw.root, false); err != nil {
+		return nil, err
+	}
+
+	return aw.w.C(), nil
+}
+
+// depth of the node under the apps, eg: 1 of the app, 3 of the task.
+func (aw *appsWatch) depth(p string) int {
+	if p == aw.root {
+		return 0
+	}
+
+	return strings.Count(strings.TrimPrefix(p, aw.root), "/")
+}
+
+// node watches the node found, the app is notified if it's new.
+func (aw *appsWatch) node(p string, notify bool) {
+	var err error
+
+	switch aw.depth(p) {
+	case 0:
+		err = aw.children(p, notify)
+	case 1:
+		if err = aw.data(p); err == nil {
+			err = aw.children(p, notify)
+		}
+
+		if notify {
+			aw.changed(p)
+		}
+	case 2:
+		err = aw.children(p, notify)
+	case 3:
+		if path.Base(path.Dir(p)) == "tasks" {
+			err = aw.data(p)
+		}
+	}
+
+	if err != nil && err != zk.ErrNoNode {
+		log.Warnf("watch zk node %s got error: %v", p, err)
+	}
+}
+
+func (aw *appsWatch) children(p string, notify bool) error {
+	if !aw.arm(p + "/") {
+		return nil
+	}
+
+	children, _, ch, err := aw.zs.conn.ChildrenW(p)
+	if err != nil {
+		aw.disarm(p + "/")
+		return err
+	}
+
+	go aw.wait(p, p+"/", ch)
+
+	for _, child := range children {
+		aw.node(path.Join(p, child), notify)
+	}
+
+	return nil
+}
+
+func (aw *appsWatch) data(p string) error {
+	if !aw.arm(p) {
+		return nil
+	}
+
+	_, _, ch, err := aw.zs.conn.GetW(p)
+	if err != nil {
+		aw.disarm(p)
+		return err
+	}
+
+	go aw.wait(p, p, ch)
+
+	return nil
+}
+
+// wait waits for the watch fired, and sets it again unless the node deleted.
+func (aw *appsWatch) wait(p, key string, ch <-chan zk.Event) {
+	var ev zk.Event
+
+	select {
+	case ev = <-ch:
+	case <-aw.w.Stopped():
+		return
+	}
+
+	aw.disarm(key)
+
+	switch ev.Type {
+	case zk.EventNodeDeleted:
+		aw.changed(p)
+	case zk.EventNodeDataChanged:
+		aw.node(p, false)
+		aw.changed(p)
+	case zk.EventNodeChildrenChanged:
+		aw.node(p, true)
+		aw.changed(p)
+	default:
+		// the watch lost, eg: the session expired.
+		log.Warnf("zk watch of %s lost: %v, resuming", p, ev.Err)
+
+		select {
+		case <-time.After(time.Second):
+		case <-aw.w.Stopped():
+			return
+		}
+
+		aw.node(p, false)
+		aw.w.Changed(watch.All)
+	}
+}
+
+func (aw *appsWatch) changed(p string) {
+	if id, ok := watch.AppID(aw.root, p); ok {
+		aw.w.Changed(id)
+	}
+}
+
+// arm marks the key watched, false if it has been.
+func (aw *appsWatch) arm(key string) bool {
+	aw.Lock()
+	defer aw.Unlock()
+
+	if aw.armed[key] {
+		return false
+	}
+
+	aw.armed[key] = true
+
+	return true
+}
+
+func (aw *appsWatch) disarm(key string) {
+	aw.Lock()
+	delete(aw.armed, key)
+	aw.Unlock()
+}
@@ -17,21 +17,32 @@ var (
 )
 
 var (
-	errAppNotFound          = errors.New("app not found")
-	errAppAlreadyExists     = errors.New("app already exists")
-	errVersionAlreadyExists = errors.New("version already exists")
-	errInstanceNotFound     = errors.New("instance not found")
-	errAgentNotFound        = errors.New("agent not found")
-	errNotExists            = zk.ErrNoNode
+	errAppNotFound              = errors.New("app not found")
+	errAppAlreadyExists         = errors.New("app already exists")
+	errVersionAlreadyExists     = errors.New("version already exists")
+	errInstanceNotFound         = errors.New("instance not found")
+	errAgentNotFound            = errors.New("agent not found")
+	errQuotaAlreadyExists       = errors.New("quota already exists")
+	errJobAlreadyExists         = errors.New("job already exists")
+	errCronJobAlreadyExists     = errors.New("cron job already exists")
+	errReservationAlreadyExists = errors.New("reservation already exists")
+	errNotExists                = zk.ErrNoNode
+	errConflict                 = zk.ErrBadVersion
+	errNodeExists               = zk.ErrNodeExists
 )
 
 const (
 	keyApp = "/apps" // single app
 	//keyTask        = "/tasks"
 	//keyVersion     = "/versions"
-	keyCompose     = "/composes"  // compose instance (group apps)
-	keyAgent       = "/agents"    // swan agent
-	keyFrameworkID = "/framework" // framework id
+	keyCompose     = "/composes"     // compose instance (group apps)
+	keyAgent       = "/agents"       // swan agent
+	keyQuota       = "/quotas"       // resource quota of runAs
+	keyUsage       = "/usage"        // resource usage rollups
+	keyJob         = "/jobs"         // run-to-completion jobs
+	keyCronJob     = "/cronjobs"     // cron jobs
+	keyReservation = "/reservations" // dynamic reservations of stateful tasks
+	keyFrameworkID = "/framework"    // framework id
 )
 
 type ZKStore struct {
@@ -55,7 +66,7 @@ func NewZKStore(url *url.URL) (*ZKStore, error) {
 	}
 
 	// create base keys nodes
-	for _, node := range []string{keyApp, keyCompose, keyAgent} {
+	for _, node := range []string{keyApp, keyCompose, keyAgent, keyQuota, keyUsage, keyJob, keyCronJob, keyReservation} {
 		if err := zs.createAll(node, nil); err != nil {
 			return nil, err
 		}
@@ -150,6 +161,28 @@ func (zs *ZKStore) set(path string, data []byte) error {
 	return err
 }
 
+// cas sets the node only if its revision is rev, unless rev is 0, and returns the
+// new revision.
+func (zs *ZKStore) cas(path string, rev int64, data []byte) (int64, error) {
+	version := int32(-1)
+	if rev != 0 {
+		version = int32(rev - 1)
+	}
+
+	stat, err := zs.conn.Set(zs.clean(path), data, version)
+	if err != nil {
+		return 0, err
+	}
+
+	return revision(stat), nil
+}
+
+// revision is the version of the node plus 1, as the version starts from 0 and the
+// revision 0 means unknown.
+func revision(stat *zk.Stat) int64 {
+	return int64(stat.Version) + 1
+}
+
 func (zs *ZKStore) create(path string, data []byte) error {
 	path = zs.clean(path)
 
@@ -167,6 +200,17 @@ func (zs *ZKStore) create(path string, data []byte) error {
 	return err
 }
 
+// createNew creates the node with its parents, errNodeExists if it exists already.
+func (zs *ZKStore) createNew(p string, data []byte) error {
+	if err := zs.createAll(path.Dir(zs.clean(p)), nil); err != nil {
+		return err
+	}
+
+	_, err := zs.conn.Create(zs.clean(p), data, 0, zs.acl)
+
+	return err
+}
+
 // encode & decode is just short-hands for json Marshal/Unmarshal
 func encode(data interface{}) ([]byte, error) {
 	return json.Marshal(data)
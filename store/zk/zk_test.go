@@ -0,0 +1,79 @@
+package zk_test
+
+import (
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/samuel/go-zookeeper/zk"
+
+	"github.com/Dataman-Cloud/swan/store"
+	"github.com/Dataman-Cloud/swan/store/storetest"
+	swanzk "github.com/Dataman-Cloud/swan/store/zk"
+	"github.com/Dataman-Cloud/swan/utils"
+)
+
+// TestZKStore runs the conformance suite against the zookeeper given by the
+// SWAN_TEST_ZK_URL, eg: zk://127.0.0.1:2181/swan-test. Each test is given a new
+// path under it, which is removed once done.
+func TestZKStore(t *testing.T) {
+	raw := os.Getenv("SWAN_TEST_ZK_URL")
+	if raw == "" {
+		t.Skip("SWAN_TEST_ZK_URL not set")
+	}
+
+	base, err := url.Parse(raw)
+	if err != nil {
+		t.Fatalf("malformed SWAN_TEST_ZK_URL: %v", err)
+	}
+
+	conn, _, err := zk.Connect(strings.Split(base.Host, ","), 5*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	storetest.Run(t, func(t *testing.T) store.Store {
+		u := *base
+		u.Path = path.Join("/", base.Path, utils.RandomString(8))
+
+		s, err := swanzk.NewZKStore(&u)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		t.Cleanup(func() {
+			if err := deleteAll(conn, u.Path); err != nil {
+				t.Logf("remove %s got error: %v", u.Path, err)
+			}
+		})
+
+		return s
+	})
+}
+
+// deleteAll removes the node with all of its children.
+func deleteAll(conn *zk.Conn, p string) error {
+	children, _, err := conn.Children(p)
+	if err != nil {
+		if err == zk.ErrNoNode {
+			return nil
+		}
+		return err
+	}
+
+	for _, child := range children {
+		if err := deleteAll(conn, path.Join(p, child)); err != nil {
+			return err
+		}
+	}
+
+	if err := conn.Delete(p, -1); err != nil && err != zk.ErrNoNode {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Dataman-Cloud/swan/utils/labels"
+)
+
+// AppAffinity puts the tasks on (or away from, if Anti) the agents hosting tasks of
+// the apps selected by the label Selector. Required rules must be satisfied, the
+// preferred ones only rate the agents by Weight with score strategy.
+type AppAffinity struct {
+	Selector string  `json:"selector"` // eg: "app=redis,tier in (cache)"
+	Anti     bool    `json:"anti"`
+	Required bool    `json:"required"`
+	Weight   float64 `json:"weight,omitempty"` // default 1
+}
+
+func (a *AppAffinity) validate() error {
+	if a.Selector == "" {
+		return errors.New("affinity selector required")
+	}
+
+	if _, err := labels.Parse(a.Selector); err != nil {
+		return fmt.Errorf("invalid affinity selector %s: %v", a.Selector, err)
+	}
+
+	if a.Weight < 0 {
+		return errors.New("affinity weight can't be negative")
+	}
+
+	return nil
+}
+
+// Matches reports whether the app labels are selected by the rule.
+func (a *AppAffinity) Matches(lbs map[string]string) bool {
+	selector, err := labels.Parse(a.Selector)
+	if err != nil {
+		return false
+	}
+
+	return selector.Matches(labels.Set(lbs))
+}
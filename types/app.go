@@ -14,6 +14,7 @@ const (
 	OpStatusUpdating = "updating"
 	OpStatusDeleting = "deleting"
 	OpStatusRollback = "rollbacking"
+	OpStatusAdopting = "adopting" // restored without tasks, adopting the running ones
 )
 
 type Application struct {
@@ -32,6 +33,7 @@ type Application struct {
 	Health       *Health   `json:"health"`
 	CreatedAt    time.Time `json:"created"`
 	UpdatedAt    time.Time `json:"updated"`
+	Revision     int64     `json:"revision"` // of the store, 0 means unknown and updates unconditionally
 }
 
 type AppFilterOptions struct {
@@ -34,6 +34,7 @@ type Compose struct {
 	ErrMsg      string    `json:"errmsg"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
+	Revision    int64     `json:"revision"` // of the store, 0 means unknown and updates unconditionally
 
 	// request settings
 	ServiceGroup ServiceGroup          `json:"service_group"`
@@ -249,6 +250,8 @@ func (s *DockerService) ToVersion(cName, cluster string) (*Version, error) {
 		} else {
 			ver.Instances = int32(*n)
 		}
+	case "global": // one container on every matching agent
+		ver.DeployPolicy = &DeployPolicy{Mode: DeployDaemon}
 	default:
 		ver.Instances = 1
 	}
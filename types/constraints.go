@@ -39,6 +39,17 @@ func (c *Constraint) Match(attrs map[string]string) bool {
 	return false
 }
 
+// MatchConstraints reports whether the attributes match all of the constraints.
+func MatchConstraints(constraints []*Constraint, attrs map[string]string) bool {
+	for _, constraint := range constraints {
+		if !constraint.Match(attrs) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func equal(n, m string) bool {
 	return n == m
 }
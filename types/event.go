@@ -21,18 +21,19 @@ type CombinedEvents struct {
 }
 
 type TaskEvent struct {
-	Type           string  `json:"type"`
-	AppID          string  `json:"app_id"`
-	AppAlias       string  `json:"app_alias"`
-	AppListen      string  `json:"app_listen"`
-	AppSticky      bool    `json:"app_sticky"`
-	VersionID      string  `json:"version_id"`
-	AppVersion     string  `json:"app_version"`
-	TaskID         string  `json:"task_id"`
-	IP             string  `json:"task_ip"`
-	Port           uint64  `json:"task_port"`
-	Weight         float64 `json:"weihgt"`
-	GatewayEnabled bool    `json:"gateway"`
+	Type           string   `json:"type"`
+	AppID          string   `json:"app_id"`
+	AppAlias       string   `json:"app_alias"`
+	AppListen      string   `json:"app_listen"`
+	AppSticky      bool     `json:"app_sticky"`
+	VersionID      string   `json:"version_id"`
+	AppVersion     string   `json:"app_version"`
+	TaskID         string   `json:"task_id"`
+	IP             string   `json:"task_ip"`
+	IPs            []string `json:"task_ips,omitempty"`
+	Port           uint64   `json:"task_port"`
+	Weight         float64  `json:"weihgt"`
+	GatewayEnabled bool     `json:"gateway"`
 }
 
 // Format format task events to SSE text
@@ -0,0 +1,161 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Dataman-Cloud/swan/utils"
+	"github.com/Dataman-Cloud/swan/utils/cron"
+)
+
+const (
+	JobPending   = "pending"
+	JobRunning   = "running"
+	JobSucceeded = "succeeded"
+	JobFailed    = "failed"
+
+	// concurrency policy of cron job
+	ConcurrencyAllow   = "allow"   // run concurrently with the previous ones
+	ConcurrencyForbid  = "forbid"  // skip if the previous one is still running
+	ConcurrencyReplace = "replace" // stop the previous one and run
+
+	defaultHistoryLimit = 10
+)
+
+// Job runs one task to completion, the failed task is retried at most Retries times.
+type Job struct {
+	ID         string        `json:"id"`
+	Name       string        `json:"name"`
+	RunAs      string        `json:"runAs"`
+	Cluster    string        `json:"cluster"`
+	Template   *Version      `json:"template"` // the task spec, name/runAs/instances are ignored
+	Retries    int           `json:"retries"`
+	Deadline   int64         `json:"deadline"` // in seconds since started, 0 means no limit
+	CronJob    string        `json:"cronJob,omitempty"`
+	Status     string        `json:"status"`
+	ErrMsg     string        `json:"errmsg"`
+	Attempts   []*JobAttempt `json:"attempts"`
+	CreatedAt  time.Time     `json:"created"`
+	StartedAt  time.Time     `json:"started"`
+	FinishedAt time.Time     `json:"finished"`
+}
+
+// JobAttempt is one run of the job's task.
+type JobAttempt struct {
+	TaskID     string    `json:"taskId"`
+	AgentID    string    `json:"agentId"`
+	Status     string    `json:"status"`
+	ErrMsg     string    `json:"errmsg"`
+	StartedAt  time.Time `json:"started"`
+	FinishedAt time.Time `json:"finished"`
+}
+
+// CronJob creates jobs by the cron Schedule.
+type CronJob struct {
+	ID                string    `json:"id"`
+	Name              string    `json:"name"`
+	RunAs             string    `json:"runAs"`
+	Cluster           string    `json:"cluster"`
+	Schedule          string    `json:"schedule"` // eg: "0 2 * * *"
+	ConcurrencyPolicy string    `json:"concurrencyPolicy"`
+	Suspend           bool      `json:"suspend"`
+	HistoryLimit      int       `json:"historyLimit"` // finished jobs kept
+	Template          *Version  `json:"template"`
+	Retries           int       `json:"retries"`
+	Deadline          int64     `json:"deadline"`
+	LastScheduleTime  time.Time `json:"lastScheduleTime"`
+	CreatedAt         time.Time `json:"created"`
+	UpdatedAt         time.Time `json:"updated"`
+}
+
+func (j *Job) Finished() bool {
+	return j.Status == JobSucceeded || j.Status == JobFailed
+}
+
+// Version returns the template completed with the job's name and runAs.
+func (j *Job) Version() *Version {
+	ver := *j.Template
+	ver.Name = j.Name
+	ver.RunAs = j.RunAs
+	ver.Instances = 1
+	ver.DeployPolicy = nil
+
+	return &ver
+}
+
+func (j *Job) Validate() error {
+	if j.Template == nil {
+		return errors.New("job template required")
+	}
+
+	if j.Retries < 0 {
+		return errors.New("retries can't be negative")
+	}
+
+	if j.Deadline < 0 {
+		return errors.New("deadline can't be negative")
+	}
+
+	if j.Template.Pod != nil {
+		return errors.New("pod not supported by job")
+	}
+
+	if c := j.Template.Container; c != nil {
+		for _, v := range c.Volumes {
+			if v.Persistent != nil {
+				return errors.New("persistent volume not supported by job")
+			}
+		}
+	}
+
+	if err := utils.LegalDomain(j.Name); err != nil {
+		return err
+	}
+
+	return j.Version().Validate()
+}
+
+// NewJob creates a job of the cron job scheduled at t.
+func (c *CronJob) NewJob(t time.Time) *Job {
+	name := fmt.Sprintf("%s-%d", c.Name, t.Unix())
+
+	return &Job{
+		ID:        fmt.Sprintf("%s.%s.%s", name, c.RunAs, c.Cluster),
+		Name:      name,
+		RunAs:     c.RunAs,
+		Cluster:   c.Cluster,
+		Template:  c.Template,
+		Retries:   c.Retries,
+		Deadline:  c.Deadline,
+		CronJob:   c.ID,
+		Status:    JobPending,
+		Attempts:  make([]*JobAttempt, 0),
+		CreatedAt: time.Now(),
+	}
+}
+
+func (c *CronJob) Validate() error {
+	if _, err := cron.Parse(c.Schedule); err != nil {
+		return err
+	}
+
+	switch c.ConcurrencyPolicy {
+	case "":
+		c.ConcurrencyPolicy = ConcurrencyAllow
+	case ConcurrencyAllow, ConcurrencyForbid, ConcurrencyReplace:
+	default:
+		return fmt.Errorf("concurrency policy %s not supported. must be one of %s, %s, %s",
+			c.ConcurrencyPolicy, ConcurrencyAllow, ConcurrencyForbid, ConcurrencyReplace)
+	}
+
+	if c.HistoryLimit < 0 {
+		return errors.New("history limit can't be negative")
+	}
+
+	if c.HistoryLimit == 0 {
+		c.HistoryLimit = defaultHistoryLimit
+	}
+
+	return c.NewJob(time.Now()).Validate()
+}
@@ -0,0 +1,369 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Dataman-Cloud/swan/mesosproto"
+	"github.com/gogo/protobuf/proto"
+)
+
+const (
+	// resources of the mesos default executor running the pod
+	PodExecutorCPUs = 0.1
+	PodExecutorMem  = 32
+	PodExecutorDisk = 10
+)
+
+var podContainerNameRegexp = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
+// Pod is a group of containers launched together on one agent by the mesos
+// default executor. The containers share the network namespace and the pod
+// volumes, each container is a mesos task with its own resources and health check.
+type Pod struct {
+	Containers []*PodContainer `json:"containers"`
+	Volumes    []*PodVolume    `json:"volumes,omitempty"`
+}
+
+type PodContainer struct {
+	Name         string            `json:"name"`
+	Image        string            `json:"image"`
+	Command      string            `json:"cmd,omitempty"`
+	CPUs         float64           `json:"cpus"`
+	Mem          float64           `json:"mem"`
+	Disk         float64           `json:"disk,omitempty"`
+	Env          map[string]string `json:"env,omitempty"`
+	HealthCheck  *PodHealthCheck   `json:"healthCheck,omitempty"`
+	VolumeMounts []*VolumeMount    `json:"volumeMounts,omitempty"`
+}
+
+// PodVolume is a directory shared by the pod containers, in the executor's sandbox
+// or on the host if HostPath specified.
+type PodVolume struct {
+	Name     string `json:"name"`
+	HostPath string `json:"hostPath,omitempty"`
+}
+
+type VolumeMount struct {
+	Name      string `json:"name"`
+	MountPath string `json:"mountPath"`
+	ReadOnly  bool   `json:"readOnly,omitempty"`
+}
+
+// PodHealthCheck checks the container by the port directly, as the pod runs
+// without port mappings.
+type PodHealthCheck struct {
+	HealthCheck
+	Port uint32 `json:"port,omitempty"`
+}
+
+func (p *Pod) validate() error {
+	if len(p.Containers) == 0 {
+		return errors.New("pod should have at least one container")
+	}
+
+	volumes := make(map[string]bool)
+	for _, v := range p.Volumes {
+		if !podContainerNameRegexp.MatchString(v.Name) {
+			return fmt.Errorf("invalid pod volume name %q", v.Name)
+		}
+
+		if volumes[v.Name] {
+			return fmt.Errorf("duplicated pod volume %s", v.Name)
+		}
+		volumes[v.Name] = true
+	}
+
+	names := make(map[string]bool)
+	for _, c := range p.Containers {
+		if len(c.Name) > 63 || !podContainerNameRegexp.MatchString(c.Name) {
+			return fmt.Errorf("invalid pod container name %q: lower case alphanumeric characters or '-' only", c.Name)
+		}
+
+		if names[c.Name] {
+			return fmt.Errorf("duplicated pod container %s", c.Name)
+		}
+		names[c.Name] = true
+
+		if c.Image == "" {
+			return fmt.Errorf("image of pod container %s required", c.Name)
+		}
+
+		if c.CPUs < 0.01 {
+			return fmt.Errorf("cpu of pod container %s should >= 0.01", c.Name)
+		}
+
+		if c.Mem < 5 {
+			return fmt.Errorf("mem of pod container %s should >= 5m", c.Name)
+		}
+
+		for _, m := range c.VolumeMounts {
+			if !volumes[m.Name] {
+				return fmt.Errorf("volume %s mounted by pod container %s not defined", m.Name, c.Name)
+			}
+
+			if !strings.HasPrefix(m.MountPath, "/") {
+				return fmt.Errorf("mount path of volume %s should be absolute", m.Name)
+			}
+		}
+
+		if hc := c.HealthCheck; hc != nil {
+			switch strings.ToLower(hc.Protocol) {
+			case "cmd":
+				if strings.TrimSpace(hc.Command) == "" {
+					return fmt.Errorf("no cmd provided for health check of pod container %s", c.Name)
+				}
+			case "http", "tcp":
+				if hc.Port == 0 {
+					return fmt.Errorf("no port provided for health check of pod container %s", c.Name)
+				}
+
+				if strings.ToLower(hc.Protocol) == "http" && strings.TrimSpace(hc.Path) == "" {
+					return fmt.Errorf("no path provided for health check of pod container %s", c.Name)
+				}
+			default:
+				return fmt.Errorf("doesn't recoginized protocol %s for health check", hc.Protocol)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Resources returns the resources of the whole pod, including the executor.
+func (p *Pod) Resources() (cpus, mem, disk float64) {
+	cpus, mem, disk = PodExecutorCPUs, PodExecutorMem, PodExecutorDisk
+
+	for _, c := range p.Containers {
+		cpus += c.CPUs
+		mem += c.Mem
+		disk += c.Disk
+	}
+
+	return
+}
+
+func (p *Pod) HasHealthCheck() bool {
+	for _, c := range p.Containers {
+		if c.HealthCheck != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p *Pod) getVolume(name string) *PodVolume {
+	for _, v := range p.Volumes {
+		if v.Name == name {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// PodContainerTaskID returns the mesos task id of the pod container, the container
+// name is inserted after the random part of the pod task id, eg:
+// `a1b0c3d9e2f1-envoy.0.web.xcm.dataman`.
+func PodContainerTaskID(podTaskId, container string) string {
+	parts := strings.SplitN(podTaskId, ".", 2)
+	if len(parts) != 2 {
+		return podTaskId + "-" + container
+	}
+
+	return fmt.Sprintf("%s-%s.%s", parts[0], container, parts[1])
+}
+
+// SplitPodContainerTaskID returns the pod task id and the container name of
+// the pod container's mesos task id.
+func SplitPodContainerTaskID(taskId string) (podTaskId, container string, ok bool) {
+	parts := strings.SplitN(taskId, ".", 2)
+	if len(parts) != 2 {
+		return "", "", false
+	}
+
+	prefix := strings.SplitN(parts[0], "-", 2)
+	if len(prefix) != 2 {
+		return "", "", false
+	}
+
+	return prefix[0] + "." + parts[1], prefix[1], true
+}
+
+// BuildExecutor builds the default executor running the pod, identified by the
+// pod task id.
+func (c *TaskConfig) BuildExecutor(id string) *mesosproto.ExecutorInfo {
+	return &mesosproto.ExecutorInfo{
+		Type: mesosproto.ExecutorInfo_DEFAULT.Enum(),
+		ExecutorId: &mesosproto.ExecutorID{
+			Value: proto.String(id),
+		},
+		Resources: scalarResources(PodExecutorCPUs, PodExecutorMem, PodExecutorDisk),
+		Container: &mesosproto.ContainerInfo{
+			Type: mesosproto.ContainerInfo_MESOS.Enum(),
+		},
+	}
+}
+
+// BuildTaskGroup builds the mesos tasks of the pod containers.
+func (c *TaskConfig) BuildTaskGroup(id, name string, agentId *mesosproto.AgentID) *mesosproto.TaskGroupInfo {
+	tasks := make([]*mesosproto.TaskInfo, 0, len(c.Pod.Containers))
+
+	for _, container := range c.Pod.Containers {
+		task := &mesosproto.TaskInfo{
+			Name: proto.String(fmt.Sprintf("%s.%s", container.Name, name)),
+			TaskId: &mesosproto.TaskID{
+				Value: proto.String(PodContainerTaskID(id, container.Name)),
+			},
+			AgentId:   agentId,
+			Resources: scalarResources(container.CPUs, container.Mem, container.Disk),
+			Command:   c.buildPodCommand(container),
+			Container: c.buildPodContainer(container),
+			Labels:    c.BuildLabels(name),
+		}
+
+		if container.HealthCheck != nil {
+			task.HealthCheck = buildPodHealthCheck(container.HealthCheck)
+		}
+
+		tasks = append(tasks, task)
+	}
+
+	return &mesosproto.TaskGroupInfo{
+		Tasks: tasks,
+	}
+}
+
+func (c *TaskConfig) buildPodCommand(container *PodContainer) *mesosproto.CommandInfo {
+	env := make(map[string]string)
+	for k, v := range c.Env {
+		env[k] = v
+	}
+	for k, v := range container.Env {
+		env[k] = v
+	}
+
+	vars := make([]*mesosproto.Environment_Variable, 0, len(env))
+	for k, v := range env {
+		vars = append(vars, &mesosproto.Environment_Variable{
+			Name:  proto.String(k),
+			Value: proto.String(v),
+		})
+	}
+
+	cmd := &mesosproto.CommandInfo{
+		Uris:        c.uris(),
+		Environment: &mesosproto.Environment{Variables: vars},
+		Shell:       proto.Bool(false),
+	}
+
+	if container.Command != "" {
+		cmd.Shell = proto.Bool(true)
+		cmd.Value = proto.String(container.Command)
+	}
+
+	return cmd
+}
+
+func (c *TaskConfig) buildPodContainer(container *PodContainer) *mesosproto.ContainerInfo {
+	volumes := make([]*mesosproto.Volume, 0, len(container.VolumeMounts))
+
+	for _, m := range container.VolumeMounts {
+		mode := mesosproto.Volume_RW
+		if m.ReadOnly {
+			mode = mesosproto.Volume_RO
+		}
+
+		vol := &mesosproto.Volume{
+			ContainerPath: proto.String(m.MountPath),
+			Mode:          &mode,
+		}
+
+		if v := c.Pod.getVolume(m.Name); v != nil && v.HostPath != "" {
+			vol.HostPath = proto.String(v.HostPath)
+		} else {
+			vol.Source = &mesosproto.Volume_Source{
+				Type: mesosproto.Volume_Source_SANDBOX_PATH.Enum(),
+				SandboxPath: &mesosproto.Volume_Source_SandboxPath{
+					Type: mesosproto.Volume_Source_SandboxPath_PARENT.Enum(),
+					Path: proto.String("volumes/" + m.Name),
+				},
+			}
+		}
+
+		volumes = append(volumes, vol)
+	}
+
+	return &mesosproto.ContainerInfo{
+		Type:    mesosproto.ContainerInfo_MESOS.Enum(),
+		Volumes: volumes,
+		Mesos: &mesosproto.ContainerInfo_MesosInfo{
+			Image: mesosImage(container.Image, c.ForcePullImage),
+		},
+	}
+}
+
+func buildPodHealthCheck(hc *PodHealthCheck) *mesosproto.HealthCheck {
+	var health *mesosproto.HealthCheck
+
+	switch strings.ToLower(hc.Protocol) {
+	case "cmd":
+		health = &mesosproto.HealthCheck{
+			Type: mesosproto.HealthCheck_COMMAND.Enum(),
+			Command: &mesosproto.CommandInfo{
+				Value: proto.String(hc.Command),
+			},
+		}
+	case "http":
+		health = &mesosproto.HealthCheck{
+			Type: mesosproto.HealthCheck_HTTP.Enum(),
+			Http: &mesosproto.HealthCheck_HTTPCheckInfo{
+				Scheme:   proto.String("http"),
+				Port:     proto.Uint32(hc.Port),
+				Path:     proto.String(hc.Path),
+				Statuses: []uint32{uint32(200), uint32(201), uint32(301), uint32(302)},
+			},
+		}
+	case "tcp":
+		health = &mesosproto.HealthCheck{
+			Type: mesosproto.HealthCheck_TCP.Enum(),
+			Tcp: &mesosproto.HealthCheck_TCPCheckInfo{
+				Port: proto.Uint32(hc.Port),
+			},
+		}
+	}
+
+	health.DelaySeconds = proto.Float64(hc.DelaySeconds)
+	health.IntervalSeconds = proto.Float64(hc.IntervalSeconds)
+	health.TimeoutSeconds = proto.Float64(hc.TimeoutSeconds)
+	health.ConsecutiveFailures = proto.Uint32(hc.ConsecutiveFailures)
+	health.GracePeriodSeconds = proto.Float64(hc.GracePeriodSeconds)
+
+	return health
+}
+
+func scalarResources(cpus, mem, disk float64) []*mesosproto.Resource {
+	rs := make([]*mesosproto.Resource, 0, 3)
+
+	for _, r := range []struct {
+		name  string
+		value float64
+	}{{"cpus", cpus}, {"mem", mem}, {"disk", disk}} {
+		if r.value <= 0 {
+			continue
+		}
+
+		rs = append(rs, &mesosproto.Resource{
+			Name: proto.String(r.name),
+			Type: mesosproto.Value_SCALAR.Enum(),
+			Scalar: &mesosproto.Value_Scalar{
+				Value: proto.Float64(r.value),
+			},
+		})
+	}
+
+	return rs
+}
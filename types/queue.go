@@ -0,0 +1,18 @@
+package types
+
+import "time"
+
+// QueuedLaunch is a pending launch waiting in the scheduler's launch queue.
+type QueuedLaunch struct {
+	ID          string    `json:"id"`
+	AppID       string    `json:"appId"`
+	Priority    int32     `json:"priority"`
+	Position    int       `json:"position"`
+	Tasks       []string  `json:"tasks"`
+	Dispatching bool      `json:"dispatching"` // being placed, waiting for the offers
+	EnqueuedAt  time.Time `json:"enqueuedAt"`
+}
+
+type UpdateLaunchPriorityBody struct {
+	Priority int32 `json:"priority"`
+}
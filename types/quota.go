@@ -0,0 +1,106 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Dataman-Cloud/swan/utils"
+)
+
+// Quota limits the resources could be consumed by all of the apps of one runAs.
+// Zero value of any field means unlimited.
+type Quota struct {
+	RunAs     string    `json:"runAs"`
+	CPUs      float64   `json:"cpus"`
+	Mem       float64   `json:"mem"`
+	Disk      float64   `json:"disk"`
+	Instances int       `json:"instances"`
+	Apps      int       `json:"apps"`
+	CreatedAt time.Time `json:"created"`
+	UpdatedAt time.Time `json:"updated"`
+}
+
+// ResourceUsage is the resources consumed (or requested) by the apps of one runAs.
+type ResourceUsage struct {
+	CPUs      float64 `json:"cpus"`
+	Mem       float64 `json:"mem"`
+	Disk      float64 `json:"disk"`
+	Instances int     `json:"instances"`
+	Apps      int     `json:"apps"`
+}
+
+// QuotaStatus reports a quota with its current usage.
+type QuotaStatus struct {
+	*Quota
+	Used *ResourceUsage `json:"used"`
+}
+
+// NewResourceUsage returns the resources requested by n instances of the version.
+func NewResourceUsage(ver *Version, n int) *ResourceUsage {
+	return &ResourceUsage{
+		CPUs:      ver.CPUs * float64(n),
+		Mem:       ver.Mem * float64(n),
+		Disk:      ver.Disk * float64(n),
+		Instances: n,
+	}
+}
+
+func (u *ResourceUsage) Add(o *ResourceUsage) {
+	u.CPUs += o.CPUs
+	u.Mem += o.Mem
+	u.Disk += o.Disk
+	u.Instances += o.Instances
+	u.Apps += o.Apps
+}
+
+func (u *ResourceUsage) Sub(o *ResourceUsage) {
+	u.CPUs -= o.CPUs
+	u.Mem -= o.Mem
+	u.Disk -= o.Disk
+	u.Instances -= o.Instances
+	u.Apps -= o.Apps
+}
+
+func (q *Quota) Valid() error {
+	if q.RunAs == "" {
+		return errors.New("runAs required")
+	}
+
+	if err := utils.LegalDomain(q.RunAs); err != nil {
+		return err
+	}
+
+	if q.CPUs < 0 || q.Mem < 0 || q.Disk < 0 || q.Instances < 0 || q.Apps < 0 {
+		return errors.New("quota limits can't be negative")
+	}
+
+	return nil
+}
+
+// Check returns error if the requested resources on top of the used exceed the quota.
+func (q *Quota) Check(used, req *ResourceUsage) error {
+	total := &ResourceUsage{}
+	total.Add(used)
+	total.Add(req)
+
+	switch {
+	case q.CPUs > 0 && total.CPUs > q.CPUs:
+		return q.exceeded("cpus", req.CPUs, used.CPUs, q.CPUs)
+	case q.Mem > 0 && total.Mem > q.Mem:
+		return q.exceeded("mem", req.Mem, used.Mem, q.Mem)
+	case q.Disk > 0 && total.Disk > q.Disk:
+		return q.exceeded("disk", req.Disk, used.Disk, q.Disk)
+	case q.Instances > 0 && total.Instances > q.Instances:
+		return q.exceeded("instances", float64(req.Instances), float64(used.Instances), float64(q.Instances))
+	case q.Apps > 0 && total.Apps > q.Apps:
+		return q.exceeded("apps", float64(req.Apps), float64(used.Apps), float64(q.Apps))
+	}
+
+	return nil
+}
+
+func (q *Quota) exceeded(name string, req, used, limit float64) error {
+	return fmt.Errorf("quota exceeded for runAs %s: %s requested %.2f, used %.2f, limit %.2f",
+		q.RunAs, name, req, used, limit)
+}
@@ -0,0 +1,120 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/Dataman-Cloud/swan/utils"
+)
+
+const (
+	ReservationReserved  = "reserved"
+	ReservationReleasing = "releasing"
+
+	// label key of the reserved resources, the value is the reservation id.
+	ReservationLabel = "swan_reservation"
+)
+
+// PersistentVolume is created in the disk reserved for the task, and mounted
+// at the container path relative to the task sandbox.
+type PersistentVolume struct {
+	Size float64 `json:"size"` // in MB
+}
+
+// Reservation is the resources dynamically reserved on an agent for a task of
+// the stateful app, with the persistent volumes created in them. The task with
+// the same name is always launched on the reservation, with the same data.
+type Reservation struct {
+	ID        string            `json:"id"` // the task name, eg: 0.mysql.xcm.dataman
+	AppID     string            `json:"appId"`
+	AgentID   string            `json:"agentId"`
+	Hostname  string            `json:"hostname"`
+	Role      string            `json:"role"`
+	Principal string            `json:"principal"`
+	CPUs      float64           `json:"cpus"`
+	Mem       float64           `json:"mem"`
+	Disk      float64           `json:"disk"` // besides the volumes
+	Volumes   []*ReservedVolume `json:"volumes"`
+	Status    string            `json:"status"`
+	CreatedAt time.Time         `json:"created"`
+	UpdatedAt time.Time         `json:"updated"`
+}
+
+type ReservedVolume struct {
+	ID            string  `json:"id"` // persistence id, unique on the agent
+	ContainerPath string  `json:"containerPath"`
+	Size          float64 `json:"size"`
+}
+
+// NewReservation creates the reservation of the task config, the resources are
+// not reserved yet.
+func NewReservation(id, appId string, cfg *TaskConfig) *Reservation {
+	r := &Reservation{
+		ID:        id,
+		AppID:     appId,
+		CPUs:      cfg.CPUs,
+		Mem:       cfg.Mem,
+		Disk:      cfg.Disk,
+		Volumes:   make([]*ReservedVolume, 0),
+		Status:    ReservationReserved,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	for _, v := range cfg.Volumes {
+		if v.Persistent == nil {
+			continue
+		}
+
+		r.Volumes = append(r.Volumes, &ReservedVolume{
+			ID:            fmt.Sprintf("%s.%s", utils.RandomString(12), id),
+			ContainerPath: v.ContainerPath,
+			Size:          v.Persistent.Size,
+		})
+	}
+
+	return r
+}
+
+// IsStateful reports whether the task requires persistent volumes.
+func (c *TaskConfig) IsStateful() bool {
+	for _, v := range c.Volumes {
+		if v.Persistent != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// PersistentSize returns the disk required by the persistent volumes.
+func (c *TaskConfig) PersistentSize() float64 {
+	var size float64
+	for _, v := range c.Volumes {
+		if v.Persistent != nil {
+			size += v.Persistent.Size
+		}
+	}
+
+	return size
+}
+
+func (v *Volume) validatePersistent() error {
+	if v.Persistent.Size <= 0 {
+		return errors.New("size of persistent volume should > 0")
+	}
+
+	if v.HostPath != "" {
+		return errors.New("persistent volume can't have hostPath")
+	}
+
+	p := v.ContainerPath
+	if p == "" || strings.HasPrefix(p, "/") || path.Clean(p) != p || strings.HasPrefix(p, "..") {
+		return fmt.Errorf("containerPath %q of persistent volume should be a relative path in the sandbox", p)
+	}
+
+	return nil
+}
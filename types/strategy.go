@@ -0,0 +1,119 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Dataman-Cloud/swan/utils"
+)
+
+const (
+	StrategyRandom  = "random"
+	StrategySpread  = "spread"
+	StrategyBinpack = "binpack"
+	StrategyScore   = "score"
+
+	ScorerLeastAllocated    = "least-allocated"
+	ScorerMostAllocated     = "most-allocated"
+	ScorerSpreadByAttribute = "spread-by-attribute"
+	ScorerAppTaskCount      = "app-task-count"
+	ScorerImageLocality     = "image-locality"
+	ScorerAppAffinity       = "app-affinity"
+)
+
+var (
+	supportedStrategies = []string{StrategyRandom, StrategySpread, StrategyBinpack, StrategyScore}
+	supportedScorers    = []string{ScorerLeastAllocated, ScorerMostAllocated, ScorerSpreadByAttribute, ScorerAppTaskCount, ScorerImageLocality, ScorerAppAffinity}
+)
+
+// Strategy decides the order of the candidate agents for placing a task.
+type Strategy struct {
+	Name    string    `json:"name"`
+	Scorers []*Scorer `json:"scorers,omitempty"` // only for score strategy
+}
+
+// Scorer rates an agent in range [0, 1], the final score of an agent is the
+// weighted average of all of the scorers.
+type Scorer struct {
+	Name      string  `json:"name"`
+	Weight    float64 `json:"weight"`
+	Attribute string  `json:"attribute,omitempty"` // only for spread-by-attribute
+}
+
+func (s *Strategy) Validate() error {
+	if !utils.SliceContains(supportedStrategies, s.Name) {
+		return fmt.Errorf("strategy %s not supported. must be one of %v", s.Name, supportedStrategies)
+	}
+
+	if s.Name != StrategyScore {
+		if len(s.Scorers) > 0 {
+			return fmt.Errorf("scorers only works with %s strategy", StrategyScore)
+		}
+		return nil
+	}
+
+	for _, scorer := range s.Scorers {
+		if err := scorer.validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Scorer) validate() error {
+	if !utils.SliceContains(supportedScorers, s.Name) {
+		return fmt.Errorf("scorer %s not supported. must be one of %v", s.Name, supportedScorers)
+	}
+
+	if s.Weight < 0 {
+		return fmt.Errorf("weight of scorer %s can't be negative", s.Name)
+	}
+
+	if s.Name == ScorerSpreadByAttribute && s.Attribute == "" {
+		return fmt.Errorf("attribute required for scorer %s", s.Name)
+	}
+
+	return nil
+}
+
+// ParseScorers parses scorers in form of `name[:attribute]=weight,...`,
+// eg: `least-allocated=1,spread-by-attribute:zone=2`.
+func ParseScorers(s string) ([]*Scorer, error) {
+	ret := make([]*Scorer, 0)
+
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		parts := strings.SplitN(item, "=", 2)
+		if len(parts) != 2 {
+			return nil, errors.New("malformed scorer " + item + ", expect name[:attribute]=weight")
+		}
+
+		weight, err := strconv.ParseFloat(parts[1], 64)
+		if err != nil {
+			return nil, fmt.Errorf("malformed weight of scorer %s: %v", item, err)
+		}
+
+		scorer := &Scorer{Weight: weight}
+
+		name := strings.SplitN(parts[0], ":", 2)
+		scorer.Name = name[0]
+		if len(name) == 2 {
+			scorer.Attribute = name[1]
+		}
+
+		if err := scorer.validate(); err != nil {
+			return nil, err
+		}
+
+		ret = append(ret, scorer)
+	}
+
+	return ret, nil
+}
@@ -21,6 +21,7 @@ type Task struct {
 	ID       string    `json:"id"`
 	Name     string    `json:"name"`
 	IP       string    `json:"ip"`
+	IPs      []string  `json:"ips,omitempty"` // all of the container addresses, IP is the first one
 	Port     uint64    `json:"port"`
 	Healthy  string    `json:"healthy"`
 	Weight   float64   `json:"weight"`
@@ -31,6 +32,18 @@ type Task struct {
 	OpStatus string    `json:"opstatus"`
 	Created  time.Time `json:"created"`
 	Updated  time.Time `json:"updated"`
+	Revision int64     `json:"revision"` // of the store, 0 means unknown and updates unconditionally
+
+	Containers []*TaskContainer `json:"containers,omitempty"` // of the pod task
+}
+
+// TaskContainer is the status of a pod container.
+type TaskContainer struct {
+	Name    string `json:"name"`
+	TaskID  string `json:"taskId"`
+	Status  string `json:"status"`
+	Healthy string `json:"healthy"`
+	ErrMsg  string `json:"errmsg"`
 }
 
 type TaskList []*Task
@@ -58,6 +71,7 @@ type TaskConfig struct {
 	Disk           float64           `json:"disk"`
 	IP             string            `json:"ip"`
 	Port           uint64            `json:"ports"`
+	ContainerType  string            `json:"containerType"`
 	Image          string            `json:"image"`
 	Command        string            `json:"cmd"`
 	Privileged     bool              `json:"privileged"`
@@ -65,6 +79,7 @@ type TaskConfig struct {
 	Volumes        []*Volume         `json:"volumes"`
 	PortMappings   []*PortMapping    `json:"portmappings"`
 	Network        string            `json:"network"`
+	Networks       []*Network        `json:"networks,omitempty"` // cni networks
 	Parameters     []*Parameter      `json:"parameters"`
 	HealthCheck    *HealthCheck      `json:"healthCheck"`
 	KillPolicy     *KillPolicy       `json:"killPolicy"`
@@ -74,21 +89,21 @@ type TaskConfig struct {
 	Env            map[string]string `json:"env"`
 	Constraints    []*Constraint     `json:"constraints"`
 	Proxy          *Proxy            `json:"proxy"`
+	Priority       int32             `json:"priority"`
+	Strategy       *Strategy         `json:"strategy"`
+	TopologySpread *TopologySpread   `json:"topologySpread"`
+	Affinity       []*AppAffinity    `json:"affinity"`
+	AgentID        string            `json:"agentId,omitempty"` // pinned agent, for daemon tasks
+	Reservation    *Reservation      `json:"-"`                 // of the stateful task, set while launching
+	Pod            *Pod              `json:"pod,omitempty"`
 }
 
 func NewTaskConfig(spec *Version) *TaskConfig {
-	return &TaskConfig{
+	cfg := &TaskConfig{
 		CPUs:           spec.CPUs,
 		Mem:            spec.Mem,
 		Disk:           spec.Disk,
-		Image:          spec.Container.Docker.Image,
 		Command:        spec.Command,
-		Privileged:     spec.Container.Docker.Privileged,
-		ForcePullImage: spec.Container.Docker.ForcePullImage,
-		Volumes:        spec.Container.Volumes,
-		PortMappings:   spec.Container.Docker.PortMappings,
-		Network:        spec.Container.Docker.Network,
-		Parameters:     spec.Container.Docker.Parameters,
 		HealthCheck:    spec.HealthCheck,
 		KillPolicy:     spec.KillPolicy,
 		RestartPolicy:  spec.RestartPolicy,
@@ -97,7 +112,44 @@ func NewTaskConfig(spec *Version) *TaskConfig {
 		Env:            spec.Env,
 		Constraints:    spec.Constraints,
 		Proxy:          spec.Proxy,
+		Priority:       spec.Priority,
+		Strategy:       spec.Strategy,
+		TopologySpread: spec.TopologySpread,
+		Affinity:       spec.Affinity,
+		Pod:            spec.Pod,
+	}
+
+	if spec.Pod != nil {
+		cfg.Network = "host"
+		return cfg
+	}
+
+	cfg.ContainerType = ContainerDocker
+	if spec.Container.IsMesos() {
+		cfg.ContainerType = ContainerMesos
+	}
+
+	cfg.Image = spec.Container.Docker.Image
+	cfg.Privileged = spec.Container.Docker.Privileged
+	cfg.ForcePullImage = spec.Container.Docker.ForcePullImage
+	cfg.Volumes = spec.Container.Volumes
+	cfg.PortMappings = spec.Container.PortMappings()
+	cfg.Network = spec.Container.Docker.Network
+	cfg.Networks = spec.Container.Networks
+	cfg.Parameters = spec.Container.Docker.Parameters
+
+	return cfg
+}
+
+// FixedIP reports whether the task runs with the ip specified by the app, on
+// the user defined docker network.
+func (c *TaskConfig) FixedIP() bool {
+	switch c.Network {
+	case "host", "bridge", NetworkCNI:
+		return false
 	}
+
+	return true
 }
 
 func (c *TaskConfig) BuildCommand() *mesosproto.CommandInfo {
@@ -148,6 +200,11 @@ func (c *TaskConfig) volumes() []*mesosproto.Volume {
 	)
 
 	for _, vlm := range vlms {
+		// mounted by mesos as the persistent volume resource.
+		if vlm.Persistent != nil {
+			continue
+		}
+
 		mode := mesosproto.Volume_RO
 		if vlm.Mode == "RW" {
 			mode = mesosproto.Volume_RW
@@ -223,9 +280,21 @@ func (c *TaskConfig) BuildContainer() *mesosproto.ContainerInfo {
 		force      = c.ForcePullImage
 	)
 
+	if c.ContainerType == ContainerMesos {
+		return &mesosproto.ContainerInfo{
+			Type:    mesosproto.ContainerInfo_MESOS.Enum(),
+			Volumes: c.volumes(),
+			Mesos: &mesosproto.ContainerInfo_MesosInfo{
+				Image: mesosImage(image, force),
+			},
+			NetworkInfos: c.networkInfos(),
+		}
+	}
+
 	return &mesosproto.ContainerInfo{
-		Type:    mesosproto.ContainerInfo_DOCKER.Enum(),
-		Volumes: c.volumes(),
+		Type:         mesosproto.ContainerInfo_DOCKER.Enum(),
+		Volumes:      c.volumes(),
+		NetworkInfos: c.networkInfos(),
 		Docker: &mesosproto.ContainerInfo_DockerInfo{
 			Image:          proto.String(image),
 			Privileged:     proto.Bool(privileged),
@@ -238,6 +307,54 @@ func (c *TaskConfig) BuildContainer() *mesosproto.ContainerInfo {
 
 }
 
+// networkInfos returns the cni networks to join, the port mappings without
+// host port are left to the container ip.
+func (c *TaskConfig) networkInfos() []*mesosproto.NetworkInfo {
+	infos := make([]*mesosproto.NetworkInfo, 0, len(c.Networks))
+
+	for _, n := range c.Networks {
+		labels := make([]*mesosproto.Label, 0, len(n.Labels))
+		for k, v := range n.Labels {
+			labels = append(labels, &mesosproto.Label{
+				Key:   proto.String(k),
+				Value: proto.String(v),
+			})
+		}
+
+		pms := make([]*mesosproto.NetworkInfo_PortMapping, 0, len(n.PortMappings))
+		for _, m := range n.PortMappings {
+			if m.HostPort <= 0 {
+				continue
+			}
+
+			pms = append(pms, &mesosproto.NetworkInfo_PortMapping{
+				HostPort:      proto.Uint32(uint32(m.HostPort)),
+				ContainerPort: proto.Uint32(uint32(m.ContainerPort)),
+				Protocol:      proto.String(strings.ToLower(m.Protocol)),
+			})
+		}
+
+		infos = append(infos, &mesosproto.NetworkInfo{
+			Name:         proto.String(n.Name),
+			Labels:       &mesosproto.Labels{Labels: labels},
+			PortMappings: pms,
+		})
+	}
+
+	return infos
+}
+
+// mesosImage returns the docker (or oci) image pulled by the mesos containerizer.
+func mesosImage(image string, force bool) *mesosproto.Image {
+	return &mesosproto.Image{
+		Type: mesosproto.Image_DOCKER.Enum(),
+		Docker: &mesosproto.Image_Docker{
+			Name: proto.String(image),
+		},
+		Cached: proto.Bool(!force),
+	}
+}
+
 func (c *TaskConfig) BuildResources() []*mesosproto.Resource {
 	var (
 		rs   = make([]*mesosproto.Resource, 0, 0)
@@ -308,6 +425,21 @@ func (c *TaskConfig) BuildResources() []*mesosproto.Resource {
 					},
 				},
 			})
+		case NetworkCNI:
+			if pm.HostPort > 0 {
+				rs = append(rs, &mesosproto.Resource{
+					Name: proto.String("ports"),
+					Type: mesosproto.Value_RANGES.Enum(),
+					Ranges: &mesosproto.Value_Ranges{
+						Range: []*mesosproto.Value_Range{
+							{
+								Begin: proto.Uint64(uint64(pm.HostPort)),
+								End:   proto.Uint64(uint64(pm.HostPort)),
+							},
+						},
+					},
+				})
+			}
 		}
 	}
 
@@ -327,7 +459,7 @@ func (c *TaskConfig) BuildHealthCheck() *mesosproto.HealthCheck {
 				port = pm.HostPort
 			}
 
-			if network == "bridge" {
+			if network == "bridge" || network == NetworkCNI {
 				port = pm.ContainerPort
 			}
 
@@ -0,0 +1,25 @@
+package types
+
+import (
+	"errors"
+)
+
+// TopologySpread keeps the tasks of an app spread across the domains (the values
+// of the agent attribute Key), the difference of task count between any two
+// domains won't exceed MaxSkew.
+type TopologySpread struct {
+	Key     string `json:"key"`
+	MaxSkew int    `json:"maxSkew"`
+}
+
+func (t *TopologySpread) validate() error {
+	if t.Key == "" {
+		return errors.New("topologySpread key required")
+	}
+
+	if t.MaxSkew < 1 {
+		return errors.New("topologySpread maxSkew should >= 1")
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// UsageRecord is the resource-time consumed by one app within one rollup bucket.
+type UsageRecord struct {
+	Bucket          time.Time `json:"bucket"` // start of the rollup bucket
+	AppID           string    `json:"appId"`
+	RunAs           string    `json:"runAs"`
+	CPUSeconds      float64   `json:"cpuSeconds"`
+	MemMBSeconds    float64   `json:"memMBSeconds"`
+	DiskMBSeconds   float64   `json:"diskMBSeconds"`
+	InstanceSeconds float64   `json:"instanceSeconds"`
+}
+
+// Key returns the unique key of the record in store.
+func (r *UsageRecord) Key() string {
+	return fmt.Sprintf("%d.%s", r.Bucket.Unix(), r.AppID)
+}
+
+// BucketKey returns the key of the bucket in store, the records are kept by buckets
+// so that only the buckets within a time range are read.
+func (r *UsageRecord) BucketKey() string {
+	return strconv.FormatInt(r.Bucket.Unix(), 10)
+}
+
+// ParseUsageBucket returns the start of the bucket by its key in store.
+func ParseUsageBucket(key string) (time.Time, error) {
+	secs, err := strconv.ParseInt(key, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid usage bucket %s: %v", key, err)
+	}
+
+	return time.Unix(secs, 0), nil
+}
+
+func (r *UsageRecord) Add(o *UsageRecord) {
+	r.CPUSeconds += o.CPUSeconds
+	r.MemMBSeconds += o.MemMBSeconds
+	r.DiskMBSeconds += o.DiskMBSeconds
+	r.InstanceSeconds += o.InstanceSeconds
+}
+
+// UsageReport is the resource-time summed up by runAs or app within a time range.
+type UsageReport struct {
+	RunAs         string  `json:"runAs"`
+	AppID         string  `json:"appId,omitempty"`
+	CPUSeconds    float64 `json:"cpuSeconds"`
+	MemMBSeconds  float64 `json:"memMBSeconds"`
+	DiskMBSeconds float64 `json:"diskMBSeconds"`
+	InstanceHours float64 `json:"instanceHours"`
+}
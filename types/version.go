@@ -17,10 +17,21 @@ const (
 	DeployContinue = "continue"
 	DeployRollback = "rollback" // TODO(nmg)
 
+	// deploy mode
+	DeployReplicated = "replicated" // specified number of tasks
+	DeployDaemon     = "daemon"     // one task on every matching agent
+
 	// update onfailure action
 	UpdateStop     = "stop"
 	UpdateContinue = "continue"
 	UpdateRollback = "rollback" // TODO(nmg)
+
+	// containerizer
+	ContainerDocker = "DOCKER"
+	ContainerMesos  = "MESOS" // the universal containerizer, runs docker/oci images without docker daemon
+
+	// network attached by the cni plugins, declared in container networks
+	NetworkCNI = "cni"
 )
 
 type VersionList []*Version
@@ -43,33 +54,51 @@ func (vl VersionList) Reverse() {
 }
 
 type Version struct {
-	ID            string            `json:"id"`
-	Name          string            `json:"name"`
-	Command       string            `json:"cmd"`
-	CPUs          float64           `json:"cpus"`
-	Mem           float64           `json:"mem"`
-	Disk          float64           `json:"disk"`
-	Instances     int32             `json:"instances"`
-	RunAs         string            `json:"runAs"`
-	Priority      int32             `json:"priority"`
-	Container     *Container        `json:"container"`
-	Labels        map[string]string `json:"labels"`
-	HealthCheck   *HealthCheck      `json:"healthCheck"`
-	Env           map[string]string `json:"env"`
-	DeployPolicy  *DeployPolicy     `json:"deploy"`
-	KillPolicy    *KillPolicy       `json:"kill"`
-	RestartPolicy *RestartPolicy    `json:"restart"`
-	UpdatePolicy  *UpdatePolicy     `json:"update"`
-	Constraints   []*Constraint     `json:"constraints"`
-	URIs          []string          `json:"uris"`
-	IPs           []string          `json:"ips"`
-	Proxy         *Proxy            `json:"proxy"`
+	ID             string            `json:"id"`
+	Name           string            `json:"name"`
+	Command        string            `json:"cmd"`
+	CPUs           float64           `json:"cpus"`
+	Mem            float64           `json:"mem"`
+	Disk           float64           `json:"disk"`
+	Instances      int32             `json:"instances"`
+	RunAs          string            `json:"runAs"`
+	Priority       int32             `json:"priority"`
+	Container      *Container        `json:"container"`
+	Labels         map[string]string `json:"labels"`
+	HealthCheck    *HealthCheck      `json:"healthCheck"`
+	Env            map[string]string `json:"env"`
+	DeployPolicy   *DeployPolicy     `json:"deploy"`
+	KillPolicy     *KillPolicy       `json:"kill"`
+	RestartPolicy  *RestartPolicy    `json:"restart"`
+	UpdatePolicy   *UpdatePolicy     `json:"update"`
+	Constraints    []*Constraint     `json:"constraints"`
+	URIs           []string          `json:"uris"`
+	IPs            []string          `json:"ips"`
+	Proxy          *Proxy            `json:"proxy"`
+	Strategy       *Strategy         `json:"strategy,omitempty"`
+	TopologySpread *TopologySpread   `json:"topologySpread,omitempty"`
+	Affinity       []*AppAffinity    `json:"affinity,omitempty"`
+	Pod            *Pod              `json:"pod,omitempty"`
 }
 
 type Container struct {
-	Type    string    `json:"type"`
-	Docker  *Docker   `json:"docker"`
-	Volumes []*Volume `json:"volumes"`
+	Type     string     `json:"type"`
+	Docker   *Docker    `json:"docker"` // the image and network, for both docker and mesos containerizer
+	Volumes  []*Volume  `json:"volumes"`
+	Networks []*Network `json:"networks,omitempty"` // cni networks
+}
+
+// Network is a named cni network the container joins, the labels are passed to
+// the cni plugin, and the port mappings are handled by the port-mapper plugin.
+type Network struct {
+	Name         string            `json:"name"`
+	Labels       map[string]string `json:"labels,omitempty"`
+	PortMappings []*PortMapping    `json:"portMappings,omitempty"`
+}
+
+// IsMesos reports whether the container runs by the mesos containerizer.
+func (c *Container) IsMesos() bool {
+	return strings.ToUpper(c.Type) == ContainerMesos
 }
 
 type Docker struct {
@@ -94,9 +123,74 @@ type PortMapping struct {
 }
 
 type Volume struct {
-	ContainerPath string `json:"containerPath,omitempty"`
-	HostPath      string `json:"hostPath,omitempty"`
-	Mode          string `json:"mode,omitempty"`
+	ContainerPath string            `json:"containerPath,omitempty"`
+	HostPath      string            `json:"hostPath,omitempty"`
+	Mode          string            `json:"mode,omitempty"`
+	Persistent    *PersistentVolume `json:"persistent,omitempty"`
+}
+
+func (c *Container) validate() error {
+	switch strings.ToUpper(c.Type) {
+	case "", ContainerDocker:
+		return nil
+	case ContainerMesos:
+	default:
+		return fmt.Errorf("container type %s not supported. must be one of %s, %s", c.Type, ContainerDocker, ContainerMesos)
+	}
+
+	if c.Docker.Privileged {
+		return errors.New("privileged not supported by mesos containerizer")
+	}
+
+	if len(c.Docker.Parameters) > 0 {
+		return errors.New("docker parameters not supported by mesos containerizer")
+	}
+
+	if network := strings.ToLower(c.Docker.Network); network != "host" && network != NetworkCNI {
+		return fmt.Errorf("network %s not supported by mesos containerizer", c.Docker.Network)
+	}
+
+	return nil
+}
+
+func (c *Container) validateNetworks() error {
+	if network := strings.ToLower(c.Docker.Network); network != "" && network != NetworkCNI {
+		return fmt.Errorf("network %s can't be used with cni networks", c.Docker.Network)
+	}
+
+	if !c.IsMesos() && len(c.Networks) > 1 {
+		return errors.New("docker containerizer supports only one cni network")
+	}
+
+	names := make([]string, 0, len(c.Networks))
+	for _, n := range c.Networks {
+		if strings.TrimSpace(n.Name) == "" {
+			return errors.New("cni network name required")
+		}
+
+		names = append(names, n.Name)
+	}
+
+	if !utils.SliceUnique(names) {
+		return errors.New("each cni network should be joined only once")
+	}
+
+	return nil
+}
+
+// PortMappings returns the port mappings of the container, those of all cni
+// networks if any.
+func (c *Container) PortMappings() []*PortMapping {
+	if len(c.Networks) == 0 {
+		return c.Docker.PortMappings
+	}
+
+	pms := make([]*PortMapping, 0)
+	for _, n := range c.Networks {
+		pms = append(pms, n.PortMappings...)
+	}
+
+	return pms
 }
 
 type KillPolicy struct {
@@ -112,6 +206,12 @@ type UpdatePolicy struct {
 type DeployPolicy struct {
 	Step      int64  `json:"step"`
 	OnFailure string `json:"onfailure"`
+	Mode      string `json:"mode,omitempty"`
+}
+
+// IsDaemon reports whether the version runs one task on every matching agent.
+func (v *Version) IsDaemon() bool {
+	return v.DeployPolicy != nil && v.DeployPolicy.Mode == DeployDaemon
 }
 
 type HealthCheck struct {
@@ -162,16 +262,65 @@ func (v *Version) EmptyLabels() *Version {
 // validate version
 // TODO(nmg): use json schema validation replace latter.
 func (v *Version) Validate() error {
-	if v.Container == nil {
-		return errors.New("swan only support mesos docker containerization, no container found")
-	}
+	if v.Pod != nil {
+		if v.Container != nil {
+			return errors.New("pod app defines its containers in pod, container field not allowed")
+		}
 
-	if v.Container.Docker == nil {
-		return errors.New("swan only support mesos docker containerization, no container found")
-	}
+		if v.HealthCheck != nil {
+			return errors.New("pod app defines health checks in pod containers, healthCheck field not allowed")
+		}
 
-	if v.Container.Docker.Image == "" {
-		return errors.New("image field required")
+		if err := v.Pod.validate(); err != nil {
+			return err
+		}
+
+		v.CPUs, v.Mem, v.Disk = v.Pod.Resources()
+	} else {
+		if v.Container == nil {
+			return errors.New("no container found")
+		}
+
+		if v.Container.Docker == nil {
+			return errors.New("no docker section found in container")
+		}
+
+		if v.Container.Docker.Image == "" {
+			return errors.New("image field required")
+		}
+
+		if len(v.Container.Networks) > 0 {
+			if err := v.Container.validateNetworks(); err != nil {
+				return err
+			}
+
+			v.Container.Docker.Network = NetworkCNI
+		}
+
+		if err := v.Container.validate(); err != nil {
+			return err
+		}
+
+		paths := make([]string, 0)
+		for _, vol := range v.Container.Volumes {
+			if vol.Persistent == nil {
+				continue
+			}
+
+			if err := vol.validatePersistent(); err != nil {
+				return err
+			}
+
+			paths = append(paths, vol.ContainerPath)
+		}
+
+		if !utils.SliceUnique(paths) {
+			return errors.New("each persistent volume should have a unique containerPath")
+		}
+
+		if len(paths) > 0 && v.IsDaemon() {
+			return errors.New("daemon app doesn't support persistent volume")
+		}
 	}
 
 	if n := len(v.Name); n == 0 || n > 63 {
@@ -182,7 +331,13 @@ func (v *Version) Validate() error {
 		return errors.New("runAs should not empty")
 	}
 
-	if v.Instances <= 0 {
+	if p := v.DeployPolicy; p != nil && p.Mode != "" && p.Mode != DeployReplicated && p.Mode != DeployDaemon {
+		return fmt.Errorf("deploy mode %s not supported. must be one of %s, %s", p.Mode, DeployReplicated, DeployDaemon)
+	}
+
+	if v.IsDaemon() {
+		v.Instances = 0 // decided by the matching agents
+	} else if v.Instances <= 0 {
 		return errors.New("invalid instances: instances must be specified and should greater than 0")
 	}
 
@@ -202,6 +357,24 @@ func (v *Version) Validate() error {
 		}
 	}
 
+	if v.Strategy != nil {
+		if err := v.Strategy.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if v.TopologySpread != nil {
+		if err := v.TopologySpread.validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, affinity := range v.Affinity {
+		if err := affinity.validate(); err != nil {
+			return err
+		}
+	}
+
 	if err := utils.LegalDomain(v.Name); err != nil {
 		return err
 	}
@@ -210,9 +383,24 @@ func (v *Version) Validate() error {
 		return err
 	}
 
+	// pod runs on the host network.
+	if v.Pod != nil {
+		return nil
+	}
+
 	network := strings.ToLower(v.Container.Docker.Network)
 
-	if network != "host" && network != "bridge" {
+	if network == NetworkCNI && len(v.Container.Networks) == 0 {
+		return errors.New("no cni networks found in container")
+	}
+
+	fixedIP := network != "host" && network != "bridge" && network != NetworkCNI
+
+	if v.IsDaemon() && fixedIP {
+		return errors.New("daemon app only supports host, bridge or cni network")
+	}
+
+	if fixedIP {
 		if len(v.IPs) != int(v.Instances) {
 			return fmt.Errorf("Ip number must equal instance number. required: %d actual: %d", v.Instances, len(v.IPs))
 		}
@@ -231,14 +419,16 @@ func (v *Version) Validate() error {
 			}
 		}
 	} else {
-		for _, portmapping := range v.Container.Docker.PortMappings {
+		portMappings := v.Container.PortMappings()
+
+		for _, portmapping := range portMappings {
 			if strings.TrimSpace(portmapping.Name) == "" {
 				return errors.New("each port mapping should have a unique identified name")
 			}
 		}
 
 		portNames := make([]string, 0)
-		for _, portmapping := range v.Container.Docker.PortMappings {
+		for _, portmapping := range portMappings {
 			portNames = append(portNames, portmapping.Name)
 		}
 